@@ -0,0 +1,194 @@
+package channeldb
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/coreos/bbolt"
+)
+
+// Backup writes a consistent snapshot of the entire database to the passed
+// writer. The snapshot is taken within a single read-only transaction, so the
+// database may continue to be used while the backup is written. The backup can
+// later be restored using RestoreFromBackup.
+func (d *DB) Backup(w io.Writer) error {
+	return d.View(func(tx *bbolt.Tx) error {
+		_, err := tx.WriteTo(w)
+		return err
+	})
+}
+
+// RestoreFromBackup restores a database backup, as written by Backup, into the
+// directory targetPath, and opens it with the passed options. The backup is
+// first streamed to a temporary file next to its destination and validated:
+// it's opened read-only to ensure it's a channel database at a version we're
+// able to migrate from, and bolt's consistency check is run over it. Only once
+// validation succeeds is the backup promoted to replace any existing database
+// within targetPath. If validation fails, or the restored database can't be
+// opened, then the existing database, if any, is left in place untouched.
+//
+// NOTE: If a database already exists within targetPath, then it must not be
+// open, otherwise ErrDatabaseLocked is returned.
+func RestoreFromBackup(r io.Reader, targetPath string,
+	modifiers ...OptionModifier) (*DB, error) {
+
+	if err := os.MkdirAll(targetPath, 0700); err != nil {
+		return nil, err
+	}
+
+	// Before writing anything, make sure that we won't be replacing a
+	// database that is in use.
+	dbPath := filepath.Join(targetPath, dbName)
+	if fileExists(dbPath) {
+		if err := checkNotLocked(dbPath); err != nil {
+			return nil, err
+		}
+	}
+
+	// We'll stream the backup into a temporary file within the target
+	// directory, such that it can be moved into place atomically.
+	tempPath, err := writeTempFile(r, targetPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateBackup(tempPath); err != nil {
+		os.Remove(tempPath)
+		return nil, fmt.Errorf("invalid database backup: %v", err)
+	}
+
+	// With the backup validated, we'll move any existing database aside
+	// so it can be reinstated should the restored one fail to open.
+	var origPath string
+	if fileExists(dbPath) {
+		origPath = dbPath + ".orig"
+		if err := renameFile(dbPath, origPath); err != nil {
+			os.Remove(tempPath)
+			return nil, err
+		}
+	}
+
+	if err := renameFile(tempPath, dbPath); err != nil {
+		os.Remove(tempPath)
+		if origPath != "" {
+			if rbErr := renameFile(origPath, dbPath); rbErr != nil {
+				return nil, fmt.Errorf("unable to restore "+
+					"backup: %v, reinstating original "+
+					"failed: %v", err, rbErr)
+			}
+		}
+		return nil, err
+	}
+
+	db, err := Open(targetPath, modifiers...)
+	if err != nil {
+		log.Errorf("Unable to open restored database at %v, "+
+			"reinstating original: %v", dbPath, err)
+
+		// Remove the restored database, moving back the original one
+		// if there was any.
+		rbErr := os.Remove(dbPath)
+		if rbErr == nil && origPath != "" {
+			rbErr = renameFile(origPath, dbPath)
+		}
+		if rbErr != nil {
+			return nil, fmt.Errorf("unable to open restored "+
+				"database: %v, reinstating original failed: "+
+				"%v", err, rbErr)
+		}
+		return nil, err
+	}
+
+	// Now that the restored database is in place, the original is no
+	// longer needed.
+	if origPath != "" {
+		if err := os.Remove(origPath); err != nil {
+			log.Warnf("Unable to remove original database at "+
+				"%v: %v", origPath, err)
+		}
+	}
+
+	log.Infof("Restored database backup to %v", dbPath)
+
+	return db, nil
+}
+
+// checkNotLocked returns ErrDatabaseLocked if the bolt database at the passed
+// path is held open elsewhere.
+func checkNotLocked(path string) error {
+	bdb, err := bbolt.Open(path, dbFilePermission, &bbolt.Options{
+		ReadOnly: true,
+		Timeout:  readDBVersionTimeout,
+	})
+	switch {
+	case err == bbolt.ErrTimeout:
+		return ErrDatabaseLocked
+
+	case err != nil:
+		return err
+	}
+
+	return bdb.Close()
+}
+
+// writeTempFile streams the contents of the passed reader into a new
+// temporary file within dir, returning its path once it has been synced to
+// disk.
+func writeTempFile(r io.Reader, dir string) (string, error) {
+	f, err := ioutil.TempFile(dir, dbName+".restore-*")
+	if err != nil {
+		return "", err
+	}
+
+	_, err = io.Copy(f, r)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+
+	return f.Name(), nil
+}
+
+// validateBackup opens the bolt database at the passed path read-only, and
+// ensures that it's a channel database at a version we're able to migrate
+// from, which passes bolt's consistency check.
+func validateBackup(path string) error {
+	bdb, err := bbolt.Open(path, dbFilePermission, &bbolt.Options{
+		ReadOnly: true,
+		Timeout:  readDBVersionTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer bdb.Close()
+
+	return bdb.View(func(tx *bbolt.Tx) error {
+		meta := &Meta{}
+		if err := fetchMeta(meta, tx); err != nil {
+			return err
+		}
+		if meta.DbVersionNumber > getLatestDBVersion(dbVersions) {
+			return ErrDBReversion
+		}
+
+		// The consistency check reports every error found, but the
+		// first one suffices to reject the backup. We'll still drain
+		// all of them, as the check blocks until each is received.
+		var checkErr error
+		for err := range tx.Check() {
+			if checkErr == nil {
+				checkErr = err
+			}
+		}
+
+		return checkErr
+	})
+}
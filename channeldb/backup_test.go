@@ -0,0 +1,139 @@
+package channeldb
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestBackupRestore asserts that a database backup can be restored in place of
+// an existing database, and that the restored database holds the channels of
+// the original.
+func TestBackupRestore(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channel.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+
+	var backup bytes.Buffer
+	if err := cdb.Backup(&backup); err != nil {
+		t.Fatalf("unable to backup database: %v", err)
+	}
+
+	// We'll restore the backup in place of an existing, empty database,
+	// which must be closed beforehand.
+	targetDB, targetCleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer targetCleanUp()
+	targetDB.Close()
+
+	restoredDB, err := RestoreFromBackup(&backup, targetDB.dbPath)
+	if err != nil {
+		t.Fatalf("unable to restore backup: %v", err)
+	}
+	defer restoredDB.Close()
+
+	channels, err := restoredDB.FetchAllChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(channels) != 1 {
+		t.Fatalf("expected 1 channel, got %v", len(channels))
+	}
+	if channels[0].FundingOutpoint != channel.FundingOutpoint {
+		t.Fatalf("expected channel %v, got %v",
+			channel.FundingOutpoint, channels[0].FundingOutpoint)
+	}
+
+	// Neither the temporary file nor the original database should be left
+	// behind.
+	assertOnlyDBFile(t, targetDB.dbPath)
+}
+
+// TestRestoreInvalidBackup asserts that an invalid backup is rejected by
+// RestoreFromBackup, leaving the existing database untouched.
+func TestRestoreInvalidBackup(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channel.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+	cdb.Close()
+
+	backup := bytes.NewReader(bytes.Repeat([]byte{0xaa}, 8192))
+	if _, err := RestoreFromBackup(backup, cdb.dbPath); err == nil {
+		t.Fatalf("expected invalid backup to be rejected")
+	}
+	assertOnlyDBFile(t, cdb.dbPath)
+
+	// The existing database should still hold its channel.
+	cdb, err = Open(cdb.dbPath)
+	if err != nil {
+		t.Fatalf("unable to open database: %v", err)
+	}
+	defer cdb.Close()
+
+	channels, err := cdb.FetchAllChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(channels) != 1 {
+		t.Fatalf("expected 1 channel, got %v", len(channels))
+	}
+}
+
+// assertOnlyDBFile asserts that the database file is the only file within the
+// passed directory.
+func assertOnlyDBFile(t *testing.T, dir string) {
+	t.Helper()
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("unable to read dir: %v", err)
+	}
+	if len(files) != 1 || files[0].Name() != dbName {
+		var names []string
+		for _, file := range files {
+			names = append(names, file.Name())
+		}
+		t.Fatalf("expected only %v within %v, found %v", dbName,
+			dir, names)
+	}
+	if _, err := os.Stat(filepath.Join(dir, dbName)); err != nil {
+		t.Fatalf("unable to stat database: %v", err)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/shachain"
+	"github.com/lightningnetwork/lnd/tlv"
 )
 
 var (
@@ -713,7 +714,11 @@ func (c *OpenChannel) fullSync(tx *bbolt.Tx) error {
 		return err
 	}
 
-	return putOpenChannel(chanBucket, c)
+	if err := putOpenChannel(chanBucket, c); err != nil {
+		return err
+	}
+
+	return putChanLastModified(chanBucket, c.Db.now())
 }
 
 // MarkAsOpen marks a channel as fully open given a locator that uniquely
@@ -735,10 +740,36 @@ func (c *OpenChannel) MarkAsOpen(openLoc lnwire.ShortChannelID) error {
 			return err
 		}
 
+		// If the channel was already open, then its entry in the open
+		// height index is replaced by one for the new location.
+		if !channel.IsPending {
+			var chanPointBuf bytes.Buffer
+			err := writeOutpoint(&chanPointBuf, &c.FundingOutpoint)
+			if err != nil {
+				return err
+			}
+
+			err = removeChanOpenHeight(
+				tx, channel.ShortChannelID.BlockHeight,
+				chanPointBuf.Bytes(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
 		channel.IsPending = false
 		channel.ShortChannelID = openLoc
 
-		return putOpenChannel(chanBucket, channel)
+		if err := putOpenChannel(chanBucket, channel); err != nil {
+			return err
+		}
+		err = putChanLastModified(chanBucket, c.Db.now())
+		if err != nil {
+			return err
+		}
+
+		return putChanOpenHeight(tx, channel)
 	}); err != nil {
 		return err
 	}
@@ -747,6 +778,11 @@ func (c *OpenChannel) MarkAsOpen(openLoc lnwire.ShortChannelID) error {
 	c.ShortChannelID = openLoc
 	c.Packager = NewChannelPackager(openLoc)
 
+	c.Db.notifyChannelEvent(&ChannelOpenedEvent{
+		ChanPoint:   c.FundingOutpoint,
+		ShortChanID: openLoc,
+	})
+
 	return nil
 }
 
@@ -985,12 +1021,27 @@ func (c *OpenChannel) putChanStatus(status ChannelStatus,
 		}
 
 		// Add this status to the existing bitvector found in the DB.
+		oldStatus := channel.chanStatus
 		status = channel.chanStatus | status
 		channel.chanStatus = status
 
 		if err := putOpenChannel(chanBucket, channel); err != nil {
 			return err
 		}
+		err = putChanLastModified(chanBucket, c.Db.now())
+		if err != nil {
+			return err
+		}
+
+		if status != oldStatus {
+			err := logChannelEvent(
+				tx, &c.FundingOutpoint, oldStatus, status,
+				c.Db.now(),
+			)
+			if err != nil {
+				return err
+			}
+		}
 
 		for _, f := range fs {
 			if err := f(chanBucket); err != nil {
@@ -1024,10 +1075,25 @@ func (c *OpenChannel) clearChanStatus(status ChannelStatus) error {
 		}
 
 		// Unset this bit in the bitvector on disk.
+		oldStatus := channel.chanStatus
 		status = channel.chanStatus & ^status
 		channel.chanStatus = status
 
-		return putOpenChannel(chanBucket, channel)
+		if err := putOpenChannel(chanBucket, channel); err != nil {
+			return err
+		}
+		err = putChanLastModified(chanBucket, c.Db.now())
+		if err != nil {
+			return err
+		}
+
+		if status == oldStatus {
+			return nil
+		}
+
+		return logChannelEvent(
+			tx, &c.FundingOutpoint, oldStatus, status, c.Db.now(),
+		)
 	}); err != nil {
 		return err
 	}
@@ -1111,9 +1177,19 @@ func (c *OpenChannel) SyncPending(addr net.Addr, pendingHeight uint32) error {
 
 	c.FundingBroadcastHeight = pendingHeight
 
-	return c.Db.Update(func(tx *bbolt.Tx) error {
+	err := c.Db.Update(func(tx *bbolt.Tx) error {
 		return syncNewChannel(tx, c, []net.Addr{addr})
 	})
+	if err != nil {
+		return err
+	}
+
+	c.Db.notifyChannelEvent(&ChannelPendingEvent{
+		ChanPoint: c.FundingOutpoint,
+		RemotePub: c.IdentityPub,
+	})
+
+	return nil
 }
 
 // syncNewChannel will write the passed channel to disk, and also create a
@@ -1124,6 +1200,15 @@ func syncNewChannel(tx *bbolt.Tx, c *OpenChannel, addrs []net.Addr) error {
 		return err
 	}
 
+	// Channels that are written once their funding transaction has
+	// already confirmed, such as restored channels, are indexed by their
+	// open height straight away.
+	if !c.IsPending {
+		if err := putChanOpenHeight(tx, c); err != nil {
+			return err
+		}
+	}
+
 	nodeInfoBucket, err := tx.CreateBucketIfNotExists(nodeInfoBucket)
 	if err != nil {
 		return err
@@ -1194,7 +1279,7 @@ func (c *OpenChannel) UpdateCommitment(newCommitment *ChannelCommitment) error {
 				"revocations: %v", err)
 		}
 
-		return nil
+		return putChanLastModified(chanBucket, c.Db.now())
 	})
 	if err != nil {
 		return err
@@ -1648,7 +1733,12 @@ func (c *OpenChannel) AppendRemoteCommitChain(diff *CommitDiff) error {
 		if err := serializeCommitDiff(&b, diff); err != nil {
 			return err
 		}
-		return chanBucket.Put(commitDiffKey, b.Bytes())
+		err = chanBucket.Put(commitDiffKey, b.Bytes())
+		if err != nil {
+			return err
+		}
+
+		return putChanLastModified(chanBucket, c.Db.now())
 	})
 }
 
@@ -1714,7 +1804,11 @@ func (c *OpenChannel) InsertNextRevocation(revKey *btcec.PublicKey) error {
 			return err
 		}
 
-		return putChanRevocationState(chanBucket, c)
+		if err := putChanRevocationState(chanBucket, c); err != nil {
+			return err
+		}
+
+		return putChanLastModified(chanBucket, c.Db.now())
 	})
 	if err != nil {
 		return err
@@ -1808,6 +1902,10 @@ func (c *OpenChannel) AdvanceCommitChainTail(fwdPkg *FwdPkg) error {
 		if err != nil {
 			return err
 		}
+		err = putChanLastModified(chanBucket, c.Db.now())
+		if err != nil {
+			return err
+		}
 
 		// Lastly, we write the forwarding package to disk so that we
 		// can properly recover from failures and reforward HTLCs that
@@ -2085,6 +2183,22 @@ const (
 	Abandoned ClosureType = 5
 )
 
+const (
+	// closeSummaryTLVMarker is written in place of the boolean that
+	// indicates the presence of the optional fields within the legacy
+	// close summary encoding. It signals that the optional fields follow
+	// as a TLV stream instead.
+	closeSummaryTLVMarker byte = 2
+
+	// A set of tlv type definitions used to serialize the optional fields
+	// of a channel close summary to the database. All types are odd, such
+	// that readers unaware of a field are able to skip it.
+	remoteCurrentRevocationType tlv.Type = 1
+	localChanConfigType         tlv.Type = 3
+	remoteNextRevocationType    tlv.Type = 5
+	lastChanSyncMsgType         tlv.Type = 7
+)
+
 // ChannelCloseSummary contains the final state of a channel at the point it
 // was closed. Once a channel is closed, all the information pertaining to that
 // channel within the openChannelBucket is deleted, and a compact summary is
@@ -2175,71 +2289,127 @@ func (c *OpenChannel) CloseChannel(summary *ChannelCloseSummary) error {
 	c.Lock()
 	defer c.Unlock()
 
-	return c.Db.Update(func(tx *bbolt.Tx) error {
-		openChanBucket := tx.Bucket(openChannelBucket)
-		if openChanBucket == nil {
-			return ErrNoChanDBExists
-		}
+	err := c.Db.Update(func(tx *bbolt.Tx) error {
+		return c.closeChannel(tx, summary)
+	})
+	if err != nil {
+		return err
+	}
 
-		nodePub := c.IdentityPub.SerializeCompressed()
-		nodeChanBucket := openChanBucket.Bucket(nodePub)
-		if nodeChanBucket == nil {
-			return ErrNoActiveChannels
-		}
+	c.Db.notifyChannelClosed(summary)
 
-		chainBucket := nodeChanBucket.Bucket(c.ChainHash[:])
-		if chainBucket == nil {
-			return ErrNoActiveChannels
-		}
+	return nil
+}
 
-		var chanPointBuf bytes.Buffer
-		err := writeOutpoint(&chanPointBuf, &c.FundingOutpoint)
-		if err != nil {
-			return err
-		}
-		chanBucket := chainBucket.Bucket(chanPointBuf.Bytes())
-		if chanBucket == nil {
-			return ErrNoActiveChannels
-		}
+// closeChannel closes the channel within the passed transaction.
+//
+// NOTE: This method requires the channel's mutex to be held.
+func (c *OpenChannel) closeChannel(tx *bbolt.Tx,
+	summary *ChannelCloseSummary) error {
+
+	openChanBucket := tx.Bucket(openChannelBucket)
+	if openChanBucket == nil {
+		return ErrNoChanDBExists
+	}
+
+	nodePub := c.IdentityPub.SerializeCompressed()
+	nodeChanBucket := openChanBucket.Bucket(nodePub)
+	if nodeChanBucket == nil {
+		return ErrNoActiveChannels
+	}
 
-		// Before we delete the channel state, we'll read out the full
-		// details, as we'll also store portions of this information
-		// for record keeping.
-		chanState, err := fetchOpenChannel(
-			chanBucket, &c.FundingOutpoint,
+	chainBucket := nodeChanBucket.Bucket(c.ChainHash[:])
+	if chainBucket == nil {
+		return ErrNoActiveChannels
+	}
+
+	var chanPointBuf bytes.Buffer
+	err := writeOutpoint(&chanPointBuf, &c.FundingOutpoint)
+	if err != nil {
+		return err
+	}
+	chanBucket := chainBucket.Bucket(chanPointBuf.Bytes())
+	if chanBucket == nil {
+		return ErrNoActiveChannels
+	}
+
+	// Before we delete the channel state, we'll read out the full
+	// details, as we'll also store portions of this information
+	// for record keeping.
+	chanState, err := fetchOpenChannel(
+		chanBucket, &c.FundingOutpoint,
+	)
+	if err != nil {
+		return err
+	}
+
+	// If requested, retain a copy of the complete channel state,
+	// including its revocation log, before it's deleted.
+	if c.Db.keepHistoricalChannels {
+		err := putHistoricalChannel(
+			tx, chanPointBuf.Bytes(), chanBucket,
 		)
 		if err != nil {
 			return err
 		}
+	}
 
-		// Now that the index to this channel has been deleted, purge
-		// the remaining channel metadata from the database.
-		err = deleteOpenChannel(chanBucket, chanPointBuf.Bytes())
+	// Now that the index to this channel has been deleted, purge
+	// the remaining channel metadata from the database.
+	err = deleteOpenChannel(chanBucket, chanPointBuf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	// With the base channel data deleted, attempt to delete the
+	// information stored within the revocation log.
+	logBucket := chanBucket.Bucket(revocationLogBucket)
+	if logBucket != nil {
+		err = chanBucket.DeleteBucket(revocationLogBucket)
 		if err != nil {
 			return err
 		}
+	}
 
-		// With the base channel data deleted, attempt to delete the
-		// information stored within the revocation log.
-		logBucket := chanBucket.Bucket(revocationLogBucket)
-		if logBucket != nil {
-			err = chanBucket.DeleteBucket(revocationLogBucket)
-			if err != nil {
-				return err
-			}
-		}
+	err = chainBucket.DeleteBucket(chanPointBuf.Bytes())
+	if err != nil {
+		return err
+	}
 
-		err = chainBucket.DeleteBucket(chanPointBuf.Bytes())
+	// As aliases may only be assigned to open channels, we'll
+	// remove the alias of the channel, if any.
+	if err := removeChannelAlias(tx, chanPointBuf.Bytes()); err != nil {
+		return err
+	}
+
+	// Channels that are no longer pending are indexed by their open
+	// height, so we'll remove them from the index.
+	if !chanState.IsPending {
+		err := removeChanOpenHeight(
+			tx, chanState.ShortChannelID.BlockHeight,
+			chanPointBuf.Bytes(),
+		)
 		if err != nil {
 			return err
 		}
+	}
 
-		// Finally, create a summary of this channel in the closed
-		// channel bucket for this node.
-		return putChannelCloseSummary(
-			tx, chanPointBuf.Bytes(), summary, chanState,
+	// If requested, archive a trimmed snapshot of the final state
+	// of the channel alongside its summary.
+	if c.Db.archiveClosedChannels {
+		err := putArchivedChannel(
+			tx, chanPointBuf.Bytes(), chanState,
 		)
-	})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Finally, create a summary of this channel in the closed
+	// channel bucket for this node.
+	return putChannelCloseSummary(
+		tx, chanPointBuf.Bytes(), summary, chanState,
+	)
 }
 
 // ChannelSnapshot is a frozen snapshot of the current channel state. A
@@ -2384,53 +2554,83 @@ func serializeChannelCloseSummary(w io.Writer, cs *ChannelCloseSummary) error {
 		return err
 	}
 
-	// If this is a close channel summary created before the addition of
-	// the new fields, then we can exit here.
-	if cs.RemoteCurrentRevocation == nil {
-		return WriteElements(w, false)
-	}
-
-	// If fields are present, write boolean to indicate this, and continue.
-	if err := WriteElements(w, true); err != nil {
+	// With the fixed fields written, we'll write the marker indicating
+	// that the optional fields follow as a TLV stream.
+	if _, err := w.Write([]byte{closeSummaryTLVMarker}); err != nil {
 		return err
 	}
 
-	if err := WriteElements(w, cs.RemoteCurrentRevocation); err != nil {
-		return err
-	}
+	return serializeCloseSummaryTLV(w, cs)
+}
 
-	if err := writeChanConfig(w, &cs.LocalChanConfig); err != nil {
-		return err
+// serializeCloseSummaryTLV writes the optional fields of the passed close
+// summary as a TLV stream. Only the fields that are present are included.
+func serializeCloseSummaryTLV(w io.Writer, cs *ChannelCloseSummary) error {
+	var records []tlv.Record
+	if cs.RemoteCurrentRevocation != nil {
+		records = append(records, tlv.MakePrimitiveRecord(
+			remoteCurrentRevocationType, &cs.RemoteCurrentRevocation,
+		))
 	}
 
-	// The RemoteNextRevocation field is optional, as it's possible for a
-	// channel to be closed before we learn of the next unrevoked
-	// revocation point for the remote party. Write a boolen indicating
-	// whether this field is present or not.
-	if err := WriteElements(w, cs.RemoteNextRevocation != nil); err != nil {
-		return err
+	if cs.LocalChanConfig != (ChannelConfig{}) {
+		var b bytes.Buffer
+		if err := writeChanConfig(&b, &cs.LocalChanConfig); err != nil {
+			return err
+		}
+		chanConfig := b.Bytes()
+
+		records = append(records, tlv.MakePrimitiveRecord(
+			localChanConfigType, &chanConfig,
+		))
 	}
 
-	// Write the field, if present.
 	if cs.RemoteNextRevocation != nil {
-		if err = WriteElements(w, cs.RemoteNextRevocation); err != nil {
+		records = append(records, tlv.MakePrimitiveRecord(
+			remoteNextRevocationType, &cs.RemoteNextRevocation,
+		))
+	}
+
+	if cs.LastChanSyncMsg != nil {
+		var b bytes.Buffer
+		if _, err := lnwire.WriteMessage(&b, cs.LastChanSyncMsg, 0); err != nil {
 			return err
 		}
+		chanSyncMsg := b.Bytes()
+
+		records = append(records, tlv.MakePrimitiveRecord(
+			lastChanSyncMsgType, &chanSyncMsg,
+		))
 	}
 
-	// Write whether the channel sync message is present.
-	if err := WriteElements(w, cs.LastChanSyncMsg != nil); err != nil {
+	tlvStream, err := tlv.NewStream(records...)
+	if err != nil {
 		return err
 	}
 
-	// Write the channel sync message, if present.
-	if cs.LastChanSyncMsg != nil {
-		if err := WriteElements(w, cs.LastChanSyncMsg); err != nil {
-			return err
-		}
+	return tlvStream.Encode(w)
+}
+
+// deserializeCloseHeight reads only the close height from a serialized
+// channel close summary, skipping over the fields that precede it. This avoids
+// decoding the remainder of the summary when only its height is needed.
+func deserializeCloseHeight(r io.Reader) (uint32, error) {
+	var (
+		chanPoint   wire.OutPoint
+		shortChanID lnwire.ShortChannelID
+		chainHash   chainhash.Hash
+		closingTXID chainhash.Hash
+		closeHeight uint32
+	)
+	err := ReadElements(r,
+		&chanPoint, &shortChanID, &chainHash, &closingTXID,
+		&closeHeight,
+	)
+	if err != nil {
+		return 0, err
 	}
 
-	return nil
+	return closeHeight, nil
 }
 
 func deserializeCloseChannelSummary(r io.Reader) (*ChannelCloseSummary, error) {
@@ -2445,17 +2645,34 @@ func deserializeCloseChannelSummary(r io.Reader) (*ChannelCloseSummary, error) {
 		return nil, err
 	}
 
-	// We'll now check to see if the channel close summary was encoded with
-	// any of the additional optional fields.
-	var hasNewFields bool
-	err = ReadElements(r, &hasNewFields)
-	if err != nil {
+	// We'll now check how the optional fields of the summary were encoded.
+	// Summaries written before the migration to the TLV encoding use a
+	// boolean to indicate whether the optional fields are present, while
+	// newer ones use a marker indicating a TLV stream follows.
+	var marker [1]byte
+	if _, err := io.ReadFull(r, marker[:]); err != nil {
 		return nil, err
 	}
 
+	switch marker[0] {
+	case closeSummaryTLVMarker:
+		if err := deserializeCloseSummaryTLV(r, c); err != nil {
+			return nil, err
+		}
+
+		return c, nil
+
 	// If fields are not present, we can return.
-	if !hasNewFields {
+	case 0:
 		return c, nil
+
+	// The fields are present within their legacy encoding, which we'll
+	// read below.
+	case 1:
+
+	default:
+		return nil, fmt.Errorf("unknown close summary marker %v",
+			marker[0])
 	}
 
 	// Otherwise read the new fields.
@@ -2514,6 +2731,54 @@ func deserializeCloseChannelSummary(r io.Reader) (*ChannelCloseSummary, error) {
 	return c, nil
 }
 
+// deserializeCloseSummaryTLV reads the optional fields of a close summary,
+// encoded as a TLV stream, into the passed summary.
+func deserializeCloseSummaryTLV(r io.Reader, c *ChannelCloseSummary) error {
+	var chanConfig, chanSyncMsg []byte
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(
+			remoteCurrentRevocationType, &c.RemoteCurrentRevocation,
+		),
+		tlv.MakePrimitiveRecord(localChanConfigType, &chanConfig),
+		tlv.MakePrimitiveRecord(
+			remoteNextRevocationType, &c.RemoteNextRevocation,
+		),
+		tlv.MakePrimitiveRecord(lastChanSyncMsgType, &chanSyncMsg),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tlvStream.Decode(r); err != nil {
+		return err
+	}
+
+	if len(chanConfig) > 0 {
+		err := readChanConfig(
+			bytes.NewReader(chanConfig), &c.LocalChanConfig,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(chanSyncMsg) > 0 {
+		msg, err := lnwire.ReadMessage(bytes.NewReader(chanSyncMsg), 0)
+		if err != nil {
+			return err
+		}
+
+		chanSync, ok := msg.(*lnwire.ChannelReestablish)
+		if !ok {
+			return errors.New("unable cast db Message to " +
+				"ChannelReestablish")
+		}
+		c.LastChanSyncMsg = chanSync
+	}
+
+	return nil
+}
+
 func writeChanConfig(b io.Writer, c *ChannelConfig) error {
 	return WriteElements(b,
 		c.DustLimit, c.MaxPendingAmount, c.ChanReserve, c.MinHTLC,
@@ -2679,6 +2944,54 @@ func fetchChanInfo(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 	return nil
 }
 
+// chanInfoHeader holds the fixed size fields found at the start of the static
+// channel info of a channel. It allows callers that only need a handful of
+// flags to skip decoding the remainder of the channel state.
+type chanInfoHeader struct {
+	chanType               ChannelType
+	chainHash              chainhash.Hash
+	fundingOutpoint        wire.OutPoint
+	shortChannelID         lnwire.ShortChannelID
+	isPending              bool
+	isInitiator            bool
+	chanStatus             ChannelStatus
+	fundingBroadcastHeight uint32
+	numConfsRequired       uint16
+	channelFlags           lnwire.FundingFlag
+	capacity               btcutil.Amount
+}
+
+// fetchChanInfoHeader reads only the fixed size header of the static channel
+// info stored within the passed channel bucket.
+func fetchChanInfoHeader(chanBucket *bbolt.Bucket) (*chanInfoHeader, error) {
+	infoBytes := chanBucket.Get(chanInfoKey)
+	if infoBytes == nil {
+		return nil, ErrNoChanInfoFound
+	}
+	r := bytes.NewReader(infoBytes)
+
+	var h chanInfoHeader
+	if err := ReadElements(r,
+		&h.chanType, &h.chainHash, &h.fundingOutpoint,
+		&h.shortChannelID, &h.isPending, &h.isInitiator,
+		&h.chanStatus, &h.fundingBroadcastHeight,
+		&h.numConfsRequired, &h.channelFlags,
+	); err != nil {
+		return nil, err
+	}
+
+	// The identity of the remote node precedes the capacity, but as it's
+	// of a fixed size we can skip it rather than parsing it.
+	if _, err := r.Seek(33, io.SeekCurrent); err != nil {
+		return nil, err
+	}
+	if err := ReadElement(r, &h.capacity); err != nil {
+		return nil, err
+	}
+
+	return &h, nil
+}
+
 func deserializeChanCommit(r io.Reader) (ChannelCommitment, error) {
 	var c ChannelCommitment
 
@@ -2716,6 +3029,61 @@ func fetchChanCommitment(chanBucket *bbolt.Bucket, local bool) (ChannelCommitmen
 	return deserializeChanCommit(r)
 }
 
+// fetchCommitHeight reads only the height of the local or remote commitment
+// stored within the passed channel bucket.
+func fetchCommitHeight(chanBucket *bbolt.Bucket, local bool) (uint64, error) {
+	var commitKey []byte
+	if local {
+		commitKey = append(chanCommitmentKey, byte(0x00))
+	} else {
+		commitKey = append(chanCommitmentKey, byte(0x01))
+	}
+
+	commitBytes := chanBucket.Get(commitKey)
+	if commitBytes == nil {
+		return 0, ErrNoCommitmentsFound
+	}
+
+	var height uint64
+	err := ReadElement(bytes.NewReader(commitBytes), &height)
+	return height, err
+}
+
+// fetchCommitNumHtlcs reads only the number of HTLCs of the local or remote
+// commitment stored within the passed channel bucket, skipping the decoding of
+// the HTLCs themselves.
+func fetchCommitNumHtlcs(chanBucket *bbolt.Bucket, local bool) (uint16, error) {
+	var commitKey []byte
+	if local {
+		commitKey = append(chanCommitmentKey, byte(0x00))
+	} else {
+		commitKey = append(chanCommitmentKey, byte(0x01))
+	}
+
+	commitBytes := chanBucket.Get(commitKey)
+	if commitBytes == nil {
+		return 0, ErrNoCommitmentsFound
+	}
+	r := bytes.NewReader(commitBytes)
+
+	// The HTLCs are preceded by the fields of the commitment itself, which
+	// we'll need to read past first.
+	var c ChannelCommitment
+	err := ReadElements(r,
+		&c.CommitHeight, &c.LocalLogIndex, &c.LocalHtlcIndex,
+		&c.RemoteLogIndex, &c.RemoteHtlcIndex, &c.LocalBalance,
+		&c.RemoteBalance, &c.CommitFee, &c.FeePerKw, &c.CommitTx,
+		&c.CommitSig,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var numHtlcs uint16
+	err = ReadElement(r, &numHtlcs)
+	return numHtlcs, err
+}
+
 func fetchChanCommitments(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 	var err error
 
@@ -0,0 +1,171 @@
+package channeldb
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// channelAliasBucket is a top-level bucket housing the indexes that
+	// map the operator assigned aliases of channels to their outpoints,
+	// and vice versa. It's created on demand when the first alias is set.
+	//
+	// channelAlias -> aliasIndex -> alias -> chanPoint
+	//              -> chanPointIndex -> chanPoint -> alias
+	channelAliasBucket = []byte("channel-alias")
+
+	// channelAliasIndexBucket is a sub-bucket of channelAliasBucket which
+	// maps each alias to the outpoint of the channel it was assigned to.
+	channelAliasIndexBucket = []byte("alias-index")
+
+	// channelAliasChanPointBucket is a sub-bucket of channelAliasBucket
+	// which maps the outpoint of each channel to its alias.
+	channelAliasChanPointBucket = []byte("chan-point-index")
+)
+
+// MaxChannelAliasLength is the maximum length of the alias of a channel.
+const MaxChannelAliasLength = 64
+
+// SetChannelAlias assigns the passed alias to the open channel identified by
+// the passed channel point, replacing any alias it was previously assigned.
+// Aliases must be unique across channels, so ErrChannelAliasInUse is returned
+// if the alias is assigned to another channel. Passing an empty alias removes
+// the alias of the channel. The alias of a channel is removed once it's
+// closed.
+func (d *DB) SetChannelAlias(chanPoint wire.OutPoint, alias string) error {
+	if len(alias) > MaxChannelAliasLength {
+		return ErrChannelAliasTooLong
+	}
+
+	return d.Update(func(tx *bbolt.Tx) error {
+		// Only open channels may be assigned an alias.
+		if _, err := findChanBucket(tx, &chanPoint); err != nil {
+			return err
+		}
+
+		var chanPointBuf bytes.Buffer
+		if err := writeOutpoint(&chanPointBuf, &chanPoint); err != nil {
+			return err
+		}
+		chanPointBytes := chanPointBuf.Bytes()
+
+		aliases, err := tx.CreateBucketIfNotExists(channelAliasBucket)
+		if err != nil {
+			return err
+		}
+		aliasIndex, err := aliases.CreateBucketIfNotExists(
+			channelAliasIndexBucket,
+		)
+		if err != nil {
+			return err
+		}
+		chanPointIndex, err := aliases.CreateBucketIfNotExists(
+			channelAliasChanPointBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		if alias != "" {
+			owner := aliasIndex.Get([]byte(alias))
+			switch {
+			// If the channel already has this alias, then there's
+			// nothing left to do.
+			case bytes.Equal(owner, chanPointBytes):
+				return nil
+
+			case owner != nil:
+				return ErrChannelAliasInUse
+			}
+		}
+
+		// With the alias known to be available, we'll remove the
+		// current alias of the channel, if any.
+		err = deleteChannelAlias(
+			aliasIndex, chanPointIndex, chanPointBytes,
+		)
+		if err != nil {
+			return err
+		}
+
+		if alias == "" {
+			return nil
+		}
+
+		err = aliasIndex.Put([]byte(alias), chanPointBytes)
+		if err != nil {
+			return err
+		}
+		return chanPointIndex.Put(chanPointBytes, []byte(alias))
+	})
+}
+
+// FetchChannelByAlias returns the open channel that was assigned the passed
+// alias. If no channel was assigned the alias, then ErrChannelAliasNotFound is
+// returned.
+func (d *DB) FetchChannelByAlias(alias string) (*OpenChannel, error) {
+	var channel *OpenChannel
+	err := d.View(func(tx *bbolt.Tx) error {
+		aliases := tx.Bucket(channelAliasBucket)
+		if aliases == nil {
+			return ErrChannelAliasNotFound
+		}
+		aliasIndex := aliases.Bucket(channelAliasIndexBucket)
+		if aliasIndex == nil {
+			return ErrChannelAliasNotFound
+		}
+
+		chanPointBytes := aliasIndex.Get([]byte(alias))
+		if chanPointBytes == nil {
+			return ErrChannelAliasNotFound
+		}
+
+		var chanPoint wire.OutPoint
+		err := readOutpoint(bytes.NewReader(chanPointBytes), &chanPoint)
+		if err != nil {
+			return err
+		}
+
+		channel, err = d.FetchChannelTx(tx, chanPoint)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+// removeChannelAlias removes the alias of the channel with the passed
+// serialized channel point within the passed transaction, if it has one.
+func removeChannelAlias(tx *bbolt.Tx, chanPoint []byte) error {
+	aliases := tx.Bucket(channelAliasBucket)
+	if aliases == nil {
+		return nil
+	}
+	aliasIndex := aliases.Bucket(channelAliasIndexBucket)
+	chanPointIndex := aliases.Bucket(channelAliasChanPointBucket)
+	if aliasIndex == nil || chanPointIndex == nil {
+		return nil
+	}
+
+	return deleteChannelAlias(aliasIndex, chanPointIndex, chanPoint)
+}
+
+// deleteChannelAlias removes the alias of the channel with the passed
+// serialized channel point from both alias indexes, if it has one.
+func deleteChannelAlias(aliasIndex, chanPointIndex *bbolt.Bucket,
+	chanPoint []byte) error {
+
+	alias := chanPointIndex.Get(chanPoint)
+	if alias == nil {
+		return nil
+	}
+
+	if err := aliasIndex.Delete(alias); err != nil {
+		return err
+	}
+	return chanPointIndex.Delete(chanPoint)
+}
@@ -0,0 +1,112 @@
+package channeldb
+
+import (
+	"net"
+	"testing"
+)
+
+// TestChannelAlias asserts that channels can be looked up by their alias, that
+// aliases are unique, and that the alias of a channel is removed once it's
+// closed.
+func TestChannelAlias(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+
+	var channels []*OpenChannel
+	for i := 0; i < 2; i++ {
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+		channels = append(channels, channel)
+	}
+	chanPoint1 := channels[0].FundingOutpoint
+	chanPoint2 := channels[1].FundingOutpoint
+
+	assertAlias := func(alias string, expErr error,
+		expChannel *OpenChannel) {
+
+		t.Helper()
+
+		channel, err := cdb.FetchChannelByAlias(alias)
+		if err != expErr {
+			t.Fatalf("expected error %v, got %v", expErr, err)
+		}
+		if expChannel == nil {
+			return
+		}
+		if channel.FundingOutpoint != expChannel.FundingOutpoint {
+			t.Fatalf("expected channel %v, got %v",
+				expChannel.FundingOutpoint,
+				channel.FundingOutpoint)
+		}
+	}
+
+	assertAlias("alice", ErrChannelAliasNotFound, nil)
+
+	if err := cdb.SetChannelAlias(chanPoint1, "alice"); err != nil {
+		t.Fatalf("unable to set alias: %v", err)
+	}
+	assertAlias("alice", nil, channels[0])
+
+	// Setting the same alias again is a no-op, while assigning it to
+	// another channel should fail.
+	if err := cdb.SetChannelAlias(chanPoint1, "alice"); err != nil {
+		t.Fatalf("unable to set alias: %v", err)
+	}
+	err = cdb.SetChannelAlias(chanPoint2, "alice")
+	if err != ErrChannelAliasInUse {
+		t.Fatalf("expected ErrChannelAliasInUse, got: %v", err)
+	}
+
+	// Renaming the first channel should free up its former alias.
+	if err := cdb.SetChannelAlias(chanPoint1, "bob"); err != nil {
+		t.Fatalf("unable to set alias: %v", err)
+	}
+	assertAlias("alice", ErrChannelAliasNotFound, nil)
+	assertAlias("bob", nil, channels[0])
+
+	if err := cdb.SetChannelAlias(chanPoint2, "alice"); err != nil {
+		t.Fatalf("unable to set alias: %v", err)
+	}
+	assertAlias("alice", nil, channels[1])
+
+	// An empty alias removes the alias of the channel.
+	if err := cdb.SetChannelAlias(chanPoint2, ""); err != nil {
+		t.Fatalf("unable to remove alias: %v", err)
+	}
+	assertAlias("alice", ErrChannelAliasNotFound, nil)
+
+	// Closing the first channel should remove its alias, allowing it to
+	// be reused.
+	err = channels[0].CloseChannel(&ChannelCloseSummary{
+		ChanPoint: chanPoint1,
+		RemotePub: channels[0].IdentityPub,
+	})
+	if err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+	assertAlias("bob", ErrChannelAliasNotFound, nil)
+	if err := cdb.SetChannelAlias(chanPoint2, "bob"); err != nil {
+		t.Fatalf("unable to set alias: %v", err)
+	}
+
+	// Closed channels can't be assigned an alias.
+	err = cdb.SetChannelAlias(chanPoint1, "carol")
+	if err != ErrChannelNotFound {
+		t.Fatalf("expected ErrChannelNotFound, got: %v", err)
+	}
+}
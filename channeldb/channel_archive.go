@@ -0,0 +1,176 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// closedChannelArchiveBucket is a top-level bucket which stores a
+	// trimmed snapshot of the final state of each closed channel, keyed
+	// by the channel's outpoint. It's only populated if the database was
+	// opened with archival of closed channels enabled, and is created on
+	// demand.
+	closedChannelArchiveBucket = []byte("closed-chan-archive")
+)
+
+// ArchivedChannel is a trimmed snapshot of the final state of an open channel,
+// recorded at the time it was closed.
+type ArchivedChannel struct {
+	// ChanPoint is the outpoint of the funding transaction of the channel.
+	ChanPoint wire.OutPoint
+
+	// ShortChanID is the short channel ID of the channel.
+	ShortChanID lnwire.ShortChannelID
+
+	// ChainHash is the genesis hash of the chain the channel resided
+	// within.
+	ChainHash chainhash.Hash
+
+	// Capacity is the total capacity of the channel.
+	Capacity btcutil.Amount
+
+	// ChanStatus is the status of the channel at the time it was closed.
+	ChanStatus ChannelStatus
+
+	// TotalMSatSent is the total number of milli-satoshis we sent within
+	// the channel.
+	TotalMSatSent lnwire.MilliSatoshi
+
+	// TotalMSatReceived is the total number of milli-satoshis we received
+	// within the channel.
+	TotalMSatReceived lnwire.MilliSatoshi
+
+	// LocalBalance is our balance within the latest local commitment.
+	LocalBalance lnwire.MilliSatoshi
+
+	// RemoteBalance is the remote party's balance within the latest local
+	// commitment.
+	RemoteBalance lnwire.MilliSatoshi
+
+	// LocalCommitHeight is the height of the latest local commitment.
+	LocalCommitHeight uint64
+
+	// RemoteCommitHeight is the height of the latest remote commitment.
+	RemoteCommitHeight uint64
+
+	// NumLocalHtlcs is the number of HTLCs that were active within the
+	// latest local commitment.
+	NumLocalHtlcs uint16
+
+	// NumRemoteHtlcs is the number of HTLCs that were active within the
+	// latest remote commitment.
+	NumRemoteHtlcs uint16
+}
+
+// newArchivedChannel creates a trimmed snapshot of the passed channel.
+func newArchivedChannel(c *OpenChannel) *ArchivedChannel {
+	return &ArchivedChannel{
+		ChanPoint:          c.FundingOutpoint,
+		ShortChanID:        c.ShortChannelID,
+		ChainHash:          c.ChainHash,
+		Capacity:           c.Capacity,
+		ChanStatus:         c.chanStatus,
+		TotalMSatSent:      c.TotalMSatSent,
+		TotalMSatReceived:  c.TotalMSatReceived,
+		LocalBalance:       c.LocalCommitment.LocalBalance,
+		RemoteBalance:      c.LocalCommitment.RemoteBalance,
+		LocalCommitHeight:  c.LocalCommitment.CommitHeight,
+		RemoteCommitHeight: c.RemoteCommitment.CommitHeight,
+		NumLocalHtlcs:      uint16(len(c.LocalCommitment.Htlcs)),
+		NumRemoteHtlcs:     uint16(len(c.RemoteCommitment.Htlcs)),
+	}
+}
+
+// FetchArchivedChannel returns the snapshot of the final state of a closed
+// channel. If no snapshot was archived for the channel, for instance because
+// archival wasn't enabled at the time it was closed, then
+// ErrArchivedChannelNotFound is returned.
+func (d *DB) FetchArchivedChannel(chanPoint *wire.OutPoint) (*ArchivedChannel,
+	error) {
+
+	var archived *ArchivedChannel
+	err := d.View(func(tx *bbolt.Tx) error {
+		var err error
+		archived, err = fetchArchivedChannel(tx, chanPoint)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return archived, nil
+}
+
+// fetchArchivedChannel returns the snapshot of the final state of the closed
+// channel identified by chanPoint within the passed transaction.
+func fetchArchivedChannel(tx *bbolt.Tx,
+	chanPoint *wire.OutPoint) (*ArchivedChannel, error) {
+
+	archiveBucket := tx.Bucket(closedChannelArchiveBucket)
+	if archiveBucket == nil {
+		return nil, ErrArchivedChannelNotFound
+	}
+
+	var k bytes.Buffer
+	if err := writeOutpoint(&k, chanPoint); err != nil {
+		return nil, err
+	}
+
+	archivedBytes := archiveBucket.Get(k.Bytes())
+	if archivedBytes == nil {
+		return nil, ErrArchivedChannelNotFound
+	}
+
+	return deserializeArchivedChannel(bytes.NewReader(archivedBytes))
+}
+
+// putArchivedChannel archives a trimmed snapshot of the passed channel within
+// the passed transaction.
+func putArchivedChannel(tx *bbolt.Tx, chanPoint []byte,
+	c *OpenChannel) error {
+
+	archiveBucket, err := tx.CreateBucketIfNotExists(
+		closedChannelArchiveBucket,
+	)
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	if err := serializeArchivedChannel(&b, newArchivedChannel(c)); err != nil {
+		return err
+	}
+
+	return archiveBucket.Put(chanPoint, b.Bytes())
+}
+
+func serializeArchivedChannel(w io.Writer, a *ArchivedChannel) error {
+	return WriteElements(w,
+		a.ChanPoint, a.ShortChanID, a.ChainHash, a.Capacity,
+		a.ChanStatus, a.TotalMSatSent, a.TotalMSatReceived,
+		a.LocalBalance, a.RemoteBalance, a.LocalCommitHeight,
+		a.RemoteCommitHeight, a.NumLocalHtlcs, a.NumRemoteHtlcs,
+	)
+}
+
+func deserializeArchivedChannel(r io.Reader) (*ArchivedChannel, error) {
+	a := &ArchivedChannel{}
+	err := ReadElements(r,
+		&a.ChanPoint, &a.ShortChanID, &a.ChainHash, &a.Capacity,
+		&a.ChanStatus, &a.TotalMSatSent, &a.TotalMSatReceived,
+		&a.LocalBalance, &a.RemoteBalance, &a.LocalCommitHeight,
+		&a.RemoteCommitHeight, &a.NumLocalHtlcs, &a.NumRemoteHtlcs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
@@ -0,0 +1,73 @@
+package channeldb
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestArchiveClosedChannels asserts that a snapshot of the final state of a
+// channel is archived when it's closed only if archival is enabled.
+func TestArchiveClosedChannels(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+
+	// We'll close two channels, only the second of which is closed once
+	// archival has been enabled.
+	var channels []*OpenChannel
+	for i := 0; i < 2; i++ {
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+		if err := channel.MarkBorked(); err != nil {
+			t.Fatalf("unable to mark channel borked: %v", err)
+		}
+
+		cdb.archiveClosedChannels = i == 1
+		err = channel.CloseChannel(&ChannelCloseSummary{
+			ChanPoint: channel.FundingOutpoint,
+			RemotePub: channel.IdentityPub,
+		})
+		if err != nil {
+			t.Fatalf("unable to close channel: %v", err)
+		}
+
+		channels = append(channels, channel)
+	}
+
+	_, err = cdb.FetchArchivedChannel(&channels[0].FundingOutpoint)
+	if err != ErrArchivedChannelNotFound {
+		t.Fatalf("expected ErrArchivedChannelNotFound, got: %v", err)
+	}
+
+	archived, err := cdb.FetchArchivedChannel(&channels[1].FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to fetch archived channel: %v", err)
+	}
+
+	expected := newArchivedChannel(channels[1])
+	if expected.ChanStatus != ChanStatusBorked {
+		t.Fatalf("expected borked status to be archived, got %v",
+			expected.ChanStatus)
+	}
+	if !reflect.DeepEqual(expected, archived) {
+		t.Fatalf("archived channel mismatch: expected %v, got %v",
+			spew.Sdump(expected), spew.Sdump(archived))
+	}
+}
@@ -1,9 +1,17 @@
 package channeldb
 
+import "sync/atomic"
+
 // channelCache is an in-memory cache used to improve the performance of
 // ChanUpdatesInHorizon. It caches the chan info and edge policies for a
 // particular channel.
 type channelCache struct {
+	// hits and misses count the lookups served from and missed by the
+	// cache respectively. They must be accessed atomically, and are kept
+	// at the start of the struct to ensure 64-bit alignment.
+	hits   uint64
+	misses uint64
+
 	n        int
 	channels map[uint64]ChannelEdge
 }
@@ -48,3 +56,28 @@ func (c *channelCache) insert(chanid uint64, channel ChannelEdge) {
 func (c *channelCache) remove(chanid uint64) {
 	delete(c.channels, chanid)
 }
+
+// resize changes the maximum capacity of the cache to n channels, evicting
+// channels at random if the cache holds more than n channels.
+func (c *channelCache) resize(n int) {
+	channels := make(map[uint64]ChannelEdge)
+	for chanid, channel := range c.channels {
+		if len(channels) == n {
+			break
+		}
+		channels[chanid] = channel
+	}
+
+	c.n = n
+	c.channels = channels
+}
+
+// recordLookup records whether a lookup was served from the cache. This can be
+// called concurrently while only holding a shared lock.
+func (c *channelCache) recordLookup(hit bool) {
+	if hit {
+		atomic.AddUint64(&c.hits, 1)
+	} else {
+		atomic.AddUint64(&c.misses, 1)
+	}
+}
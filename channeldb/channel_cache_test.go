@@ -103,3 +103,35 @@ func channelForInt(i uint64) ChannelEdge {
 		},
 	}
 }
+
+// TestChannelCacheResize checks that resizing the channelCache evicts entries
+// down to the new capacity, and that the new capacity is respected on
+// subsequent insertions.
+func TestChannelCacheResize(t *testing.T) {
+	const cacheSize = 100
+
+	c := newChannelCache(cacheSize)
+	for i := uint64(0); i < cacheSize; i++ {
+		c.insert(i, channelForInt(i))
+	}
+
+	// Shrinking the cache should evict entries until it is at its new
+	// capacity.
+	c.resize(cacheSize / 2)
+	if len(c.channels) != cacheSize/2 {
+		t.Fatalf("expected %d entries, got: %d", cacheSize/2,
+			len(c.channels))
+	}
+	for i, channel := range c.channels {
+		if !reflect.DeepEqual(channel, channelForInt(i)) {
+			t.Fatalf("entry mismatch for %d", i)
+		}
+	}
+
+	// Further insertions should cause evictions at the new capacity.
+	c.insert(cacheSize, channelForInt(cacheSize))
+	if len(c.channels) != cacheSize/2 {
+		t.Fatalf("expected %d entries, got: %d", cacheSize/2,
+			len(c.channels))
+	}
+}
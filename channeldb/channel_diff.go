@@ -0,0 +1,190 @@
+package channeldb
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+// ChannelStatusChange describes a channel that is open within two databases,
+// but whose status differs between them.
+type ChannelStatusChange struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// OldStatus is the status of the channel within the older database.
+	OldStatus ChannelStatus
+
+	// NewStatus is the status of the channel within the newer database.
+	NewStatus ChannelStatus
+
+	// OldPending indicates whether the channel was still pending within
+	// the older database.
+	OldPending bool
+
+	// NewPending indicates whether the channel is still pending within
+	// the newer database.
+	NewPending bool
+}
+
+// ChannelDiff details how the set of channels changed between two databases
+// as returned by DiffChannelSets. All channels are sorted by their funding
+// outpoint.
+type ChannelDiff struct {
+	// Opened is the set of channels found within the newer database, either
+	// open or closed, that are unknown to the older database.
+	Opened []wire.OutPoint
+
+	// Closed is the set of channels that were closed within the newer
+	// database, but not the older one. This includes channels that are
+	// still in the process of being closed.
+	Closed []wire.OutPoint
+
+	// StatusChanged is the set of channels that are open within both
+	// databases, but whose status or pending state differ.
+	StatusChanged []ChannelStatusChange
+}
+
+// channelSet is the set of open and closed channels within a database, keyed
+// by their funding outpoint.
+type channelSet struct {
+	open   map[wire.OutPoint]*chanInfoHeader
+	closed map[wire.OutPoint]struct{}
+}
+
+// known returns true if the channel with the passed funding outpoint is either
+// open or closed within the set.
+func (s *channelSet) known(chanPoint wire.OutPoint) bool {
+	_, isOpen := s.open[chanPoint]
+	_, isClosed := s.closed[chanPoint]
+	return isOpen || isClosed
+}
+
+// fetchChannelSet reads the set of open and closed channels within the passed
+// database in a single read-only transaction. Only the static header of each
+// open channel is read, as that's all that's needed to compare them.
+func fetchChannelSet(d *DB) (*channelSet, error) {
+	var set *channelSet
+	err := d.View(func(tx *bbolt.Tx) error {
+		set = &channelSet{
+			open:   make(map[wire.OutPoint]*chanInfoHeader),
+			closed: make(map[wire.OutPoint]struct{}),
+		}
+
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket != nil {
+			err := forEachChanBucket(openChanBucket, func(_,
+				_ []byte, chanBucket *bbolt.Bucket) error {
+
+				header, err := fetchChanInfoHeader(chanBucket)
+				if err != nil {
+					return err
+				}
+				set.open[header.fundingOutpoint] = header
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		closeBucket := tx.Bucket(closedChannelBucket)
+		if closeBucket == nil {
+			return nil
+		}
+		return closeBucket.ForEach(func(k, _ []byte) error {
+			var chanPoint wire.OutPoint
+			err := readOutpoint(bytes.NewReader(k), &chanPoint)
+			if err != nil {
+				return err
+			}
+			set.closed[chanPoint] = struct{}{}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+// outPointLess returns true if outpoint a sorts before outpoint b.
+func outPointLess(a, b *wire.OutPoint) bool {
+	if cmp := bytes.Compare(a.Hash[:], b.Hash[:]); cmp != 0 {
+		return cmp < 0
+	}
+
+	return a.Index < b.Index
+}
+
+// DiffChannelSets compares the channels within two databases, such as two
+// snapshots of the same node taken at different times, by their funding
+// outpoint. It returns the channels that were opened or closed within newDB
+// relative to oldDB, along with the channels open within both whose status
+// changed. Both databases are only read from.
+func DiffChannelSets(oldDB, newDB *DB) (*ChannelDiff, error) {
+	oldSet, err := fetchChannelSet(oldDB)
+	if err != nil {
+		return nil, err
+	}
+	newSet, err := fetchChannelSet(newDB)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &ChannelDiff{}
+	for chanPoint, newHeader := range newSet.open {
+		if !oldSet.known(chanPoint) {
+			diff.Opened = append(diff.Opened, chanPoint)
+			continue
+		}
+
+		oldHeader, ok := oldSet.open[chanPoint]
+		if !ok {
+			continue
+		}
+		if oldHeader.chanStatus == newHeader.chanStatus &&
+			oldHeader.isPending == newHeader.isPending {
+
+			continue
+		}
+
+		diff.StatusChanged = append(
+			diff.StatusChanged, ChannelStatusChange{
+				ChanPoint:  chanPoint,
+				OldStatus:  oldHeader.chanStatus,
+				NewStatus:  newHeader.chanStatus,
+				OldPending: oldHeader.isPending,
+				NewPending: newHeader.isPending,
+			},
+		)
+	}
+	for chanPoint := range newSet.closed {
+		if !oldSet.known(chanPoint) {
+			diff.Opened = append(diff.Opened, chanPoint)
+		}
+		if _, ok := oldSet.closed[chanPoint]; !ok {
+			diff.Closed = append(diff.Closed, chanPoint)
+		}
+	}
+
+	sort.Slice(diff.Opened, func(i, j int) bool {
+		return outPointLess(&diff.Opened[i], &diff.Opened[j])
+	})
+	sort.Slice(diff.Closed, func(i, j int) bool {
+		return outPointLess(&diff.Closed[i], &diff.Closed[j])
+	})
+	sort.Slice(diff.StatusChanged, func(i, j int) bool {
+		return outPointLess(
+			&diff.StatusChanged[i].ChanPoint,
+			&diff.StatusChanged[j].ChanPoint,
+		)
+	})
+
+	return diff, nil
+}
@@ -0,0 +1,157 @@
+package channeldb
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestDiffChannelSets asserts that the channels opened, closed and changed
+// between two databases are reported by DiffChannelSets.
+func TestDiffChannelSets(t *testing.T) {
+	t.Parallel()
+
+	oldDB, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	newDB, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+
+	// addChannel writes a pending channel with the passed funding outpoint
+	// to the database. If no outpoint is given, a random one is used.
+	addChannel := func(db *DB, chanPoint *wire.OutPoint) *OpenChannel {
+		channel, err := createTestChannelState(db)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if chanPoint != nil {
+			channel.FundingOutpoint = *chanPoint
+		}
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+
+		return channel
+	}
+	closeChannel := func(channel *OpenChannel) {
+		err := channel.CloseChannel(&ChannelCloseSummary{
+			ChanPoint: channel.FundingOutpoint,
+			RemotePub: channel.IdentityPub,
+		})
+		if err != nil {
+			t.Fatalf("unable to close channel: %v", err)
+		}
+	}
+
+	// The first channel is pending within the old database, and has been
+	// confirmed within the new one.
+	confirmedOld := addChannel(oldDB, nil)
+	confirmedNew := addChannel(newDB, &confirmedOld.FundingOutpoint)
+	openLoc := lnwire.NewShortChanIDFromInt(10)
+	if err := confirmedNew.MarkAsOpen(openLoc); err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+
+	// The second channel has been marked borked within the new database.
+	borkedOld := addChannel(oldDB, nil)
+	borkedNew := addChannel(newDB, &borkedOld.FundingOutpoint)
+	if err := borkedNew.MarkBorked(); err != nil {
+		t.Fatalf("unable to mark channel borked: %v", err)
+	}
+
+	// The third channel has been closed within the new database.
+	closedOld := addChannel(oldDB, nil)
+	closedNew := addChannel(newDB, &closedOld.FundingOutpoint)
+	closeChannel(closedNew)
+
+	// The fourth channel is unchanged across both databases.
+	unchanged := addChannel(oldDB, nil)
+	addChannel(newDB, &unchanged.FundingOutpoint)
+
+	// Finally, the last two channels only exist within the new database,
+	// with one of them having been closed already.
+	opened := addChannel(newDB, nil)
+	openedAndClosed := addChannel(newDB, nil)
+	closeChannel(openedAndClosed)
+
+	diff, err := DiffChannelSets(oldDB, newDB)
+	if err != nil {
+		t.Fatalf("unable to diff channel sets: %v", err)
+	}
+
+	expectedOpened := []wire.OutPoint{
+		opened.FundingOutpoint, openedAndClosed.FundingOutpoint,
+	}
+	if outPointLess(&expectedOpened[1], &expectedOpened[0]) {
+		expectedOpened[0], expectedOpened[1] = expectedOpened[1],
+			expectedOpened[0]
+	}
+	if !reflect.DeepEqual(diff.Opened, expectedOpened) {
+		t.Fatalf("expected opened channels %v, got %v",
+			expectedOpened, diff.Opened)
+	}
+
+	expectedClosed := []wire.OutPoint{
+		closedOld.FundingOutpoint, openedAndClosed.FundingOutpoint,
+	}
+	if outPointLess(&expectedClosed[1], &expectedClosed[0]) {
+		expectedClosed[0], expectedClosed[1] = expectedClosed[1],
+			expectedClosed[0]
+	}
+	if !reflect.DeepEqual(diff.Closed, expectedClosed) {
+		t.Fatalf("expected closed channels %v, got %v",
+			expectedClosed, diff.Closed)
+	}
+
+	expectedChanges := []ChannelStatusChange{
+		{
+			ChanPoint:  confirmedOld.FundingOutpoint,
+			OldStatus:  ChanStatusDefault,
+			NewStatus:  ChanStatusDefault,
+			OldPending: true,
+			NewPending: false,
+		},
+		{
+			ChanPoint:  borkedOld.FundingOutpoint,
+			OldStatus:  ChanStatusDefault,
+			NewStatus:  ChanStatusBorked,
+			OldPending: true,
+			NewPending: true,
+		},
+	}
+	if outPointLess(&expectedChanges[1].ChanPoint,
+		&expectedChanges[0].ChanPoint) {
+
+		expectedChanges[0], expectedChanges[1] = expectedChanges[1],
+			expectedChanges[0]
+	}
+	if !reflect.DeepEqual(diff.StatusChanged, expectedChanges) {
+		t.Fatalf("expected status changes %v, got %v",
+			spew.Sdump(expectedChanges),
+			spew.Sdump(diff.StatusChanged))
+	}
+
+	// Diffing a database against itself should yield no differences.
+	diff, err = DiffChannelSets(newDB, newDB)
+	if err != nil {
+		t.Fatalf("unable to diff channel sets: %v", err)
+	}
+	if !reflect.DeepEqual(diff, &ChannelDiff{}) {
+		t.Fatalf("expected empty diff, got %v", spew.Sdump(diff))
+	}
+}
@@ -0,0 +1,155 @@
+package channeldb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// channelEventLogBucket is the top-level bucket housing an append-only
+	// log of the status transitions of each channel. Within it, every
+	// channel has a nested bucket keyed by its outpoint, which maps a
+	// big-endian sequence number to each serialized event:
+	//
+	// channelEventLog -> chanPoint -> seqNum -> event
+	channelEventLogBucket = []byte("channel-event-log")
+)
+
+// ChannelEvent records a single transition of the status of a channel.
+type ChannelEvent struct {
+	// Timestamp is the time at which the transition was logged.
+	Timestamp time.Time
+
+	// OldStatus is the status of the channel prior to the transition.
+	OldStatus ChannelStatus
+
+	// NewStatus is the status of the channel after the transition.
+	NewStatus ChannelStatus
+}
+
+// LogChannelEvent appends a transition from oldStatus to newStatus to the
+// event log of the target channel. Status transitions made through the
+// database are logged automatically, so this only needs to be called by
+// callers that wish to record a transition made elsewhere.
+func (d *DB) LogChannelEvent(chanPoint *wire.OutPoint, oldStatus,
+	newStatus ChannelStatus) error {
+
+	return d.Update(func(tx *bbolt.Tx) error {
+		return logChannelEvent(
+			tx, chanPoint, oldStatus, newStatus, d.now(),
+		)
+	})
+}
+
+// FetchChannelEvents returns the logged status transitions of the target
+// channel in the order in which they were logged. If no transitions have been
+// logged for the channel, or the event log itself hasn't been created yet,
+// then an empty slice is returned.
+func (d *DB) FetchChannelEvents(chanPoint *wire.OutPoint) ([]ChannelEvent,
+	error) {
+
+	var events []ChannelEvent
+	err := d.View(func(tx *bbolt.Tx) error {
+		eventLog := tx.Bucket(channelEventLogBucket)
+		if eventLog == nil {
+			return nil
+		}
+
+		var k bytes.Buffer
+		if err := writeOutpoint(&k, chanPoint); err != nil {
+			return err
+		}
+
+		chanEvents := eventLog.Bucket(k.Bytes())
+		if chanEvents == nil {
+			return nil
+		}
+
+		return chanEvents.ForEach(func(_, v []byte) error {
+			event, err := deserializeChannelEvent(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			events = append(events, event)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// logChannelEvent appends a status transition, made at the passed time, to
+// the event log of the target channel within the passed transaction.
+func logChannelEvent(tx *bbolt.Tx, chanPoint *wire.OutPoint, oldStatus,
+	newStatus ChannelStatus, timestamp time.Time) error {
+
+	eventLog, err := tx.CreateBucketIfNotExists(channelEventLogBucket)
+	if err != nil {
+		return err
+	}
+
+	var k bytes.Buffer
+	if err := writeOutpoint(&k, chanPoint); err != nil {
+		return err
+	}
+
+	chanEvents, err := eventLog.CreateBucketIfNotExists(k.Bytes())
+	if err != nil {
+		return err
+	}
+
+	// Events are keyed by the bucket's sequence number, which ensures
+	// they're iterated over in the order they were logged.
+	seqNum, err := chanEvents.NextSequence()
+	if err != nil {
+		return err
+	}
+	var seqKey [8]byte
+	byteOrder.PutUint64(seqKey[:], seqNum)
+
+	event := ChannelEvent{
+		Timestamp: timestamp,
+		OldStatus: oldStatus,
+		NewStatus: newStatus,
+	}
+
+	var v bytes.Buffer
+	if err := serializeChannelEvent(&v, &event); err != nil {
+		return err
+	}
+
+	return chanEvents.Put(seqKey[:], v.Bytes())
+}
+
+func serializeChannelEvent(w io.Writer, event *ChannelEvent) error {
+	return WriteElements(w,
+		uint64(event.Timestamp.UnixNano()), event.OldStatus,
+		event.NewStatus,
+	)
+}
+
+func deserializeChannelEvent(r io.Reader) (ChannelEvent, error) {
+	var (
+		event    ChannelEvent
+		unixNano uint64
+	)
+	err := ReadElements(r, &unixNano, &event.OldStatus, &event.NewStatus)
+	if err != nil {
+		return event, fmt.Errorf("unable to read channel event: %v",
+			err)
+	}
+	event.Timestamp = time.Unix(0, int64(unixNano))
+
+	return event, nil
+}
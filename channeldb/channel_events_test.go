@@ -0,0 +1,172 @@
+package channeldb
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+// TestChannelEventLog asserts that status transitions of a channel are logged
+// in order, that no-op transitions aren't logged, and that events can be
+// logged manually.
+func TestChannelEventLog(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	now := time.Unix(1000000, 0)
+	cdb.now = func() time.Time {
+		return now
+	}
+
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channel.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+	chanPoint := channel.FundingOutpoint
+
+	// A channel that hasn't transitioned yet has no events.
+	events, err := cdb.FetchChannelEvents(&chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch channel events: %v", err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("expected no events, got %v", len(events))
+	}
+
+	// We'll mark the channel as borked twice, only the first of which
+	// modifies its status, then clear it through the DB.
+	if err := channel.MarkBorked(); err != nil {
+		t.Fatalf("unable to mark channel borked: %v", err)
+	}
+	if err := channel.MarkBorked(); err != nil {
+		t.Fatalf("unable to mark channel borked: %v", err)
+	}
+	err = cdb.SetChannelStatus(chanPoint, ChanStatusBorked, false)
+	if err != nil {
+		t.Fatalf("unable to clear channel status: %v", err)
+	}
+
+	// Finally, we'll log an event manually.
+	err = cdb.LogChannelEvent(
+		&chanPoint, ChanStatusDefault, ChanStatusLocalDataLoss,
+	)
+	if err != nil {
+		t.Fatalf("unable to log channel event: %v", err)
+	}
+
+	expected := []ChannelEvent{
+		{
+			OldStatus: ChanStatusDefault,
+			NewStatus: ChanStatusBorked,
+		},
+		{
+			OldStatus: ChanStatusBorked,
+			NewStatus: ChanStatusDefault,
+		},
+		{
+			OldStatus: ChanStatusDefault,
+			NewStatus: ChanStatusLocalDataLoss,
+		},
+	}
+
+	events, err = cdb.FetchChannelEvents(&chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch channel events: %v", err)
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("expected %v events, got %v", len(expected),
+			len(events))
+	}
+	for i, event := range events {
+		if event.OldStatus != expected[i].OldStatus ||
+			event.NewStatus != expected[i].NewStatus {
+
+			t.Fatalf("event %v: expected %v -> %v, got %v -> %v",
+				i, expected[i].OldStatus, expected[i].NewStatus,
+				event.OldStatus, event.NewStatus)
+		}
+		if !event.Timestamp.Equal(now) {
+			t.Fatalf("event %v: unexpected timestamp %v", i,
+				event.Timestamp)
+		}
+	}
+
+	// Events of other channels shouldn't be returned.
+	events, err = cdb.FetchChannelEvents(&wire.OutPoint{Index: 99})
+	if err != nil {
+		t.Fatalf("unable to fetch channel events: %v", err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("expected no events, got %v", len(events))
+	}
+
+	// Nor should any events be returned once the log itself is gone.
+	err = cdb.Update(func(tx *bbolt.Tx) error {
+		return tx.DeleteBucket(channelEventLogBucket)
+	})
+	if err != nil {
+		t.Fatalf("unable to delete event log bucket: %v", err)
+	}
+	events, err = cdb.FetchChannelEvents(&chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch channel events: %v", err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("expected no events, got %v", len(events))
+	}
+}
+
+// TestChannelEventLogAfterWipe asserts that status transitions are still
+// logged once the database has been wiped, which removes the event log.
+func TestChannelEventLogAfterWipe(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	if err := cdb.Wipe(); err != nil {
+		t.Fatalf("unable to wipe channeldb: %v", err)
+	}
+
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channel.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+
+	if err := channel.MarkBorked(); err != nil {
+		t.Fatalf("unable to mark channel borked: %v", err)
+	}
+
+	events, err := cdb.FetchChannelEvents(&channel.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to fetch channel events: %v", err)
+	}
+	if len(events) != 1 || events[0].NewStatus != ChanStatusBorked {
+		t.Fatalf("unexpected events: %v", events)
+	}
+}
@@ -0,0 +1,80 @@
+package channeldb
+
+import (
+	"time"
+
+	"github.com/coreos/bbolt"
+)
+
+// chanLastModifiedKey is the key within the bucket of each open channel that
+// stores the time the state of the channel was last modified, as the number
+// of nanoseconds since the unix epoch. Channels that haven't been modified
+// since this key was introduced don't have it set.
+var chanLastModifiedKey = []byte("chan-last-modified-key")
+
+// putChanLastModified records the passed time as the time the channel stored
+// within the passed bucket was last modified.
+func putChanLastModified(chanBucket *bbolt.Bucket, modified time.Time) error {
+	var b [8]byte
+	byteOrder.PutUint64(b[:], uint64(modified.UnixNano()))
+
+	return chanBucket.Put(chanLastModifiedKey, b[:])
+}
+
+// fetchChanLastModified returns the time the channel stored within the passed
+// bucket was last modified, and whether it has been recorded at all.
+func fetchChanLastModified(chanBucket *bbolt.Bucket) (time.Time, bool) {
+	b := chanBucket.Get(chanLastModifiedKey)
+	if len(b) != 8 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(0, int64(byteOrder.Uint64(b))), true
+}
+
+// FetchChannelsUpdatedSince returns all open channels, including those that
+// are still pending, whose state was modified strictly after the passed time.
+// This allows incremental backups to skip the channels that haven't changed
+// since the last backup was taken. Channels whose time of modification hasn't
+// been recorded, as they haven't been modified since the database was
+// upgraded, are always returned, as they may have changed at any time.
+func (d *DB) FetchChannelsUpdatedSince(t time.Time) ([]*OpenChannel, error) {
+	var channels []*OpenChannel
+	err := d.View(func(tx *bbolt.Tx) error {
+		channels = nil
+
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket == nil {
+			return nil
+		}
+
+		return forEachChanBucket(openChanBucket, func(_, _ []byte,
+			chanBucket *bbolt.Bucket) error {
+
+			modified, ok := fetchChanLastModified(chanBucket)
+			if ok && !modified.After(t) {
+				return nil
+			}
+
+			header, err := fetchChanInfoHeader(chanBucket)
+			if err != nil {
+				return err
+			}
+			channel, err := fetchOpenChannel(
+				chanBucket, &header.fundingOutpoint,
+			)
+			if err != nil {
+				return err
+			}
+			channel.Db = d
+
+			channels = append(channels, channel)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
@@ -0,0 +1,103 @@
+package channeldb
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestFetchChannelsUpdatedSince asserts that only the channels modified after
+// the queried time are returned, along with channels whose time of
+// modification hasn't been recorded.
+func TestFetchChannelsUpdatedSince(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	now := time.Unix(1000, 0)
+	cdb.now = func() time.Time {
+		return now
+	}
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	var chanPoints []wire.OutPoint
+	for i := 0; i < 3; i++ {
+		now = time.Unix(int64(1000+i*100), 0)
+
+		state.FundingOutpoint.Index = uint32(i)
+		if err := state.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to save and serialize channel "+
+				"state: %v", err)
+		}
+		chanPoints = append(chanPoints, state.FundingOutpoint)
+	}
+
+	assertUpdated := func(since time.Time, expected ...wire.OutPoint) {
+		t.Helper()
+
+		channels, err := cdb.FetchChannelsUpdatedSince(since)
+		if err != nil {
+			t.Fatalf("unable to fetch updated channels: %v", err)
+		}
+
+		updated := make(map[wire.OutPoint]struct{})
+		for _, channel := range channels {
+			updated[channel.FundingOutpoint] = struct{}{}
+		}
+		if len(updated) != len(expected) {
+			t.Fatalf("since %v: expected %v channels, got %v",
+				since.Unix(), len(expected), len(updated))
+		}
+		for _, chanPoint := range expected {
+			if _, ok := updated[chanPoint]; !ok {
+				t.Fatalf("since %v: expected channel %v",
+					since.Unix(), chanPoint)
+			}
+		}
+	}
+
+	// A channel modified at exactly the queried time isn't modified after
+	// it.
+	assertUpdated(time.Unix(999, 0), chanPoints...)
+	assertUpdated(time.Unix(1100, 0), chanPoints[2])
+	assertUpdated(time.Unix(1200, 0))
+
+	// Modifying the first channel should cause it to be returned again.
+	now = time.Unix(1300, 0)
+	state.FundingOutpoint = chanPoints[0]
+	err = state.MarkAsOpen(lnwire.NewShortChanIDFromInt(1))
+	if err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+	assertUpdated(time.Unix(1200, 0), chanPoints[0])
+
+	// A channel without a recorded time of modification should always be
+	// returned.
+	err = cdb.Update(func(tx *bbolt.Tx) error {
+		chanBucket, err := findChanBucket(tx, &chanPoints[1])
+		if err != nil {
+			return err
+		}
+		return chanBucket.Delete(chanLastModifiedKey)
+	})
+	if err != nil {
+		t.Fatalf("unable to delete last modified time: %v", err)
+	}
+	assertUpdated(time.Unix(1300, 0), chanPoints[1])
+}
@@ -0,0 +1,101 @@
+package channeldb
+
+import (
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+// ChannelPendingEvent is sent to subscribers once a new pending channel has
+// been written to the database.
+type ChannelPendingEvent struct {
+	// ChanPoint is the outpoint of the funding transaction of the channel.
+	ChanPoint wire.OutPoint
+
+	// RemotePub is the identity public key of the channel peer.
+	RemotePub *btcec.PublicKey
+}
+
+// ChannelOpenedEvent is sent to subscribers once a pending channel has been
+// marked as open within the database.
+type ChannelOpenedEvent struct {
+	// ChanPoint is the outpoint of the funding transaction of the channel.
+	ChanPoint wire.OutPoint
+
+	// ShortChanID describes the location of the confirmed funding output
+	// within the chain.
+	ShortChanID lnwire.ShortChannelID
+}
+
+// ChannelClosedEvent is sent to subscribers once a channel has been fully
+// closed within the database.
+type ChannelClosedEvent struct {
+	// CloseSummary is the summary of the closed channel.
+	CloseSummary *ChannelCloseSummary
+}
+
+// SubscribeChannelEvents returns a client that is notified of the channels
+// that are written to, opened and fully closed within the database. Each
+// event is delivered once the transaction carrying out the mutation has been
+// committed. Events are queued for each client without bound, such that a
+// slow client doesn't block writes to the database. The client should be
+// cancelled once it's no longer interested in events.
+func (d *DB) SubscribeChannelEvents() (*subscribe.Client, error) {
+	d.chanNtfnMtx.Lock()
+	if d.chanNtfnServer == nil {
+		d.chanNtfnServer = subscribe.NewServer()
+		if err := d.chanNtfnServer.Start(); err != nil {
+			d.chanNtfnMtx.Unlock()
+			return nil, err
+		}
+	}
+	server := d.chanNtfnServer
+	d.chanNtfnMtx.Unlock()
+
+	return server.Subscribe()
+}
+
+// Close stops the delivery of channel events to any subscribers, then closes
+// the underlying database.
+func (d *DB) Close() error {
+	d.chanNtfnMtx.Lock()
+	if d.chanNtfnServer != nil {
+		if err := d.chanNtfnServer.Stop(); err != nil {
+			log.Errorf("Unable to stop channel event server: %v",
+				err)
+		}
+		d.chanNtfnServer = nil
+	}
+	d.chanNtfnMtx.Unlock()
+
+	return d.DB.Close()
+}
+
+// notifyChannelEvent delivers the passed event to all subscribers, if any.
+func (d *DB) notifyChannelEvent(event interface{}) {
+	d.chanNtfnMtx.Lock()
+	server := d.chanNtfnServer
+	d.chanNtfnMtx.Unlock()
+
+	if server == nil {
+		return
+	}
+
+	if err := server.SendUpdate(event); err != nil {
+		log.Debugf("Unable to deliver channel event %T: %v", event,
+			err)
+	}
+}
+
+// notifyChannelClosed delivers a ChannelClosedEvent for the passed summary to
+// all subscribers, if the channel has been fully closed.
+func (d *DB) notifyChannelClosed(summary *ChannelCloseSummary) {
+	if summary.IsPending {
+		return
+	}
+
+	d.notifyChannelEvent(&ChannelClosedEvent{
+		CloseSummary: summary,
+	})
+}
@@ -0,0 +1,182 @@
+package channeldb
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+// TestSubscribeChannelEvents asserts that subscribers are notified of channels
+// being written, opened and fully closed within the database.
+func TestSubscribeChannelEvents(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	client, err := cdb.SubscribeChannelEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe to channel events: %v", err)
+	}
+	defer client.Cancel()
+
+	nextEvent := func() interface{} {
+		t.Helper()
+
+		select {
+		case event := <-client.Updates():
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no channel event received")
+			return nil
+		}
+	}
+	assertNoEvent := func() {
+		t.Helper()
+
+		select {
+		case event := <-client.Updates():
+			t.Fatalf("unexpected channel event: %v", event)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channel.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+	pendingEvent, ok := nextEvent().(*ChannelPendingEvent)
+	if !ok {
+		t.Fatalf("expected pending channel event")
+	}
+	if pendingEvent.ChanPoint != channel.FundingOutpoint ||
+		!pendingEvent.RemotePub.IsEqual(channel.IdentityPub) {
+
+		t.Fatalf("unexpected pending channel event: %v", pendingEvent)
+	}
+
+	shortChanID := lnwire.NewShortChanIDFromInt(99)
+	if err := channel.MarkAsOpen(shortChanID); err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+	openedEvent, ok := nextEvent().(*ChannelOpenedEvent)
+	if !ok {
+		t.Fatalf("expected opened channel event")
+	}
+	if openedEvent.ChanPoint != channel.FundingOutpoint ||
+		openedEvent.ShortChanID != shortChanID {
+
+		t.Fatalf("unexpected opened channel event: %v", openedEvent)
+	}
+
+	// Closing the channel while the close is still pending shouldn't
+	// result in an event, as it's yet to be fully closed.
+	err = channel.CloseChannel(&ChannelCloseSummary{
+		ChanPoint: channel.FundingOutpoint,
+		RemotePub: channel.IdentityPub,
+		IsPending: true,
+	})
+	if err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+	assertNoEvent()
+
+	if err := cdb.MarkChanFullyClosed(&channel.FundingOutpoint); err != nil {
+		t.Fatalf("unable to mark channel fully closed: %v", err)
+	}
+	closedEvent, ok := nextEvent().(*ChannelClosedEvent)
+	if !ok {
+		t.Fatalf("expected closed channel event")
+	}
+	if closedEvent.CloseSummary.ChanPoint != channel.FundingOutpoint ||
+		closedEvent.CloseSummary.IsPending {
+
+		t.Fatalf("unexpected closed channel event: %v",
+			closedEvent.CloseSummary)
+	}
+
+	// Once the client is cancelled, it should no longer receive events,
+	// and writes to the database shouldn't block.
+	client.Cancel()
+	select {
+	case <-client.Quit():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("client not cancelled")
+	}
+
+	channel, err = createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	if err := channel.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+}
+
+// TestSubscribeChannelEventsSlowClient asserts that a client that doesn't
+// consume its events doesn't block writes to the database.
+func TestSubscribeChannelEventsSlowClient(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	var clients []*subscribe.Client
+	for i := 0; i < 2; i++ {
+		client, err := cdb.SubscribeChannelEvents()
+		if err != nil {
+			t.Fatalf("unable to subscribe to channel events: %v",
+				err)
+		}
+		defer client.Cancel()
+
+		clients = append(clients, client)
+	}
+
+	// We'll write more channels than the clients buffer in memory
+	// without ever reading their events.
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	const numChannels = 50
+	for i := 0; i < numChannels; i++ {
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+	}
+
+	// All events should've been queued for each of the clients.
+	for _, client := range clients {
+		for i := 0; i < numChannels; i++ {
+			select {
+			case event := <-client.Updates():
+				if _, ok := event.(*ChannelPendingEvent); !ok {
+					t.Fatalf("unexpected event: %v", event)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("channel event %d not received", i)
+			}
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package channeldb
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+// chanOpenHeightBucket is a top-level bucket indexing open channels by the
+// height of the block their funding transaction confirmed in. Channels are
+// added to the index once they transition from pending to open, and removed
+// once they're closed.
+//
+// chanOpenHeight -> height || chanPoint -> nodePub || chainHash
+var chanOpenHeightBucket = []byte("chan-open-height-index")
+
+// chanOpenHeightKey returns the key of the channel with the passed serialized
+// channel point within the open height index.
+func chanOpenHeightKey(height uint32, chanPoint []byte) []byte {
+	key := make([]byte, 4+len(chanPoint))
+	byteOrder.PutUint32(key[:4], height)
+	copy(key[4:], chanPoint)
+
+	return key
+}
+
+// putChanOpenHeight adds the passed channel to the open height index, keyed
+// by the block height of its short channel ID.
+func putChanOpenHeight(tx *bbolt.Tx, channel *OpenChannel) error {
+	heightIndex, err := tx.CreateBucketIfNotExists(chanOpenHeightBucket)
+	if err != nil {
+		return err
+	}
+
+	var chanPointBuf bytes.Buffer
+	err = writeOutpoint(&chanPointBuf, &channel.FundingOutpoint)
+	if err != nil {
+		return err
+	}
+	key := chanOpenHeightKey(
+		channel.ShortChannelID.BlockHeight, chanPointBuf.Bytes(),
+	)
+
+	var value bytes.Buffer
+	value.Write(channel.IdentityPub.SerializeCompressed())
+	value.Write(channel.ChainHash[:])
+
+	return heightIndex.Put(key, value.Bytes())
+}
+
+// removeChanOpenHeight removes the channel with the passed serialized channel
+// point from the open height index, if it's present.
+func removeChanOpenHeight(tx *bbolt.Tx, height uint32, chanPoint []byte) error {
+	heightIndex := tx.Bucket(chanOpenHeightBucket)
+	if heightIndex == nil {
+		return nil
+	}
+
+	return heightIndex.Delete(chanOpenHeightKey(height, chanPoint))
+}
+
+// FetchChannelsOpenedInRange returns all open channels whose funding
+// transaction confirmed in a block within the inclusive height range
+// [start, end], ordered by their confirmation height. Pending channels aren't
+// returned, as their funding transaction is yet to confirm.
+func (d *DB) FetchChannelsOpenedInRange(start,
+	end uint32) ([]*OpenChannel, error) {
+
+	var channels []*OpenChannel
+	err := d.View(func(tx *bbolt.Tx) error {
+		heightIndex := tx.Bucket(chanOpenHeightBucket)
+		if heightIndex == nil {
+			return nil
+		}
+
+		var startKey [4]byte
+		byteOrder.PutUint32(startKey[:], start)
+
+		cursor := heightIndex.Cursor()
+		k, v := cursor.Seek(startKey[:])
+		for ; k != nil; k, v = cursor.Next() {
+			if byteOrder.Uint32(k[:4]) > end {
+				break
+			}
+
+			var chanPoint wire.OutPoint
+			err := readOutpoint(bytes.NewReader(k[4:]), &chanPoint)
+			if err != nil {
+				return err
+			}
+
+			nodePub, err := btcec.ParsePubKey(v[:33], btcec.S256())
+			if err != nil {
+				return err
+			}
+			var chainHash chainhash.Hash
+			copy(chainHash[:], v[33:])
+
+			chanBucket, err := fetchChanBucket(
+				tx, nodePub, &chanPoint, chainHash,
+			)
+			if err != nil {
+				return err
+			}
+
+			channel, err := fetchOpenChannel(chanBucket, &chanPoint)
+			if err != nil {
+				return err
+			}
+			channel.Db = d
+
+			channels = append(channels, channel)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
@@ -0,0 +1,205 @@
+package channeldb
+
+import (
+	"net"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/channeldb/migration14"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// assertChannelsOpenedInRange asserts that the channels opened within the
+// passed height range are exactly the expected ones, ordered by their open
+// height.
+func assertChannelsOpenedInRange(t *testing.T, cdb *DB, start, end uint32,
+	expected ...*OpenChannel) {
+
+	t.Helper()
+
+	channels, err := cdb.FetchChannelsOpenedInRange(start, end)
+	if err != nil {
+		t.Fatalf("unable to fetch channels opened in range: %v", err)
+	}
+	if len(channels) != len(expected) {
+		t.Fatalf("expected %d channels opened in [%d, %d], got %d",
+			len(expected), start, end, len(channels))
+	}
+
+	expectedChans := make(map[wire.OutPoint]struct{})
+	for _, channel := range expected {
+		expectedChans[channel.FundingOutpoint] = struct{}{}
+	}
+	for i, channel := range channels {
+		if _, ok := expectedChans[channel.FundingOutpoint]; !ok {
+			t.Fatalf("unexpected channel %v opened in [%d, %d]",
+				channel.FundingOutpoint, start, end)
+		}
+
+		if i == 0 {
+			continue
+		}
+		prevHeight := channels[i-1].ShortChannelID.BlockHeight
+		if channel.ShortChannelID.BlockHeight < prevHeight {
+			t.Fatalf("channels not ordered by open height")
+		}
+	}
+}
+
+// TestFetchChannelsOpenedInRange asserts that channels are indexed by their
+// open height once their funding transaction confirms, and removed from the
+// index once they're closed.
+func TestFetchChannelsOpenedInRange(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+
+	// We'll create four pending channels, opening all but the last of
+	// them at increasing heights.
+	heights := []uint32{100, 200, 300}
+	var channels []*OpenChannel
+	for i := 0; i < len(heights)+1; i++ {
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+
+		channels = append(channels, channel)
+	}
+	for i, height := range heights {
+		openLoc := lnwire.ShortChannelID{BlockHeight: height}
+		if err := channels[i].MarkAsOpen(openLoc); err != nil {
+			t.Fatalf("unable to mark channel open: %v", err)
+		}
+	}
+
+	// The pending channel shouldn't be returned for any range.
+	assertChannelsOpenedInRange(t, cdb, 0, ^uint32(0), channels[:3]...)
+	assertChannelsOpenedInRange(t, cdb, 100, 200, channels[:2]...)
+	assertChannelsOpenedInRange(t, cdb, 150, 300, channels[1:3]...)
+	assertChannelsOpenedInRange(t, cdb, 301, 1000)
+	assertChannelsOpenedInRange(t, cdb, 200, 100)
+
+	// Opening the remaining channel through the DB should index it as
+	// well.
+	err = cdb.MarkChannelOpen(
+		channels[3].FundingOutpoint,
+		lnwire.ShortChannelID{BlockHeight: 200},
+	)
+	if err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+	assertChannelsOpenedInRange(t, cdb, 200, 200, channels[1], channels[3])
+
+	// Moving a channel to a new location should replace its entry within
+	// the index.
+	openLoc := lnwire.ShortChannelID{BlockHeight: 400}
+	if err := channels[0].MarkAsOpen(openLoc); err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+	assertChannelsOpenedInRange(t, cdb, 0, 100)
+	assertChannelsOpenedInRange(t, cdb, 400, 400, channels[0])
+
+	// Finally, once a channel is closed, it should be removed from the
+	// index.
+	err = channels[1].CloseChannel(&ChannelCloseSummary{
+		ChanPoint: channels[1].FundingOutpoint,
+		RemotePub: channels[1].IdentityPub,
+	})
+	if err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+	assertChannelsOpenedInRange(
+		t, cdb, 0, ^uint32(0), channels[3], channels[2], channels[0],
+	)
+}
+
+// TestMigrateChannelOpenHeightIndex asserts that the migration creating the
+// channel open height index backfills it with all channels that are no longer
+// pending.
+func TestMigrateChannelOpenHeightIndex(t *testing.T) {
+	t.Parallel()
+
+	var openChan *OpenChannel
+	beforeMigration := func(d *DB) {
+		addr := &net.TCPAddr{
+			IP:   net.ParseIP("127.0.0.1"),
+			Port: 18555,
+		}
+
+		var err error
+		openChan, err = createTestChannelState(d)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		openChan.IsPending = false
+		openChan.ShortChannelID = lnwire.ShortChannelID{
+			BlockHeight: 500,
+			TxIndex:     3,
+		}
+		if err := openChan.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync channel: %v", err)
+		}
+
+		pendingChan, err := createTestChannelState(d)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if err := pendingChan.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync channel: %v", err)
+		}
+
+		err = d.Update(func(tx *bbolt.Tx) error {
+			return tx.DeleteBucket(chanOpenHeightBucket)
+		})
+		if err != nil {
+			t.Fatalf("unable to delete open height index: %v", err)
+		}
+	}
+
+	afterMigration := func(d *DB) {
+		channels, err := d.FetchChannelsOpenedInRange(0, ^uint32(0))
+		if err != nil {
+			t.Fatalf("unable to fetch channels opened in range: %v",
+				err)
+		}
+		if len(channels) != 1 {
+			t.Fatalf("expected 1 indexed channel, got %d",
+				len(channels))
+		}
+
+		if channels[0].FundingOutpoint != openChan.FundingOutpoint {
+			t.Fatalf("expected channel %v, got %v",
+				openChan.FundingOutpoint,
+				channels[0].FundingOutpoint)
+		}
+
+		channels, err = d.FetchChannelsOpenedInRange(501, ^uint32(0))
+		if err != nil {
+			t.Fatalf("unable to fetch channels opened in range: %v",
+				err)
+		}
+		if len(channels) != 0 {
+			t.Fatalf("expected no channels, got %d", len(channels))
+		}
+	}
+
+	applyMigration(t,
+		beforeMigration,
+		afterMigration,
+		migration14.CreateChannelOpenHeightIndex,
+		false)
+}
@@ -0,0 +1,174 @@
+package channeldb
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// chanResolutionsBucket is a top-level bucket tracking the outputs of
+	// closed channels that are still pending a sweep. Each channel with
+	// unresolved outputs has a sub-bucket, which is removed once all of
+	// its outputs have been resolved. It's created on demand when the
+	// first unresolved output is added.
+	//
+	// chanResolutions -> chanPoint -> outpoint -> empty
+	chanResolutionsBucket = []byte("channel-resolutions")
+)
+
+// AddUnresolvedOutputs records the passed outputs of the channel identified by
+// chanPoint as pending a sweep, such as the outputs of a force closed channel
+// that are yet to be resolved on-chain. Adding an output that's already
+// tracked is a no-op. Until all of these outputs are marked as resolved using
+// MarkOutputResolved, the channel can't be marked as fully closed.
+func (d *DB) AddUnresolvedOutputs(chanPoint wire.OutPoint,
+	outputs ...wire.OutPoint) error {
+
+	if len(outputs) == 0 {
+		return nil
+	}
+
+	return d.Update(func(tx *bbolt.Tx) error {
+		resolutions, err := tx.CreateBucketIfNotExists(
+			chanResolutionsBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		var chanPointBuf bytes.Buffer
+		if err := writeOutpoint(&chanPointBuf, &chanPoint); err != nil {
+			return err
+		}
+		chanOutputs, err := resolutions.CreateBucketIfNotExists(
+			chanPointBuf.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+
+		for i := range outputs {
+			var output bytes.Buffer
+			err := writeOutpoint(&output, &outputs[i])
+			if err != nil {
+				return err
+			}
+			err = chanOutputs.Put(output.Bytes(), []byte{})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// MarkOutputResolved marks the passed output of the channel identified by
+// chanPoint as resolved, removing it from the set of outputs pending a sweep.
+// If the output isn't tracked as unresolved, then ErrUnresolvedOutputNotFound
+// is returned.
+func (d *DB) MarkOutputResolved(chanPoint, output wire.OutPoint) error {
+	return d.Update(func(tx *bbolt.Tx) error {
+		chanOutputs, err := fetchChanResolutions(tx, &chanPoint)
+		if err != nil {
+			return err
+		}
+		if chanOutputs == nil {
+			return ErrUnresolvedOutputNotFound
+		}
+
+		var outputBuf bytes.Buffer
+		if err := writeOutpoint(&outputBuf, &output); err != nil {
+			return err
+		}
+		// As outputs are stored with an empty value, we'll use a cursor
+		// to check for their presence.
+		k, _ := chanOutputs.Cursor().Seek(outputBuf.Bytes())
+		if !bytes.Equal(k, outputBuf.Bytes()) {
+			return ErrUnresolvedOutputNotFound
+		}
+		if err := chanOutputs.Delete(outputBuf.Bytes()); err != nil {
+			return err
+		}
+
+		// Once the last output has been resolved, we'll remove the
+		// channel's bucket altogether.
+		if k, _ := chanOutputs.Cursor().First(); k != nil {
+			return nil
+		}
+
+		var chanPointBuf bytes.Buffer
+		if err := writeOutpoint(&chanPointBuf, &chanPoint); err != nil {
+			return err
+		}
+		return tx.Bucket(chanResolutionsBucket).DeleteBucket(
+			chanPointBuf.Bytes(),
+		)
+	})
+}
+
+// FetchUnresolvedOutputs returns the outputs of the channel identified by
+// chanPoint that are still pending a sweep. If all of its outputs have been
+// resolved, or none were ever added, then no outputs are returned.
+func (d *DB) FetchUnresolvedOutputs(chanPoint wire.OutPoint) ([]wire.OutPoint,
+	error) {
+
+	var outputs []wire.OutPoint
+	err := d.View(func(tx *bbolt.Tx) error {
+		var err error
+		outputs, err = fetchUnresolvedOutputs(tx, &chanPoint)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return outputs, nil
+}
+
+// fetchUnresolvedOutputs returns the outputs of the channel identified by
+// chanPoint that are still pending a sweep within the passed transaction.
+func fetchUnresolvedOutputs(tx *bbolt.Tx,
+	chanPoint *wire.OutPoint) ([]wire.OutPoint, error) {
+
+	chanOutputs, err := fetchChanResolutions(tx, chanPoint)
+	if err != nil || chanOutputs == nil {
+		return nil, err
+	}
+
+	var outputs []wire.OutPoint
+	err = chanOutputs.ForEach(func(k, _ []byte) error {
+		var output wire.OutPoint
+		if err := readOutpoint(bytes.NewReader(k), &output); err != nil {
+			return err
+		}
+		outputs = append(outputs, output)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return outputs, nil
+}
+
+// fetchChanResolutions returns the bucket of unresolved outputs of the channel
+// identified by chanPoint, or nil if it has none.
+func fetchChanResolutions(tx *bbolt.Tx,
+	chanPoint *wire.OutPoint) (*bbolt.Bucket, error) {
+
+	resolutions := tx.Bucket(chanResolutionsBucket)
+	if resolutions == nil {
+		return nil, nil
+	}
+
+	var chanPointBuf bytes.Buffer
+	if err := writeOutpoint(&chanPointBuf, chanPoint); err != nil {
+		return nil, err
+	}
+
+	return resolutions.Bucket(chanPointBuf.Bytes()), nil
+}
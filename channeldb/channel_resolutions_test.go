@@ -0,0 +1,105 @@
+package channeldb
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// TestChannelResolutions asserts that the unresolved outputs of a force closed
+// channel are tracked until they're resolved, and that the channel can't be
+// marked as fully closed in the meantime.
+func TestChannelResolutions(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channel.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+	err = channel.CloseChannel(&ChannelCloseSummary{
+		ChanPoint: channel.FundingOutpoint,
+		RemotePub: channel.IdentityPub,
+		CloseType: LocalForceClose,
+		IsPending: true,
+	})
+	if err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+	chanPoint := channel.FundingOutpoint
+
+	assertUnresolved := func(expected []wire.OutPoint) {
+		t.Helper()
+
+		outputs, err := cdb.FetchUnresolvedOutputs(chanPoint)
+		if err != nil {
+			t.Fatalf("unable to fetch unresolved outputs: %v", err)
+		}
+		if !reflect.DeepEqual(outputs, expected) {
+			t.Fatalf("expected unresolved outputs %v, got %v",
+				expected, outputs)
+		}
+	}
+
+	// Before any outputs are added, there should be none unresolved.
+	assertUnresolved(nil)
+
+	closeTxid := chanPoint.Hash
+	closeTxid[0] ^= 0xff
+	outputs := []wire.OutPoint{
+		{Hash: closeTxid, Index: 0},
+		{Hash: closeTxid, Index: 1},
+	}
+	if err := cdb.AddUnresolvedOutputs(chanPoint, outputs...); err != nil {
+		t.Fatalf("unable to add unresolved outputs: %v", err)
+	}
+	assertUnresolved(outputs)
+
+	// While outputs remain unresolved, the channel can't be fully closed.
+	err = cdb.MarkChanFullyClosed(&chanPoint)
+	if err != ErrChanOutputsUnresolved {
+		t.Fatalf("expected ErrChanOutputsUnresolved, got: %v", err)
+	}
+
+	if err := cdb.MarkOutputResolved(chanPoint, outputs[0]); err != nil {
+		t.Fatalf("unable to resolve output: %v", err)
+	}
+	assertUnresolved(outputs[1:])
+
+	// An output can't be resolved twice.
+	err = cdb.MarkOutputResolved(chanPoint, outputs[0])
+	if err != ErrUnresolvedOutputNotFound {
+		t.Fatalf("expected ErrUnresolvedOutputNotFound, got: %v", err)
+	}
+
+	// Once the final output is resolved, the channel can be fully closed.
+	if err := cdb.MarkOutputResolved(chanPoint, outputs[1]); err != nil {
+		t.Fatalf("unable to resolve output: %v", err)
+	}
+	assertUnresolved(nil)
+
+	if err := cdb.MarkChanFullyClosed(&chanPoint); err != nil {
+		t.Fatalf("unable to mark channel fully closed: %v", err)
+	}
+	summary, err := cdb.FetchClosedChannel(&chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch closed channel: %v", err)
+	}
+	if summary.IsPending {
+		t.Fatalf("expected channel to be fully closed")
+	}
+}
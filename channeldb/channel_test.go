@@ -1039,3 +1039,51 @@ func TestRefreshShortChanID(t *testing.T) {
 			pendingChannel.Packager.(*ChannelPackager).source)
 	}
 }
+
+// TestCloseSummaryUnknownMarker asserts that a close summary whose optional
+// fields are preceded by an unknown marker fails to deserialize, rather than
+// being read as the legacy encoding.
+func TestCloseSummaryUnknownMarker(t *testing.T) {
+	t.Parallel()
+
+	_, remotePub := btcec.PrivKeyFromBytes(btcec.S256(), key[:])
+	_, revPub := btcec.PrivKeyFromBytes(btcec.S256(), rev[:])
+	summary := &ChannelCloseSummary{
+		ChanPoint:               wire.OutPoint{Index: 1},
+		ShortChanID:             lnwire.NewShortChanIDFromInt(99),
+		CloseHeight:             100,
+		RemotePub:               remotePub,
+		Capacity:                10000,
+		RemoteCurrentRevocation: revPub,
+		LocalChanConfig: ChannelConfig{
+			MultiSigKey: keychain.KeyDescriptor{
+				PubKey: remotePub,
+			},
+		},
+	}
+
+	// We'll write the summary using the legacy encoding, which marks the
+	// presence of its optional fields with a marker of one directly
+	// following the fixed fields.
+	var b bytes.Buffer
+	if err := serializeLegacyCloseSummary(&b, summary); err != nil {
+		t.Fatalf("unable to serialize summary: %v", err)
+	}
+	summaryBytes := b.Bytes()
+	if summaryBytes[171] != 1 {
+		t.Fatalf("expected marker 1, got %v", summaryBytes[171])
+	}
+
+	_, err := deserializeCloseChannelSummary(bytes.NewReader(summaryBytes))
+	if err != nil {
+		t.Fatalf("unable to deserialize summary: %v", err)
+	}
+
+	// Replacing the marker with an unknown one should cause the summary
+	// to be rejected.
+	summaryBytes[171] = closeSummaryTLVMarker + 1
+	_, err = deserializeCloseChannelSummary(bytes.NewReader(summaryBytes))
+	if err == nil {
+		t.Fatalf("expected unknown marker to be rejected")
+	}
+}
@@ -2,19 +2,31 @@ package channeldb
 
 import (
 	"bytes"
+	"context"
+	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
+	"math"
 	"net"
 	"os"
 	"path/filepath"
+	"sort"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/coreos/bbolt"
 	"github.com/go-errors/errors"
+	"github.com/lightningnetwork/lnd/channeldb/migration12"
+	"github.com/lightningnetwork/lnd/channeldb/migration13"
+	"github.com/lightningnetwork/lnd/channeldb/migration14"
 	"github.com/lightningnetwork/lnd/channeldb/migration_01_to_11"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/subscribe"
 )
 
 const (
@@ -27,9 +39,35 @@ const (
 // up-to-date version of the database.
 type migration func(tx *bbolt.Tx) error
 
+// postMigrationCheck is a function which is executed directly after a
+// migration, within the same database transaction. It allows the author of a
+// migration to assert invariants over the migrated data before the new schema
+// is durably committed.
+type postMigrationCheck func(tx *bbolt.Tx) error
+
+// contextMigration is a migration which periodically checks the passed
+// context, aborting with the context's error once it's done. This allows
+// long running migrations to be bounded in time.
+type contextMigration func(ctx context.Context, tx *bbolt.Tx) error
+
 type version struct {
 	number    uint32
 	migration migration
+
+	// ctxMigration is an alternative to migration which accepts a
+	// context, used for migrations that may take a long time on large
+	// databases. Only one of migration and ctxMigration should be set.
+	ctxMigration contextMigration
+
+	// postCheck is an optional check that is run immediately after the
+	// migration has been applied. If it returns an error, then the entire
+	// migration transaction is rolled back.
+	postCheck postMigrationCheck
+
+	// reverse is an optional migration which undoes the changes made by
+	// migration, bringing the database back to the prior version. It is
+	// only ever applied through an explicit call to Downgrade.
+	reverse migration
 }
 
 var (
@@ -113,8 +151,30 @@ var (
 		},
 		{
 			// Add invoice htlc and cltv delta fields.
-			number:    11,
-			migration: migration_01_to_11.MigrateInvoices,
+			number:       11,
+			ctxMigration: migration_01_to_11.MigrateInvoicesContext,
+		},
+		{
+			// Re-encode the optional fields of the channel close
+			// summary as a TLV stream.
+			number:       12,
+			ctxMigration: migration12.MigrateCloseSummaryTLVContext,
+			reverse:      migration12.RevertCloseSummaryTLV,
+		},
+		{
+			// Pre-create the top-level bucket housing the log
+			// of channel status transitions, which is otherwise
+			// created on demand.
+			number:    13,
+			migration: migration13.CreateChannelEventLog,
+			reverse:   migration13.DeleteChannelEventLog,
+		},
+		{
+			// Index open channels by the height of the block their
+			// funding transaction confirmed in.
+			number:    14,
+			migration: migration14.CreateChannelOpenHeightIndex,
+			reverse:   migration14.DeleteChannelOpenHeightIndex,
 		},
 	}
 
@@ -131,49 +191,296 @@ type DB struct {
 	dbPath string
 	graph  *ChannelGraph
 	now    func() time.Time
+
+	// allowDowngrade indicates whether the caller opted in to being able
+	// to revert the database to a prior version using Downgrade.
+	allowDowngrade bool
+
+	// archiveClosedChannels indicates whether a trimmed snapshot of the
+	// final state of a channel should be archived when it's closed.
+	archiveClosedChannels bool
+
+	// keepHistoricalChannels indicates whether the complete state of a
+	// channel should be retained when it's closed.
+	keepHistoricalChannels bool
+
+	// updateMaxRetries is the maximum number of times a mutating operation
+	// retries its transaction after a transient error.
+	updateMaxRetries int
+
+	// updateRetryBackoff is the delay before the first retry of a failed
+	// transaction, which doubles with each subsequent retry.
+	updateRetryBackoff time.Duration
+
+	// txMetrics, if non-nil, is the recorder the timing of each
+	// transaction is reported to.
+	txMetrics TxMetricsRecorder
+
+	// boltOpts are the options the underlying bolt database was opened
+	// with. These are retained so the database can be re-opened with the
+	// same configuration, e.g. after being relocated with MoveTo.
+	boltOpts *bbolt.Options
+
+	// chanNtfnServer is the server used to deliver channel events to
+	// subscribers. It's only started once the first client subscribes.
+	chanNtfnServer *subscribe.Server
+	chanNtfnMtx    sync.Mutex
+
+	// migrationResults are the results of the migrations applied when the
+	// database was opened.
+	migrationResults []MigrationResult
+
+	// preMigrationBackupDir, if non-empty, is the directory a copy of the
+	// database is written to before any pending migrations are applied.
+	preMigrationBackupDir string
+
+	// migrationMinFreeBytes, if non-zero, is the minimum number of bytes
+	// that must be free on the filesystem of the database before any
+	// pending migrations are applied.
+	migrationMinFreeBytes uint64
 }
 
+// errDiskSpaceUnsupported is returned when the free space of a filesystem
+// can't be determined on the current platform.
+var errDiskSpaceUnsupported = fmt.Errorf("disk space check not supported")
+
 // Open opens an existing channeldb. Any necessary schemas migrations due to
 // updates will take place as necessary.
+//
+// If the database is opened read-only using OptionSetReadOnly, then it must
+// already exist, otherwise ErrNoChanDBExists is returned. Nothing is written
+// to disk, so rather than applying any migrations, ErrDBNeedsMigration is
+// returned if the database isn't at the latest version.
 func Open(dbPath string, modifiers ...OptionModifier) (*DB, error) {
 	path := filepath.Join(dbPath, dbName)
 
-	if !fileExists(path) {
-		if err := createChannelDB(dbPath); err != nil {
+	opts := DefaultOptions()
+	for _, modifier := range modifiers {
+		modifier(&opts)
+	}
+
+	switch {
+	// A read-only database can't be created, so it must already exist.
+	case opts.ReadOnly && !fileExists(path):
+		return nil, ErrNoChanDBExists
+
+	// In the case that the target path has not yet been created or doesn't
+	// yet exist, then the path is created.
+	case !opts.ReadOnly && !fileExists(dbPath):
+		if err := os.MkdirAll(dbPath, 0700); err != nil {
 			return nil, err
 		}
 	}
 
-	opts := DefaultOptions()
-	for _, modifier := range modifiers {
-		modifier(&opts)
+	// Pages are addressed by their offset within the file, so we'll only
+	// accept page sizes that are a power of two.
+	if opts.PageSize < 0 ||
+		opts.PageSize != 0 && opts.PageSize&(opts.PageSize-1) != 0 {
+
+		return nil, fmt.Errorf("invalid page size %v, must be a power "+
+			"of two", opts.PageSize)
 	}
 
 	// Specify bbolt freelist options to reduce heap pressure in case the
 	// freelist grows to be very large.
 	options := &bbolt.Options{
-		NoFreelistSync: opts.NoFreelistSync,
-		FreelistType:   bbolt.FreelistMapType,
+		NoFreelistSync:  opts.NoFreelistSync,
+		FreelistType:    opts.FreelistType,
+		Timeout:         opts.OpenTimeout,
+		InitialMmapSize: opts.InitialMmapSize,
+		ReadOnly:        opts.ReadOnly,
+		PageSize:        opts.PageSize,
 	}
 
+	exists := fileExists(path)
 	bdb, err := bbolt.Open(path, dbFilePermission, options)
-	if err != nil {
+	switch {
+	// If we timed out waiting for the file lock, then another process
+	// (most likely another lnd instance) has the database open.
+	case err == bbolt.ErrTimeout:
+		return nil, ErrDatabaseLocked
+
+	case err != nil:
 		return nil, err
 	}
 
+	// The page size of an existing database is read from its file, so
+	// any page size we were configured with has no effect.
+	pageSize := bdb.Info().PageSize
+	if exists && opts.PageSize != 0 && opts.PageSize != pageSize {
+		log.Warnf("Ignoring page size of %v bytes, existing database "+
+			"uses a page size of %v bytes", opts.PageSize,
+			pageSize)
+	}
+	options.PageSize = pageSize
+
+	return openWithBackend(bdb, dbPath, &opts, options)
+}
+
+// OpenWithBackend opens a channeldb backed by the passed bolt database, which
+// has already been opened by the caller, rather than opening the database file
+// itself. This gives the caller full control over the options the bolt
+// database is opened with. Just as with Open, the database is initialized and
+// migrated as necessary. The dbPath should be the directory the bolt database
+// resides in, as it's returned by Path and used to locate the file when it's
+// relocated with MoveTo.
+//
+// NOTE: The returned DB takes ownership of the bolt database, so it's closed
+// along with it. If the channeldb can't be opened, then the bolt database is
+// closed before returning.
+func OpenWithBackend(bdb *bbolt.DB, dbPath string,
+	modifiers ...OptionModifier) (*DB, error) {
+
+	opts := DefaultOptions()
+	for _, modifier := range modifiers {
+		modifier(&opts)
+	}
+
+	// A bolt database opened read-only can't be written to, regardless of
+	// the options we were passed.
+	if bdb.IsReadOnly() {
+		opts.ReadOnly = true
+	}
+
+	// As the bolt database wasn't opened by us, we'll retain the options
+	// it was actually opened with, such that it's re-opened with the same
+	// configuration.
+	options := &bbolt.Options{
+		NoFreelistSync:  bdb.NoFreelistSync,
+		FreelistType:    bdb.FreelistType,
+		Timeout:         opts.OpenTimeout,
+		InitialMmapSize: opts.InitialMmapSize,
+		ReadOnly:        opts.ReadOnly,
+		PageSize:        bdb.Info().PageSize,
+		MmapFlags:       bdb.MmapFlags,
+	}
+
+	return openWithBackend(bdb, dbPath, &opts, options)
+}
+
+// openWithBackend initializes and migrates the channeldb backed by the passed
+// bolt database, which was opened with the passed bolt options, as configured
+// by opts. If the channeldb can't be opened, then the bolt database is closed.
+func openWithBackend(bdb *bbolt.DB, dbPath string, opts *Options,
+	options *bbolt.Options) (*DB, error) {
+
+	// If this is a fresh database, we'll initialize it using the same
+	// handle we'll use for the rest of its lifetime, such that there's no
+	// window in which a half-initialized file is closed and re-opened. A
+	// read-only database must have been initialized already.
+	var versions []version
+	if !opts.ReadOnly {
+		var err error
+		versions, err = withMigrationOverrides(
+			dbVersions, opts.MigrationOverrides,
+		)
+		if err != nil {
+			bdb.Close()
+			return nil, err
+		}
+
+		err = initChannelDB(bdb, opts.MigrationOverrides)
+		if err != nil {
+			bdb.Close()
+			return nil, err
+		}
+	}
+
 	chanDB := &DB{
-		DB:     bdb,
-		dbPath: dbPath,
-		now:    time.Now,
+		DB:                     bdb,
+		dbPath:                 dbPath,
+		now:                    time.Now,
+		allowDowngrade:         opts.AllowDowngrade,
+		archiveClosedChannels:  opts.ArchiveClosedChannels,
+		keepHistoricalChannels: opts.KeepHistoricalChannels,
+		updateMaxRetries:       opts.UpdateMaxRetries,
+		updateRetryBackoff:     opts.UpdateRetryBackoff,
+		txMetrics:              opts.TxMetrics,
+		boltOpts:               options,
+		preMigrationBackupDir:  opts.PreMigrationBackupDir,
+		migrationMinFreeBytes:  opts.MigrationMinFreeBytes,
 	}
 	chanDB.graph = newChannelGraph(
 		chanDB, opts.RejectCacheSize, opts.ChannelCacheSize,
 	)
 
 	// Synchronize the version of database and apply migrations if needed.
-	if err := chanDB.syncVersions(dbVersions); err != nil {
-		bdb.Close()
-		return nil, err
+	// If a migration timeout was set, then the migrations are aborted and
+	// rolled back once it expires. A read-only database can't be migrated,
+	// so we'll only ensure that it's already at the latest version.
+	if opts.ReadOnly {
+		meta, err := chanDB.FetchMeta(nil)
+		if err == nil {
+			err = checkDBVersion(meta.DbVersionNumber)
+		}
+		if err != nil {
+			bdb.Close()
+			return nil, err
+		}
+	} else {
+		ctx := context.Background()
+		if opts.MigrationTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(
+				ctx, opts.MigrationTimeout,
+			)
+			defer cancel()
+		}
+		migrationResults, err := chanDB.syncVersionsWithReport(
+			ctx, versions,
+		)
+		if err != nil {
+			bdb.Close()
+
+			// If any migrations were attempted, then we'll return
+			// their results along with the error, as they're most
+			// useful in diagnosing the failure.
+			if len(migrationResults) > 0 {
+				err = MigrationError{
+					Results: migrationResults,
+					Err:     err,
+				}
+			}
+			return nil, err
+		}
+		chanDB.migrationResults = migrationResults
+
+		// Any overrides of migrations that were applied prior to this
+		// call are yet to be applied as fixes.
+		err = chanDB.applyMigrationFixes(opts.MigrationOverrides)
+		if err != nil {
+			bdb.Close()
+			return nil, err
+		}
+	}
+
+	// Now that the database is at the latest version, we'll populate the
+	// graph caches if requested.
+	if opts.WarmGraphCaches {
+		if err := chanDB.graph.warmCaches(); err != nil {
+			bdb.Close()
+			return nil, err
+		}
+	}
+
+	// If requested, we'll now clean up any link nodes that may have been
+	// left behind by a prior version that didn't prune them on close. As
+	// this requires writing to the database, it's skipped if read-only.
+	if opts.StartupLinkNodeGC && !opts.ReadOnly {
+		err := chanDB.PruneLinkNodes()
+		if err != nil && err != ErrLinkNodesNotFound {
+			bdb.Close()
+			return nil, err
+		}
+	}
+
+	// Finally, now that the database is ready to be used, we'll hand it to
+	// the caller's hook, if any, before returning it.
+	if opts.PostOpenHook != nil {
+		if err := opts.PostOpenHook(chanDB); err != nil {
+			chanDB.Close()
+			return nil, fmt.Errorf("post-open hook failed: %v", err)
+		}
 	}
 
 	return chanDB, nil
@@ -184,70 +491,332 @@ func (d *DB) Path() string {
 	return d.dbPath
 }
 
-// Wipe completely deletes all saved state within all used buckets within the
-// database. The deletion is done in a single transaction, therefore this
-// operation is fully atomic.
-func (d *DB) Wipe() error {
-	return d.Update(func(tx *bbolt.Tx) error {
-		err := tx.DeleteBucket(openChannelBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
-			return err
-		}
+// MoveTo relocates the database file into the directory newDir, re-opening it
+// at its new location. The channel graph, along with its caches, is preserved
+// across the move. The move is refused if the database has a migration
+// pending. If the database can't be re-opened at its new location, then the
+// file is moved back and re-opened at its original path. Should the rollback
+// fail as well, then its error is returned alongside the original one.
+//
+// NOTE: The caller must ensure that the database isn't used concurrently for
+// the duration of the move.
+func (d *DB) MoveTo(newDir string) error {
+	// Before we touch the file, we'll make sure the database is at the
+	// latest version, as we don't want to move a database that still
+	// needs to be migrated.
+	var meta *Meta
+	err := d.View(func(tx *bbolt.Tx) error {
+		var err error
+		meta, err = d.FetchMeta(tx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	latestVersion := getLatestDBVersion(dbVersions)
+	if meta.DbVersionNumber != latestVersion {
+		return ErrMigrationPending
+	}
 
-		err = tx.DeleteBucket(closedChannelBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
-			return err
-		}
+	oldDir := d.dbPath
+	oldPath := filepath.Join(oldDir, dbName)
+	newPath := filepath.Join(newDir, dbName)
+	if fileExists(newPath) {
+		return fmt.Errorf("database already exists at %v", newPath)
+	}
 
-		err = tx.DeleteBucket(invoiceBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
-			return err
-		}
+	if err := os.MkdirAll(newDir, 0700); err != nil {
+		return err
+	}
 
-		err = tx.DeleteBucket(nodeInfoBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
-			return err
+	// With the sanity checks out of the way, we'll close our current
+	// handle so the file lock is released and move the file into place.
+	if err := d.DB.Close(); err != nil {
+		return err
+	}
+	if err := renameFile(oldPath, newPath); err != nil {
+		// As the file was never moved, we'll simply re-open it at its
+		// original location.
+		bdb, openErr := d.reopen(oldPath)
+		if openErr != nil {
+			return fmt.Errorf("unable to move database: %v, "+
+				"reopen failed: %v", err, openErr)
 		}
+		d.DB = bdb
 
-		err = tx.DeleteBucket(nodeBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
-			return err
-		}
-		err = tx.DeleteBucket(edgeBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
-			return err
-		}
-		err = tx.DeleteBucket(edgeIndexBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
-			return err
+		return err
+	}
+
+	bdb, err := d.reopen(newPath)
+	if err != nil {
+		log.Errorf("Unable to open moved database at %v, rolling "+
+			"back: %v", newPath, err)
+
+		// We weren't able to open the database at its new location,
+		// so we'll move it back and re-open the original.
+		if rbErr := renameFile(newPath, oldPath); rbErr != nil {
+			return fmt.Errorf("unable to open moved database: %v, "+
+				"rollback failed: %v", err, rbErr)
 		}
-		err = tx.DeleteBucket(graphMetaBucket)
-		if err != nil && err != bbolt.ErrBucketNotFound {
-			return err
+		bdb, openErr := d.reopen(oldPath)
+		if openErr != nil {
+			return fmt.Errorf("unable to open moved database: %v, "+
+				"reopen failed: %v", err, openErr)
 		}
+		d.DB = bdb
+
+		return err
+	}
+
+	d.DB = bdb
+	d.dbPath = newDir
+
+	return nil
+}
+
+// reopen opens the bolt database at the given path using the options the
+// database was originally opened with.
+func (d *DB) reopen(path string) (*bbolt.DB, error) {
+	bdb, err := bbolt.Open(path, dbFilePermission, d.boltOpts)
+	if err == bbolt.ErrTimeout {
+		return nil, ErrDatabaseLocked
+	}
+
+	return bdb, err
+}
+
+// renameFile moves the file at oldPath to newPath, then fsyncs the parent
+// directories of both so the move is durable.
+func renameFile(oldPath, newPath string) error {
+	if err := os.Rename(oldPath, newPath); err != nil {
+		return err
+	}
+
+	if err := syncDir(filepath.Dir(newPath)); err != nil {
+		return err
+	}
+
+	return syncDir(filepath.Dir(oldPath))
+}
+
+// syncDir fsyncs the directory at the given path, ensuring any changes to the
+// entries within it have been persisted.
+func syncDir(dir string) error {
+	f, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return f.Sync()
+}
+
+// WithTx executes the passed closure within a single database transaction,
+// allowing callers to compose several reads (or writes) against one
+// consistent snapshot of the database. If readOnly is true, a read-only
+// transaction is used, otherwise a read-write transaction is opened and
+// committed once the closure returns without error. The transaction must not
+// be used after the closure returns.
+func (d *DB) WithTx(readOnly bool, fn func(tx *bbolt.Tx) error) error {
+	if readOnly {
+		return d.View(fn)
+	}
 
+	return d.Update(fn)
+}
+
+// SetFreelistSync enables or disables syncing of bolt's freelist to disk on
+// each commit, overriding the value set through OptionSetSyncFreelist for the
+// remainder of the database's lifetime. Disabling it speeds up large write
+// heavy workloads, such as bulk imports, as the freelist no longer needs to be
+// written out by every transaction.
+//
+// NOTE: While disabled, the freelist isn't persisted, so it must be rebuilt by
+// scanning the whole database the next time it's opened, which can slow
+// startup considerably for large databases. The data written is otherwise just
+// as durable. Re-enabling syncing writes the freelist out again with the next
+// commit.
+func (d *DB) SetFreelistSync(enabled bool) error {
+	// The flag is read by bolt while committing a read-write transaction,
+	// so we flip it from within one to serialize with any other writers.
+	// This transaction's own commit already observes the new value.
+	return d.Update(func(*bbolt.Tx) error {
+		d.NoFreelistSync = !enabled
 		return nil
 	})
 }
 
-// createChannelDB creates and initializes a fresh version of channeldb. In
-// the case that the target path has not yet been created or doesn't yet exist,
-// then the path is created. Additionally, all required top-level buckets used
-// within the database are created.
-func createChannelDB(dbPath string) error {
-	if !fileExists(dbPath) {
-		if err := os.MkdirAll(dbPath, 0700); err != nil {
+// Flush forces the current state of the database, including bolt's freelist,
+// to be written and synced to disk. Committed transactions are already synced,
+// but while freelist syncing is disabled, see SetFreelistSync, the freelist is
+// only held in memory. Flushing writes out the freelist with an empty commit,
+// such that a copy of the database file taken afterwards, while no other
+// writes are in flight, can be opened without rebuilding the freelist. The
+// freelist setting is left as it was.
+func (d *DB) Flush() error {
+	// We'll commit an empty transaction with freelist syncing enabled,
+	// flipping the flag from within the transaction to serialize with any
+	// other writers, as the flag is read while committing.
+	tx, err := d.Begin(true)
+	if err != nil {
+		return err
+	}
+	noFreelistSync := d.NoFreelistSync
+	d.NoFreelistSync = false
+	commitErr := tx.Commit()
+
+	// With the freelist written, we'll restore the prior setting. We take
+	// the write lock again by beginning a transaction, but roll it back
+	// so the meta page written above isn't superseded.
+	tx, err = d.Begin(true)
+	if err != nil {
+		return err
+	}
+	d.NoFreelistSync = noFreelistSync
+	if err := tx.Rollback(); err != nil {
+		return err
+	}
+	if commitErr != nil {
+		return commitErr
+	}
+
+	return d.Sync()
+}
+
+// retryUpdate executes the passed closure within a read-write transaction
+// just as Update does, but retries the transaction if it fails due to an error
+// deemed transient by isTransientErr. The transaction is retried at most the
+// number of times configured through OptionSetUpdateRetry, doubling the delay
+// in between each attempt. As the closure may be executed several times, it
+// must not have side effects that outlive a failed transaction.
+func (d *DB) retryUpdate(f func(tx *bbolt.Tx) error) error {
+	backoff := d.updateRetryBackoff
+	for attempt := 1; ; attempt++ {
+		err := d.Update(f)
+		if err == nil || attempt > d.updateMaxRetries ||
+			!isTransientErr(err) {
+
 			return err
 		}
+
+		log.Warnf("Database update failed with transient error, "+
+			"retrying in %v (attempt %d/%d): %v", backoff, attempt,
+			d.updateMaxRetries, err)
+
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// isTransientErr returns true if the passed error, as returned from a database
+// transaction, stems from a condition of the underlying storage that may clear
+// up by itself, such that retrying the transaction may succeed.
+func isTransientErr(err error) bool {
+	switch e := err.(type) {
+	case *os.PathError:
+		err = e.Err
+	case *os.SyscallError:
+		err = e.Err
 	}
 
-	path := filepath.Join(dbPath, dbName)
-	bdb, err := bbolt.Open(path, dbFilePermission, nil)
+	switch err {
+	case syscall.EIO, syscall.EAGAIN, syscall.EINTR, syscall.EBUSY:
+		return true
+	default:
+		return false
+	}
+}
+
+// wipeBuckets is the set of top-level buckets deleted by Wipe.
+var wipeBuckets = [][]byte{
+	openChannelBucket,
+	closedChannelBucket,
+	invoiceBucket,
+	nodeInfoBucket,
+	nodeBucket,
+	edgeBucket,
+	edgeIndexBucket,
+	graphMetaBucket,
+	channelEventLogBucket,
+	closedChannelArchiveBucket,
+	channelAliasBucket,
+	historicalChannelBucket,
+	chanOpenHeightBucket,
+	chanResolutionsBucket,
+}
+
+// Wipe completely deletes all saved state within all used buckets within the
+// database. The deletion is done in a single transaction, therefore this
+// operation is fully atomic.
+func (d *DB) Wipe() error {
+	_, err := d.WipeWithReport()
+	return err
+}
+
+// WipeWithReport is identical to Wipe, but also returns the names of the
+// buckets that were present, and therefore deleted. Buckets that were already
+// absent are omitted. As deleting an absent bucket is a no-op, the wipe is
+// retried if it fails due to a transient error, see OptionSetUpdateRetry.
+func (d *DB) WipeWithReport() ([]string, error) {
+	var deleted []string
+	err := d.retryUpdate(func(tx *bbolt.Tx) error {
+		// As the transaction may be retried, we'll reset the set of
+		// deleted buckets gathered by any prior attempt.
+		deleted = nil
+
+		for _, bucket := range wipeBuckets {
+			err := tx.DeleteBucket(bucket)
+			switch {
+			case err == bbolt.ErrBucketNotFound:
+				continue
+
+			case err != nil:
+				return err
+			}
+
+			deleted = append(deleted, string(bucket))
+		}
+
+		return nil
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = bdb.Update(func(tx *bbolt.Tx) error {
+	return deleted, nil
+}
+
+// initChannelDB initializes a fresh version of channeldb within the passed
+// database handle. All required top-level buckets used within the database are
+// created, and the meta data is written at the latest version, all within a
+// single transaction, along with the passed migration overrides, which are
+// recorded as applied. If the database has already been initialized, then
+// this is a no-op.
+func initChannelDB(bdb *bbolt.DB,
+	overrides map[uint32]func(*bbolt.Tx) error) error {
+
+	err := bdb.Update(func(tx *bbolt.Tx) error {
+		// If the meta bucket is already present, then the database has
+		// already been initialized.
+		if tx.Bucket(metaBucket) != nil {
+			return nil
+		}
+
+		// A database that has top-level buckets but lacks the meta
+		// bucket predates the introduction of versioning, so we leave
+		// it to the migrations to bring it up to date.
+		var hasBuckets bool
+		err := tx.ForEach(func(_ []byte, _ *bbolt.Bucket) error {
+			hasBuckets = true
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if hasBuckets {
+			return nil
+		}
+
 		if _, err := tx.CreateBucket(openChannelBucket); err != nil {
 			return err
 		}
@@ -310,6 +879,14 @@ func createChannelDB(dbPath string) error {
 			return err
 		}
 
+		if _, err := tx.CreateBucket(channelEventLogBucket); err != nil {
+			return err
+		}
+
+		if _, err := tx.CreateBucket(chanOpenHeightBucket); err != nil {
+			return err
+		}
+
 		if _, err := tx.CreateBucket(metaBucket); err != nil {
 			return err
 		}
@@ -317,13 +894,27 @@ func createChannelDB(dbPath string) error {
 		meta := &Meta{
 			DbVersionNumber: getLatestDBVersion(dbVersions),
 		}
-		return putMeta(meta, tx)
+		if err := putMeta(meta, tx); err != nil {
+			return err
+		}
+
+		// As the database is created at the latest version, the
+		// migrations it would be fixed by have never been applied, so
+		// we'll record their overrides as applied as well.
+		for number := range overrides {
+			err := putAppliedMigrationFix(tx, number)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("unable to create new channeldb")
+		return fmt.Errorf("unable to create new channeldb: %v", err)
 	}
 
-	return bdb.Close()
+	return nil
 }
 
 // fileExists returns true if the file exists, and false otherwise.
@@ -406,26 +997,66 @@ func (d *DB) fetchOpenChannels(tx *bbolt.Tx,
 	return channels, err
 }
 
-// fetchNodeChannels retrieves all active channels from the target chainBucket
-// which is under a node's dedicated channel bucket. This function is typically
-// used to fetch all the active channels related to a particular node.
-func (d *DB) fetchNodeChannels(chainBucket *bbolt.Bucket) ([]*OpenChannel, error) {
+// FetchOpenChannelsForPeerOnChain returns all stored currently active/open
+// channels associated with the target nodeID on the chain identified by the
+// passed chain hash. Unlike FetchOpenChannels, the buckets of other chains
+// aren't visited. In the case that no active channels are known to have been
+// created with this node on the chain, then a zero-length slice is returned.
+func (d *DB) FetchOpenChannelsForPeerOnChain(nodeID *btcec.PublicKey,
+	chainHash chainhash.Hash) ([]*OpenChannel, error) {
 
 	var channels []*OpenChannel
+	err := d.View(func(tx *bbolt.Tx) error {
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket == nil {
+			return nil
+		}
 
-	// A node may have channels on several chains, so for each known chain,
-	// we'll extract all the channels.
-	err := chainBucket.ForEach(func(chanPoint, v []byte) error {
-		// If there's a value, it's not a bucket so ignore it.
-		if v != nil {
+		pub := nodeID.SerializeCompressed()
+		nodeChanBucket := openChanBucket.Bucket(pub)
+		if nodeChanBucket == nil {
 			return nil
 		}
 
-		// Once we've found a valid channel bucket, we'll extract it
-		// from the node's chain bucket.
-		chanBucket := chainBucket.Bucket(chanPoint)
+		chainBucket := nodeChanBucket.Bucket(chainHash[:])
+		if chainBucket == nil {
+			return nil
+		}
 
-		var outPoint wire.OutPoint
+		var err error
+		channels, err = d.fetchNodeChannels(chainBucket)
+		if err != nil {
+			return fmt.Errorf("unable to read channel for "+
+				"chain_hash=%x, node_key=%x: %v",
+				chainHash[:], pub, err)
+		}
+
+		return nil
+	})
+
+	return channels, err
+}
+
+// fetchNodeChannels retrieves all active channels from the target chainBucket
+// which is under a node's dedicated channel bucket. This function is typically
+// used to fetch all the active channels related to a particular node.
+func (d *DB) fetchNodeChannels(chainBucket *bbolt.Bucket) ([]*OpenChannel, error) {
+
+	var channels []*OpenChannel
+
+	// A node may have channels on several chains, so for each known chain,
+	// we'll extract all the channels.
+	err := chainBucket.ForEach(func(chanPoint, v []byte) error {
+		// If there's a value, it's not a bucket so ignore it.
+		if v != nil {
+			return nil
+		}
+
+		// Once we've found a valid channel bucket, we'll extract it
+		// from the node's chain bucket.
+		chanBucket := chainBucket.Bucket(chanPoint)
+
+		var outPoint wire.OutPoint
 		err := readOutpoint(bytes.NewReader(chanPoint), &outPoint)
 		if err != nil {
 			return err
@@ -448,239 +1079,170 @@ func (d *DB) fetchNodeChannels(chainBucket *bbolt.Bucket) ([]*OpenChannel, error
 	return channels, nil
 }
 
-// FetchChannel attempts to locate a channel specified by the passed channel
-// point. If the channel cannot be found, then an error will be returned.
-func (d *DB) FetchChannel(chanPoint wire.OutPoint) (*OpenChannel, error) {
-	var (
-		targetChan      *OpenChannel
-		targetChanPoint bytes.Buffer
-	)
-
-	if err := writeOutpoint(&targetChanPoint, &chanPoint); err != nil {
-		return nil, err
-	}
+// forEachChanBucket iterates over the bucket of every channel stored within
+// the passed open channel bucket, across all nodes and chains, invoking the
+// callback with the serialized node public key and channel point of each
+// channel. If the callback returns an error, then the iteration is stopped and
+// the error is returned to the caller.
+func forEachChanBucket(openChanBucket *bbolt.Bucket,
+	cb func(nodePub, chanPoint []byte, chanBucket *bbolt.Bucket) error) error {
+
+	return openChanBucket.ForEach(func(nodePub, v []byte) error {
+		// Ensure that this is a key the same size as a pubkey, and
+		// also that it leads directly to a bucket.
+		if len(nodePub) != 33 || v != nil {
+			return nil
+		}
 
-	// chanScan will traverse the following bucket structure:
-	//  * nodePub => chainHash => chanPoint
-	//
-	// At each level we go one further, ensuring that we're traversing the
-	// proper key (that's actually a bucket). By only reading the bucket
-	// structure and skipping fully decoding each channel, we save a good
-	// bit of CPU as we don't need to do things like decompress public
-	// keys.
-	chanScan := func(tx *bbolt.Tx) error {
-		// Get the bucket dedicated to storing the metadata for open
-		// channels.
-		openChanBucket := tx.Bucket(openChannelBucket)
-		if openChanBucket == nil {
-			return ErrNoActiveChannels
+		nodeChanBucket := openChanBucket.Bucket(nodePub)
+		if nodeChanBucket == nil {
+			return nil
 		}
 
-		// Within the node channel bucket, are the set of node pubkeys
-		// we have channels with, we don't know the entire set, so
-		// we'll check them all.
-		return openChanBucket.ForEach(func(nodePub, v []byte) error {
-			// Ensure that this is a key the same size as a pubkey,
-			// and also that it leads directly to a bucket.
-			if len(nodePub) != 33 || v != nil {
+		return nodeChanBucket.ForEach(func(chainHash, v []byte) error {
+			// If there's a value, it's not a bucket so ignore it.
+			if v != nil {
 				return nil
 			}
 
-			nodeChanBucket := openChanBucket.Bucket(nodePub)
-			if nodeChanBucket == nil {
-				return nil
+			chainBucket := nodeChanBucket.Bucket(chainHash)
+			if chainBucket == nil {
+				return fmt.Errorf("unable to read bucket for "+
+					"chain=%x", chainHash[:])
 			}
 
-			// The next layer down is all the chains that this node
-			// has channels on with us.
-			return nodeChanBucket.ForEach(func(chainHash, v []byte) error {
+			return chainBucket.ForEach(func(chanPoint, v []byte) error {
 				// If there's a value, it's not a bucket so
 				// ignore it.
 				if v != nil {
 					return nil
 				}
 
-				chainBucket := nodeChanBucket.Bucket(chainHash)
-				if chainBucket == nil {
-					return fmt.Errorf("unable to read "+
-						"bucket for chain=%x", chainHash[:])
-				}
-
-				// Finally we reach the leaf bucket that stores
-				// all the chanPoints for this node.
-				chanBucket := chainBucket.Bucket(
-					targetChanPoint.Bytes(),
-				)
+				chanBucket := chainBucket.Bucket(chanPoint)
 				if chanBucket == nil {
 					return nil
 				}
 
-				channel, err := fetchOpenChannel(
-					chanBucket, &chanPoint,
-				)
-				if err != nil {
-					return err
-				}
-
-				targetChan = channel
-				targetChan.Db = d
-
-				return nil
+				return cb(nodePub, chanPoint, chanBucket)
 			})
 		})
-	}
-
-	err := d.View(chanScan)
-	if err != nil {
-		return nil, err
-	}
+	})
+}
 
-	if targetChan != nil {
-		return targetChan, nil
-	}
+// ForEachChannelPeer iterates through the distinct remote peers we have at
+// least one open channel with, executing the passed callback once for each
+// peer. Only the keys of the open channel bucket are read, so none of the
+// channels are decoded. If the callback returns an error, then iteration is
+// halted and the error is returned to the caller.
+func (d *DB) ForEachChannelPeer(cb func(nodePub *btcec.PublicKey) error) error {
+	return d.View(func(tx *bbolt.Tx) error {
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket == nil {
+			return nil
+		}
 
-	// If we can't find the channel, then we return with an error, as we
-	// have nothing to  backup.
-	return nil, ErrChannelNotFound
-}
+		return openChanBucket.ForEach(func(k, v []byte) error {
+			// Ensure that this is a key the same size as a pubkey,
+			// and also that it leads directly to a bucket.
+			if len(k) != 33 || v != nil {
+				return nil
+			}
 
-// FetchAllChannels attempts to retrieve all open channels currently stored
-// within the database, including pending open, fully open and channels waiting
-// for a closing transaction to confirm.
-func (d *DB) FetchAllChannels() ([]*OpenChannel, error) {
-	var channels []*OpenChannel
+			nodePub, err := btcec.ParsePubKey(k, btcec.S256())
+			if err != nil {
+				return err
+			}
 
-	// TODO(halseth): fetch all in one db tx.
-	openChannels, err := d.FetchAllOpenChannels()
-	if err != nil {
-		return nil, err
-	}
-	channels = append(channels, openChannels...)
+			// The bucket of a peer is left behind once all of our
+			// channels with them are closed, so we'll skip any
+			// peers without open channels.
+			hasChannels, err := hasOpenChannels(tx, nodePub)
+			if err != nil {
+				return err
+			}
+			if !hasChannels {
+				return nil
+			}
 
-	pendingChannels, err := d.FetchPendingChannels()
-	if err != nil {
-		return nil, err
-	}
-	channels = append(channels, pendingChannels...)
+			return cb(nodePub)
+		})
+	})
+}
 
-	waitingClose, err := d.FetchWaitingCloseChannels()
+// FetchChannel attempts to locate a channel specified by the passed channel
+// point. If the channel cannot be found, then an error will be returned.
+func (d *DB) FetchChannel(chanPoint wire.OutPoint) (*OpenChannel, error) {
+	var targetChan *OpenChannel
+	err := d.View(func(tx *bbolt.Tx) error {
+		var err error
+		targetChan, err = d.FetchChannelTx(tx, chanPoint)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	channels = append(channels, waitingClose...)
-
-	return channels, nil
-}
 
-// FetchAllOpenChannels will return all channels that have the funding
-// transaction confirmed, and is not waiting for a closing transaction to be
-// confirmed.
-func (d *DB) FetchAllOpenChannels() ([]*OpenChannel, error) {
-	return fetchChannels(d, false, false)
+	return targetChan, nil
 }
 
-// FetchPendingChannels will return channels that have completed the process of
-// generating and broadcasting funding transactions, but whose funding
-// transactions have yet to be confirmed on the blockchain.
-func (d *DB) FetchPendingChannels() ([]*OpenChannel, error) {
-	return fetchChannels(d, true, false)
-}
+// FetchChannelTx is identical to FetchChannel, but uses the passed
+// transaction rather than opening a new one. This allows callers to fetch a
+// channel as part of a larger atomic operation, see WithTx.
+func (d *DB) FetchChannelTx(tx *bbolt.Tx,
+	chanPoint wire.OutPoint) (*OpenChannel, error) {
 
-// FetchWaitingCloseChannels will return all channels that have been opened,
-// but are now waiting for a closing transaction to be confirmed.
-//
-// NOTE: This includes channels that are also pending to be opened.
-func (d *DB) FetchWaitingCloseChannels() ([]*OpenChannel, error) {
-	waitingClose, err := fetchChannels(d, false, true)
+	chanBucket, err := findChanBucket(tx, &chanPoint)
 	if err != nil {
 		return nil, err
 	}
-	pendingWaitingClose, err := fetchChannels(d, true, true)
+
+	channel, err := fetchOpenChannel(chanBucket, &chanPoint)
 	if err != nil {
 		return nil, err
 	}
+	channel.Db = d
 
-	return append(waitingClose, pendingWaitingClose...), nil
+	return channel, nil
 }
 
-// fetchChannels attempts to retrieve channels currently stored in the
-// database. The pending parameter determines whether only pending channels
-// will be returned, or only open channels will be returned. The waitingClose
-// parameter determines whether only channels waiting for a closing transaction
-// to be confirmed should be returned. If no active channels exist within the
-// network, then ErrNoActiveChannels is returned.
-func fetchChannels(d *DB, pending, waitingClose bool) ([]*OpenChannel, error) {
-	var channels []*OpenChannel
+// FetchChannelsByFundingTXID returns all open channels whose funding outpoint
+// spends from the transaction with the passed txid, regardless of the output
+// index. Usually at most a single channel is returned, though several channels
+// may share a funding transaction if they were funded in a batch. Only the
+// channels whose txid matches are deserialized.
+func (d *DB) FetchChannelsByFundingTXID(
+	txid chainhash.Hash) ([]*OpenChannel, error) {
 
+	var channels []*OpenChannel
 	err := d.View(func(tx *bbolt.Tx) error {
-		// Get the bucket dedicated to storing the metadata for open
-		// channels.
 		openChanBucket := tx.Bucket(openChannelBucket)
 		if openChanBucket == nil {
 			return ErrNoActiveChannels
 		}
 
-		// Next, fetch the bucket dedicated to storing metadata related
-		// to all nodes. All keys within this bucket are the serialized
-		// public keys of all our direct counterparties.
-		nodeMetaBucket := tx.Bucket(nodeInfoBucket)
-		if nodeMetaBucket == nil {
-			return fmt.Errorf("node bucket not created")
-		}
+		return forEachChanBucket(openChanBucket, func(_, chanPoint []byte,
+			chanBucket *bbolt.Bucket) error {
 
-		// Finally for each node public key in the bucket, fetch all
-		// the channels related to this particular node.
-		return nodeMetaBucket.ForEach(func(k, v []byte) error {
-			nodeChanBucket := openChanBucket.Bucket(k)
-			if nodeChanBucket == nil {
+			// The channel point is keyed by its txid followed by
+			// its output index, so we can compare the txid without
+			// deserializing the channel.
+			if !bytes.HasPrefix(chanPoint, txid[:]) {
 				return nil
 			}
 
-			return nodeChanBucket.ForEach(func(chainHash, v []byte) error {
-				// If there's a value, it's not a bucket so
-				// ignore it.
-				if v != nil {
-					return nil
-				}
-
-				// If we've found a valid chainhash bucket,
-				// then we'll retrieve that so we can extract
-				// all the channels.
-				chainBucket := nodeChanBucket.Bucket(chainHash)
-				if chainBucket == nil {
-					return fmt.Errorf("unable to read "+
-						"bucket for chain=%x", chainHash[:])
-				}
-
-				nodeChans, err := d.fetchNodeChannels(chainBucket)
-				if err != nil {
-					return fmt.Errorf("unable to read "+
-						"channel for chain_hash=%x, "+
-						"node_key=%x: %v", chainHash[:], k, err)
-				}
-				for _, channel := range nodeChans {
-					if channel.IsPending != pending {
-						continue
-					}
-
-					// If the channel is in any other state
-					// than Default, then it means it is
-					// waiting to be closed.
-					channelWaitingClose :=
-						channel.ChanStatus() != ChanStatusDefault
-
-					// Only include it if we requested
-					// channels with the same waitingClose
-					// status.
-					if channelWaitingClose != waitingClose {
-						continue
-					}
-
-					channels = append(channels, channel)
-				}
-				return nil
-			})
+			var op wire.OutPoint
+			err := readOutpoint(bytes.NewReader(chanPoint), &op)
+			if err != nil {
+				return err
+			}
+			channel, err := fetchOpenChannel(chanBucket, &op)
+			if err != nil {
+				return err
+			}
+			channel.Db = d
 
+			channels = append(channels, channel)
+			return nil
 		})
 	})
 	if err != nil {
@@ -690,523 +1252,3157 @@ func fetchChannels(d *DB, pending, waitingClose bool) ([]*OpenChannel, error) {
 	return channels, nil
 }
 
-// FetchClosedChannels attempts to fetch all closed channels from the database.
-// The pendingOnly bool toggles if channels that aren't yet fully closed should
-// be returned in the response or not. When a channel was cooperatively closed,
-// it becomes fully closed after a single confirmation.  When a channel was
-// forcibly closed, it will become fully closed after _all_ the pending funds
-// (if any) have been swept.
-func (d *DB) FetchClosedChannels(pendingOnly bool) ([]*ChannelCloseSummary, error) {
-	var chanSummaries []*ChannelCloseSummary
+// FetchOpenChannelRaw returns the raw key/value pairs stored within the bucket
+// of the open channel with the passed channel point, such as its static info,
+// commitments and revocation state, exactly as they're stored on disk. The
+// returned map is keyed by the raw bytes of each key, and all values are
+// copies. Nested buckets, such as the revocation log, aren't included. If the
+// channel cannot be found, then an error is returned.
+func (d *DB) FetchOpenChannelRaw(
+	chanPoint wire.OutPoint) (map[string][]byte, error) {
 
-	if err := d.View(func(tx *bbolt.Tx) error {
-		closeBucket := tx.Bucket(closedChannelBucket)
-		if closeBucket == nil {
-			return ErrNoClosedChannels
+	var kvs map[string][]byte
+	err := d.View(func(tx *bbolt.Tx) error {
+		chanBucket, err := findChanBucket(tx, &chanPoint)
+		if err != nil {
+			return err
 		}
 
-		return closeBucket.ForEach(func(chanID []byte, summaryBytes []byte) error {
-			summaryReader := bytes.NewReader(summaryBytes)
-			chanSummary, err := deserializeCloseChannelSummary(summaryReader)
-			if err != nil {
-				return err
-			}
-
-			// If the query specified to only include pending
-			// channels, then we'll skip any channels which aren't
-			// currently pending.
-			if !chanSummary.IsPending && pendingOnly {
+		kvs = make(map[string][]byte)
+		return chanBucket.ForEach(func(k, v []byte) error {
+			// A nil value indicates a nested bucket, which we'll
+			// skip.
+			if v == nil {
 				return nil
 			}
 
-			chanSummaries = append(chanSummaries, chanSummary)
+			kvs[string(k)] = copySlice(v)
 			return nil
 		})
-	}); err != nil {
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	return chanSummaries, nil
+	return kvs, nil
 }
 
-// ErrClosedChannelNotFound signals that a closed channel could not be found in
-// the channeldb.
-var ErrClosedChannelNotFound = errors.New("unable to find closed channel summary")
+// ChannelExists returns true if an open channel with the passed channel point
+// exists within the database. Unlike FetchChannel, the channel's state isn't
+// deserialized, making this a cheap check.
+func (d *DB) ChannelExists(chanPoint wire.OutPoint) (bool, error) {
+	var exists bool
+	err := d.View(func(tx *bbolt.Tx) error {
+		_, err := findChanBucket(tx, &chanPoint)
+		switch {
+		case err == ErrNoActiveChannels || err == ErrChannelNotFound:
+			return nil
 
-// FetchClosedChannel queries for a channel close summary using the channel
-// point of the channel in question.
-func (d *DB) FetchClosedChannel(chanID *wire.OutPoint) (*ChannelCloseSummary, error) {
-	var chanSummary *ChannelCloseSummary
-	if err := d.View(func(tx *bbolt.Tx) error {
-		closeBucket := tx.Bucket(closedChannelBucket)
-		if closeBucket == nil {
-			return ErrClosedChannelNotFound
+		case err != nil:
+			return err
 		}
 
-		var b bytes.Buffer
+		exists = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// SetChannelStatus sets, or clears if set is false, the passed status flag(s)
+// on the open channel identified by chanPoint. The channel is loaded and
+// updated within a single transaction. If the channel cannot be found, then
+// ErrChannelNotFound is returned. This is intended for use by manual recovery
+// tooling, e.g. to clear ChanStatusRestored once a channel is known to be
+// healthy.
+//
+// NOTE: As the funding transaction isn't stored for restored channels, the
+// ChanStatusRestored flag can't be cleared from a single funder channel that
+// we initiated. Any OpenChannel instances loaded prior to this call won't
+// reflect the new status.
+func (d *DB) SetChannelStatus(chanPoint wire.OutPoint, status ChannelStatus,
+	set bool) error {
+
+	return d.Update(func(tx *bbolt.Tx) error {
+		chanBucket, err := findChanBucket(tx, &chanPoint)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &chanPoint)
+		if err != nil {
+			return err
+		}
+		oldStatus := channel.chanStatus
+
+		if set {
+			channel.chanStatus |= status
+		} else {
+			channel.chanStatus &= ^status
+		}
+
+		// The funding transaction isn't stored for restored channels,
+		// so if we're clearing the restored status of a channel that
+		// requires one, we'd be unable to serialize it.
+		if channel.ChanType.IsSingleFunder() && channel.IsInitiator &&
+			!channel.hasChanStatus(ChanStatusRestored) &&
+			channel.FundingTxn == nil {
+
+			return fmt.Errorf("unable to clear restored status of "+
+				"channel %v: funding transaction unknown",
+				chanPoint)
+		}
+
+		if err := putOpenChannel(chanBucket, channel); err != nil {
+			return err
+		}
+		err = putChanLastModified(chanBucket, d.now())
+		if err != nil {
+			return err
+		}
+
+		if channel.chanStatus == oldStatus {
+			return nil
+		}
+
+		return logChannelEvent(
+			tx, &chanPoint, oldStatus, channel.chanStatus, d.now(),
+		)
+	})
+}
+
+// MarkChannelOpen promotes the pending channel identified by the passed
+// channel point to an open channel, persisting the short channel ID describing
+// the location of its confirmed funding output within the chain. This allows
+// recovery tooling to open a channel once it has verified the confirmation of
+// its funding transaction. If the channel is already open, then
+// ErrChannelAlreadyOpen is returned.
+func (d *DB) MarkChannelOpen(chanPoint wire.OutPoint,
+	shortChanID lnwire.ShortChannelID) error {
+
+	err := d.Update(func(tx *bbolt.Tx) error {
+		chanBucket, err := findChanBucket(tx, &chanPoint)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &chanPoint)
+		if err != nil {
+			return err
+		}
+
+		if !channel.IsPending {
+			return ErrChannelAlreadyOpen
+		}
+
+		channel.IsPending = false
+		channel.ShortChannelID = shortChanID
+
+		if err := putOpenChannel(chanBucket, channel); err != nil {
+			return err
+		}
+		err = putChanLastModified(chanBucket, d.now())
+		if err != nil {
+			return err
+		}
+
+		return putChanOpenHeight(tx, channel)
+	})
+	if err != nil {
+		return err
+	}
+
+	d.notifyChannelEvent(&ChannelOpenedEvent{
+		ChanPoint:   chanPoint,
+		ShortChanID: shortChanID,
+	})
+
+	return nil
+}
+
+// CommitHeights holds the heights of the current commitments of a channel,
+// along with the number of remote commitments it has revoked.
+type CommitHeights struct {
+	// LocalCommitHeight is the height of our current commitment.
+	LocalCommitHeight uint64
+
+	// RemoteCommitHeight is the height of the remote party's current
+	// commitment.
+	RemoteCommitHeight uint64
+
+	// RevocationCounter is the number of remote commitments that have
+	// been revoked, and are therefore stored within the revocation log.
+	RevocationCounter uint64
+}
+
+// FetchChannelsWithPendingHtlcs returns all open channels, including those
+// whose closing transaction has been broadcast, that have at least one HTLC
+// pending on either their local or remote commitment. Only the number of HTLCs
+// is read from each commitment while scanning, so channels without any pending
+// HTLCs are never fully decoded. Restored channels lack commitments, so
+// they're never returned.
+func (d *DB) FetchChannelsWithPendingHtlcs() ([]*OpenChannel, error) {
+	var channels []*OpenChannel
+	err := d.View(func(tx *bbolt.Tx) error {
+		channels = nil
+
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket == nil {
+			return nil
+		}
+
+		return forEachChanBucket(openChanBucket, func(_, _ []byte,
+			chanBucket *bbolt.Bucket) error {
+
+			header, err := fetchChanInfoHeader(chanBucket)
+			if err != nil {
+				return err
+			}
+			if header.chanStatus&ChanStatusRestored != 0 {
+				return nil
+			}
+
+			numLocal, err := fetchCommitNumHtlcs(chanBucket, true)
+			if err != nil {
+				return err
+			}
+			numRemote, err := fetchCommitNumHtlcs(chanBucket, false)
+			if err != nil {
+				return err
+			}
+			if numLocal == 0 && numRemote == 0 {
+				return nil
+			}
+
+			channel, err := fetchOpenChannel(
+				chanBucket, &header.fundingOutpoint,
+			)
+			if err != nil {
+				return err
+			}
+			channel.Db = d
+
+			channels = append(channels, channel)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+// FetchChannelCommitHeights returns the commitment heights of all open
+// channels, keyed by their funding outpoint. Only the heights are read from
+// each channel's bucket, without deserializing the full commitments, making
+// this a cheap way to monitor for channels that haven't advanced. Restored
+// channels lack commitments, so their heights are zero.
+func (d *DB) FetchChannelCommitHeights() (map[wire.OutPoint]CommitHeights,
+	error) {
+
+	chanHeights := make(map[wire.OutPoint]CommitHeights)
+	err := d.View(func(tx *bbolt.Tx) error {
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket == nil {
+			return ErrNoActiveChannels
+		}
+
+		return forEachChanBucket(openChanBucket, func(_,
+			chanPoint []byte, chanBucket *bbolt.Bucket) error {
+
+			var op wire.OutPoint
+			err := readOutpoint(bytes.NewReader(chanPoint), &op)
+			if err != nil {
+				return err
+			}
+
+			header, err := fetchChanInfoHeader(chanBucket)
+			if err != nil {
+				return err
+			}
+
+			var localHeight, remoteHeight uint64
+			if header.chanStatus&ChanStatusRestored == 0 {
+				localHeight, err = fetchCommitHeight(
+					chanBucket, true,
+				)
+				if err != nil {
+					return err
+				}
+				remoteHeight, err = fetchCommitHeight(
+					chanBucket, false,
+				)
+				if err != nil {
+					return err
+				}
+			}
+			heights := CommitHeights{
+				LocalCommitHeight:  localHeight,
+				RemoteCommitHeight: remoteHeight,
+			}
+
+			// The revocation log is keyed by the height of each
+			// revoked commitment, so the counter follows from the
+			// height of its tail.
+			logBucket := chanBucket.Bucket(revocationLogBucket)
+			if logBucket != nil {
+				tailKey, _ := logBucket.Cursor().Last()
+				if tailKey != nil {
+					heights.RevocationCounter =
+						byteOrder.Uint64(tailKey) + 1
+				}
+			}
+
+			chanHeights[op] = heights
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return chanHeights, nil
+}
+
+// ChannelStateFingerprint computes a deterministic fingerprint of the set of
+// open channels within the database, covering the funding outpoint and the
+// local and remote commitment heights of each channel. All channels are read
+// within a single transaction, so the fingerprint reflects a consistent view
+// of the database. It's intended to be embedded within backups, such that a
+// restore can be verified to have captured the same set of channels by
+// recomputing the fingerprint over the restored database. The commitment
+// heights of restored channels, which lack commitments, are zero.
+func (d *DB) ChannelStateFingerprint() ([]byte, error) {
+	var entries [][]byte
+	err := d.View(func(tx *bbolt.Tx) error {
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket == nil {
+			return ErrNoActiveChannels
+		}
+
+		return forEachChanBucket(openChanBucket, func(_,
+			chanPoint []byte, chanBucket *bbolt.Bucket) error {
+
+			header, err := fetchChanInfoHeader(chanBucket)
+			if err != nil {
+				return err
+			}
+
+			var localHeight, remoteHeight uint64
+			if header.chanStatus&ChanStatusRestored == 0 {
+				localHeight, err = fetchCommitHeight(
+					chanBucket, true,
+				)
+				if err != nil {
+					return err
+				}
+				remoteHeight, err = fetchCommitHeight(
+					chanBucket, false,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			entry := make([]byte, len(chanPoint)+16)
+			copy(entry, chanPoint)
+			byteOrder.PutUint64(entry[len(chanPoint):], localHeight)
+			byteOrder.PutUint64(
+				entry[len(chanPoint)+8:], remoteHeight,
+			)
+			entries = append(entries, entry)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The channels are sorted by their outpoint, such that the
+	// fingerprint doesn't depend on how they're laid out on disk.
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i], entries[j]) < 0
+	})
+
+	h := sha256.New()
+	for _, entry := range entries {
+		h.Write(entry)
+	}
+
+	return h.Sum(nil), nil
+}
+
+// FetchOpenChannelForID attempts to locate an open channel using the channel ID
+// of the channel in question. If the channel cannot be found, then
+// ErrChannelNotFound is returned.
+func (d *DB) FetchOpenChannelForID(cid lnwire.ChannelID) (*OpenChannel, error) {
+	var targetChan *OpenChannel
+	err := d.View(func(tx *bbolt.Tx) error {
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket == nil {
+			return ErrNoActiveChannels
+		}
+
+		return openChanBucket.ForEach(func(nodePub, v []byte) error {
+			// Ensure that this is a key the same size as a pubkey,
+			// and also that it leads directly to a bucket.
+			if len(nodePub) != 33 || v != nil || targetChan != nil {
+				return nil
+			}
+
+			nodeChanBucket := openChanBucket.Bucket(nodePub)
+			if nodeChanBucket == nil {
+				return nil
+			}
+
+			return nodeChanBucket.ForEach(func(chainHash, v []byte) error {
+				// If there's a value, it's not a bucket so
+				// ignore it.
+				if v != nil || targetChan != nil {
+					return nil
+				}
+
+				chainBucket := nodeChanBucket.Bucket(chainHash)
+				if chainBucket == nil {
+					return fmt.Errorf("unable to read "+
+						"bucket for chain=%x", chainHash[:])
+				}
+
+				channel, err := fetchChannelForID(
+					chainBucket, cid,
+				)
+				if err != nil {
+					return err
+				}
+
+				targetChan = channel
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if targetChan == nil {
+		return nil, ErrChannelNotFound
+	}
+	targetChan.Db = d
+
+	return targetChan, nil
+}
+
+// fetchChannelForID searches the passed chain bucket for a channel matching
+// the target channel ID. As the first 30 bytes of the channel ID and the
+// serialized outpoint are equal, we're able to seek directly to the set of
+// candidates. If no channel matches, then a nil channel is returned.
+func fetchChannelForID(chainBucket *bbolt.Bucket,
+	cid lnwire.ChannelID) (*OpenChannel, error) {
+
+	cursor := chainBucket.Cursor()
+	for op, v := cursor.Seek(cid[:30]); op != nil; op, v = cursor.Next() {
+		// Once we're past the set of keys sharing our prefix, there
+		// are no further candidates.
+		if len(op) < 30 || !bytes.Equal(op[:30], cid[:30]) {
+			return nil, nil
+		}
+
+		// If there's a value, it's not a bucket so ignore it.
+		if v != nil {
+			continue
+		}
+
+		var outPoint wire.OutPoint
+		err := readOutpoint(bytes.NewReader(op), &outPoint)
+		if err != nil {
+			return nil, err
+		}
+
+		// If the found outpoint does not correspond to this channel
+		// ID, we continue.
+		if !cid.IsChanPoint(&outPoint) {
+			continue
+		}
+
+		chanBucket := chainBucket.Bucket(op)
+		if chanBucket == nil {
+			continue
+		}
+
+		return fetchOpenChannel(chanBucket, &outPoint)
+	}
+
+	return nil, nil
+}
+
+// FetchChannelConfig returns the local and remote channel configurations of
+// the channel identified by the passed channel point. Only the static channel
+// info is read from disk, the commitment and revocation state of the channel
+// isn't deserialized.
+func (d *DB) FetchChannelConfig(chanPoint wire.OutPoint) (*ChannelConfig,
+	*ChannelConfig, error) {
+
+	channel := &OpenChannel{
+		FundingOutpoint: chanPoint,
+	}
+	err := d.View(func(tx *bbolt.Tx) error {
+		chanBucket, err := findChanBucket(tx, &chanPoint)
+		if err != nil {
+			return err
+		}
+
+		return fetchChanInfo(chanBucket, channel)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &channel.LocalChanCfg, &channel.RemoteChanCfg, nil
+}
+
+// findChanBucket locates the bucket of the channel specified by the passed
+// channel point, without knowing the node or chain the channel belongs to. If
+// the channel cannot be found, then ErrChannelNotFound is returned.
+func findChanBucket(tx *bbolt.Tx, chanPoint *wire.OutPoint) (*bbolt.Bucket,
+	error) {
+
+	var targetChanPoint bytes.Buffer
+	if err := writeOutpoint(&targetChanPoint, chanPoint); err != nil {
+		return nil, err
+	}
+
+	// We'll traverse the following bucket structure:
+	//  * nodePub => chainHash => chanPoint
+	//
+	// At each level we go one further, ensuring that we're traversing the
+	// proper key (that's actually a bucket). By only reading the bucket
+	// structure and skipping fully decoding each channel, we save a good
+	// bit of CPU as we don't need to do things like decompress public
+	// keys.
+	openChanBucket := tx.Bucket(openChannelBucket)
+	if openChanBucket == nil {
+		return nil, ErrNoActiveChannels
+	}
+
+	// Within the node channel bucket, are the set of node pubkeys we have
+	// channels with, we don't know the entire set, so we'll check them
+	// all.
+	var targetBucket *bbolt.Bucket
+	err := openChanBucket.ForEach(func(nodePub, v []byte) error {
+		// Ensure that this is a key the same size as a pubkey, and
+		// also that it leads directly to a bucket.
+		if len(nodePub) != 33 || v != nil || targetBucket != nil {
+			return nil
+		}
+
+		nodeChanBucket := openChanBucket.Bucket(nodePub)
+		if nodeChanBucket == nil {
+			return nil
+		}
+
+		// The next layer down is all the chains that this node has
+		// channels on with us.
+		return nodeChanBucket.ForEach(func(chainHash, v []byte) error {
+			// If there's a value, it's not a bucket so ignore it.
+			if v != nil || targetBucket != nil {
+				return nil
+			}
+
+			chainBucket := nodeChanBucket.Bucket(chainHash)
+			if chainBucket == nil {
+				return fmt.Errorf("unable to read bucket for "+
+					"chain=%x", chainHash[:])
+			}
+
+			// Finally we reach the leaf bucket that stores all the
+			// chanPoints for this node.
+			targetBucket = chainBucket.Bucket(
+				targetChanPoint.Bytes(),
+			)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// If we can't find the channel, then we return with an error, as we
+	// have nothing to backup.
+	if targetBucket == nil {
+		return nil, ErrChannelNotFound
+	}
+
+	return targetBucket, nil
+}
+
+// FetchAllChannels attempts to retrieve all open channels currently stored
+// within the database, including pending open, fully open and channels waiting
+// for a closing transaction to confirm.
+func (d *DB) FetchAllChannels() ([]*OpenChannel, error) {
+	return fetchChannelsWithFilter(d, func(*OpenChannel) bool {
+		return true
+	})
+}
+
+// FetchAllOpenChannels will return all channels that have the funding
+// transaction confirmed, and is not waiting for a closing transaction to be
+// confirmed.
+func (d *DB) FetchAllOpenChannels() ([]*OpenChannel, error) {
+	return fetchChannels(d, false, false)
+}
+
+// FetchChannelsByCapacityRange returns all open channels, as returned by
+// FetchAllOpenChannels, whose capacity lies within the inclusive range
+// [minCapacity, maxCapacity]. As capacity isn't indexed, every channel is
+// scanned, but only the fixed size header of each channel is read to compare
+// its capacity, such that channels outside of the range are never decoded.
+func (d *DB) FetchChannelsByCapacityRange(minCapacity,
+	maxCapacity btcutil.Amount) ([]*OpenChannel, error) {
+
+	var channels []*OpenChannel
+	err := d.View(func(tx *bbolt.Tx) error {
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket == nil {
+			return ErrNoActiveChannels
+		}
+
+		return forEachChanBucket(openChanBucket, func(_, _ []byte,
+			chanBucket *bbolt.Bucket) error {
+
+			header, err := fetchChanInfoHeader(chanBucket)
+			if err != nil {
+				return err
+			}
+			if header.isPending ||
+				header.chanStatus != ChanStatusDefault {
+
+				return nil
+			}
+			if header.capacity < minCapacity ||
+				header.capacity > maxCapacity {
+
+				return nil
+			}
+
+			channel, err := fetchOpenChannel(
+				chanBucket, &header.fundingOutpoint,
+			)
+			if err != nil {
+				return err
+			}
+			channel.Db = d
+
+			channels = append(channels, channel)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+// FetchPendingChannels will return channels that have completed the process of
+// generating and broadcasting funding transactions, but whose funding
+// transactions have yet to be confirmed on the blockchain.
+func (d *DB) FetchPendingChannels() ([]*OpenChannel, error) {
+	return fetchChannels(d, true, false)
+}
+
+// FetchWaitingCloseChannels will return all channels that have been opened,
+// but are now waiting for a closing transaction to be confirmed.
+//
+// NOTE: This includes channels that are also pending to be opened.
+func (d *DB) FetchWaitingCloseChannels() ([]*OpenChannel, error) {
+	return fetchChannelsWithFilter(d, func(channel *OpenChannel) bool {
+		return channel.ChanStatus() != ChanStatusDefault
+	})
+}
+
+// FetchChannelsNeedingResolution returns all channels that are waiting for a
+// closing transaction to be confirmed, and which still have outputs that must
+// be resolved on-chain once it is. If unresolved outputs have been recorded
+// for a channel through AddUnresolvedOutputs, then these are authoritative and
+// the channel is returned. Otherwise, we fall back to a heuristic over the
+// channel state: channels with HTLCs on either commitment, or whose local
+// balance exceeds our dust limit, such that our commitment would carry a
+// time-locked output to sweep, are returned. As the balances of restored
+// channels and those that have lost state can't be relied upon, they are
+// always returned.
+func (d *DB) FetchChannelsNeedingResolution() ([]*OpenChannel, error) {
+	return fetchChannelsWithTxFilter(d, func(tx *bbolt.Tx,
+		channel *OpenChannel) (bool, error) {
+
+		chanStatus := channel.ChanStatus()
+		if chanStatus == ChanStatusDefault {
+			return false, nil
+		}
+
+		outputs, err := fetchUnresolvedOutputs(
+			tx, &channel.FundingOutpoint,
+		)
+		if err != nil {
+			return false, err
+		}
+		if len(outputs) > 0 {
+			return true, nil
+		}
+
+		if chanStatus&ChanStatusRestored != 0 ||
+			chanStatus&ChanStatusLocalDataLoss != 0 {
+
+			return true, nil
+		}
+
+		localCommit := channel.LocalCommitment
+		if len(localCommit.Htlcs) > 0 ||
+			len(channel.RemoteCommitment.Htlcs) > 0 {
+
+			return true, nil
+		}
+
+		localBalance := localCommit.LocalBalance.ToSatoshis()
+		return localBalance > 0 &&
+			localBalance >= channel.LocalChanCfg.DustLimit, nil
+	})
+}
+
+// ChannelStatusCounts returns the number of open channels that have each of
+// the known status flags set, computed in a single pass over the database.
+// Only the fixed size header of each channel is read, rather than decoding it
+// in full. As a channel may have several flags set at once, it's counted once
+// for each of them, while a channel with no flags set is counted under
+// ChanStatusDefault. Flags that no channel has set are omitted.
+func (d *DB) ChannelStatusCounts() (map[ChannelStatus]uint32, error) {
+	var counts map[ChannelStatus]uint32
+	err := d.View(func(tx *bbolt.Tx) error {
+		counts = make(map[ChannelStatus]uint32)
+
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket == nil {
+			return nil
+		}
+
+		return forEachChanBucket(openChanBucket, func(_, _ []byte,
+			chanBucket *bbolt.Bucket) error {
+
+			header, err := fetchChanInfoHeader(chanBucket)
+			if err != nil {
+				return err
+			}
+
+			if header.chanStatus == ChanStatusDefault {
+				counts[ChanStatusDefault]++
+				return nil
+			}
+			for _, flag := range orderedChanStatusFlags {
+				if flag != ChanStatusDefault &&
+					header.chanStatus&flag == flag {
+
+					counts[flag]++
+				}
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// FetchChannelInitiators returns whether we initiated each of the channels
+// within the set of open channels, including those still pending, keyed by
+// their funding outpoint. As the initiator of a channel pays its commitment
+// fee, this allows the channels we pay fees for to be determined. Only the
+// fixed size header of each channel is read, so no channel is fully decoded.
+func (d *DB) FetchChannelInitiators() (map[wire.OutPoint]bool, error) {
+	var initiators map[wire.OutPoint]bool
+	err := d.View(func(tx *bbolt.Tx) error {
+		initiators = make(map[wire.OutPoint]bool)
+
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket == nil {
+			return nil
+		}
+
+		return forEachChanBucket(openChanBucket, func(_, _ []byte,
+			chanBucket *bbolt.Bucket) error {
+
+			header, err := fetchChanInfoHeader(chanBucket)
+			if err != nil {
+				return err
+			}
+			initiators[header.fundingOutpoint] = header.isInitiator
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return initiators, nil
+}
+
+// FetchChannelsByCsvDelay returns all open channels, including those still
+// pending, whose CSV delay imposed by the remote party on our outputs exceeds
+// minDelay. As such channels tie up our funds for longer once force closed,
+// this allows channels negotiated with risky parameters to be spotted. Only
+// the static channel info, which includes the channel configs, is decoded to
+// check the delay, so only the matching channels are fully decoded.
+func (d *DB) FetchChannelsByCsvDelay(minDelay uint16) ([]*OpenChannel,
+	error) {
+
+	var channels []*OpenChannel
+	err := d.View(func(tx *bbolt.Tx) error {
+		channels = nil
+
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket == nil {
+			return nil
+		}
+
+		return forEachChanBucket(openChanBucket, func(_, _ []byte,
+			chanBucket *bbolt.Bucket) error {
+
+			var info OpenChannel
+			if err := fetchChanInfo(chanBucket, &info); err != nil {
+				return err
+			}
+			if info.RemoteChanCfg.CsvDelay <= minDelay {
+				return nil
+			}
+
+			channel, err := fetchOpenChannel(
+				chanBucket, &info.FundingOutpoint,
+			)
+			if err != nil {
+				return err
+			}
+			channel.Db = d
+
+			channels = append(channels, channel)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+const (
+	// ChanAgeUnderWeek is the age bucket of channels confirmed less than a
+	// week, or 1008 blocks, ago.
+	ChanAgeUnderWeek = "<1 week"
+
+	// ChanAgeUnderMonth is the age bucket of channels confirmed at least a
+	// week, but less than a month, or 4320 blocks, ago.
+	ChanAgeUnderMonth = "<1 month"
+
+	// ChanAgeUnderSixMonths is the age bucket of channels confirmed at
+	// least a month, but less than six months, or 25920 blocks, ago.
+	ChanAgeUnderSixMonths = "<6 months"
+
+	// ChanAgeOlder is the age bucket of channels confirmed at least six
+	// months ago.
+	ChanAgeOlder = "older"
+)
+
+// chanAgeBuckets are the upper bounds, in blocks, of each of the channel age
+// buckets other than ChanAgeOlder, in ascending order.
+var chanAgeBuckets = []struct {
+	maxAge uint32
+	label  string
+}{
+	{maxAge: 7 * 144, label: ChanAgeUnderWeek},
+	{maxAge: 30 * 144, label: ChanAgeUnderMonth},
+	{maxAge: 180 * 144, label: ChanAgeUnderSixMonths},
+}
+
+// ChannelAgeBuckets classifies the open channels, as returned by
+// FetchAllOpenChannels, by their age relative to the passed current height
+// within a single pass over the database. The age of a channel is derived from
+// the block height its funding transaction confirmed at, as embedded within
+// its short channel ID. The returned map is keyed by the age buckets
+// ChanAgeUnderWeek, ChanAgeUnderMonth, ChanAgeUnderSixMonths and ChanAgeOlder,
+// omitting buckets without any channels.
+func (d *DB) ChannelAgeBuckets(currentHeight uint32) (map[string]uint32,
+	error) {
+
+	var buckets map[string]uint32
+	err := d.View(func(tx *bbolt.Tx) error {
+		buckets = make(map[string]uint32)
+
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket == nil {
+			return nil
+		}
+
+		return forEachChanBucket(openChanBucket, func(_, _ []byte,
+			chanBucket *bbolt.Bucket) error {
+
+			header, err := fetchChanInfoHeader(chanBucket)
+			if err != nil {
+				return err
+			}
+			if header.isPending ||
+				header.chanStatus != ChanStatusDefault {
+
+				return nil
+			}
+
+			// A channel confirmed above the current height, e.g.
+			// if the caller lags behind the chain, is treated as
+			// having just confirmed.
+			var age uint32
+			openHeight := header.shortChannelID.BlockHeight
+			if currentHeight > openHeight {
+				age = currentHeight - openHeight
+			}
+
+			label := ChanAgeOlder
+			for _, bucket := range chanAgeBuckets {
+				if age < bucket.maxAge {
+					label = bucket.label
+					break
+				}
+			}
+			buckets[label]++
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return buckets, nil
+}
+
+// FetchChannelsWithStatus returns all channels which match any of the passed
+// status flags, collected within a single pass over the database. A channel
+// matches ChanStatusDefault only if none of its status flags are set, while it
+// matches any other status if that status is set within its bit field.
+func (d *DB) FetchChannelsWithStatus(
+	statuses ...ChannelStatus) ([]*OpenChannel, error) {
+
+	return fetchChannelsWithFilter(d, func(channel *OpenChannel) bool {
+		chanStatus := channel.ChanStatus()
+		for _, status := range statuses {
+			if status == ChanStatusDefault {
+				if chanStatus == ChanStatusDefault {
+					return true
+				}
+				continue
+			}
+
+			if chanStatus&status == status {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+// fetchChannels attempts to retrieve channels currently stored in the
+// database. The pending parameter determines whether only pending channels
+// will be returned, or only open channels will be returned. The waitingClose
+// parameter determines whether only channels waiting for a closing transaction
+// to be confirmed should be returned. If no active channels exist within the
+// network, then ErrNoActiveChannels is returned.
+func fetchChannels(d *DB, pending, waitingClose bool) ([]*OpenChannel, error) {
+	return fetchChannelsWithFilter(d, func(channel *OpenChannel) bool {
+		if channel.IsPending != pending {
+			return false
+		}
+
+		// If the channel is in any other state than Default, then it
+		// means it is waiting to be closed.
+		channelWaitingClose := channel.ChanStatus() != ChanStatusDefault
+
+		// Only include it if we requested channels with the same
+		// waitingClose status.
+		return channelWaitingClose == waitingClose
+	})
+}
+
+// fetchChannelsWithFilter retrieves all channels currently stored in the
+// database for which the passed filter returns true, within a single
+// transaction. If no active channels exist within the network, then
+// ErrNoActiveChannels is returned.
+func fetchChannelsWithFilter(d *DB,
+	filter func(*OpenChannel) bool) ([]*OpenChannel, error) {
+
+	return fetchChannelsWithTxFilter(d, func(_ *bbolt.Tx,
+		channel *OpenChannel) (bool, error) {
+
+		return filter(channel), nil
+	})
+}
+
+// fetchChannelsWithTxFilter is identical to fetchChannelsWithFilter, but
+// passes the transaction the channels are read within to the filter, allowing
+// it to consult other buckets as well.
+func fetchChannelsWithTxFilter(d *DB, filter func(*bbolt.Tx,
+	*OpenChannel) (bool, error)) ([]*OpenChannel, error) {
+
+	var channels []*OpenChannel
+
+	err := d.View(func(tx *bbolt.Tx) error {
+		// Get the bucket dedicated to storing the metadata for open
+		// channels.
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket == nil {
+			return ErrNoActiveChannels
+		}
+
+		// Next, fetch the bucket dedicated to storing metadata related
+		// to all nodes. All keys within this bucket are the serialized
+		// public keys of all our direct counterparties.
+		nodeMetaBucket := tx.Bucket(nodeInfoBucket)
+		if nodeMetaBucket == nil {
+			return fmt.Errorf("node bucket not created")
+		}
+
+		// Finally for each node public key in the bucket, fetch all
+		// the channels related to this particular node.
+		return nodeMetaBucket.ForEach(func(k, v []byte) error {
+			nodeChanBucket := openChanBucket.Bucket(k)
+			if nodeChanBucket == nil {
+				return nil
+			}
+
+			return nodeChanBucket.ForEach(func(chainHash, v []byte) error {
+				// If there's a value, it's not a bucket so
+				// ignore it.
+				if v != nil {
+					return nil
+				}
+
+				// If we've found a valid chainhash bucket,
+				// then we'll retrieve that so we can extract
+				// all the channels.
+				chainBucket := nodeChanBucket.Bucket(chainHash)
+				if chainBucket == nil {
+					return fmt.Errorf("unable to read "+
+						"bucket for chain=%x", chainHash[:])
+				}
+
+				nodeChans, err := d.fetchNodeChannels(chainBucket)
+				if err != nil {
+					return fmt.Errorf("unable to read "+
+						"channel for chain_hash=%x, "+
+						"node_key=%x: %v", chainHash[:], k, err)
+				}
+				for _, channel := range nodeChans {
+					ok, err := filter(tx, channel)
+					if err != nil {
+						return err
+					}
+					if !ok {
+						continue
+					}
+
+					channels = append(channels, channel)
+				}
+				return nil
+			})
+
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+// FetchClosedChannels attempts to fetch all closed channels from the database.
+// The pendingOnly bool toggles if channels that aren't yet fully closed should
+// be returned in the response or not. When a channel was cooperatively closed,
+// it becomes fully closed after a single confirmation.  When a channel was
+// forcibly closed, it will become fully closed after _all_ the pending funds
+// (if any) have been swept.
+func (d *DB) FetchClosedChannels(pendingOnly bool) ([]*ChannelCloseSummary, error) {
+	var chanSummaries []*ChannelCloseSummary
+
+	if err := d.View(func(tx *bbolt.Tx) error {
+		closeBucket := tx.Bucket(closedChannelBucket)
+		if closeBucket == nil {
+			return ErrNoClosedChannels
+		}
+
+		return closeBucket.ForEach(func(chanID []byte, summaryBytes []byte) error {
+			summaryReader := bytes.NewReader(summaryBytes)
+			chanSummary, err := deserializeCloseChannelSummary(summaryReader)
+			if err != nil {
+				return err
+			}
+
+			// If the query specified to only include pending
+			// channels, then we'll skip any channels which aren't
+			// currently pending.
+			if !chanSummary.IsPending && pendingOnly {
+				return nil
+			}
+
+			chanSummaries = append(chanSummaries, chanSummary)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return chanSummaries, nil
+}
+
+// FetchMaturityHeights returns the height at which our time locked outputs
+// become spendable for each channel that has been closed, but isn't yet fully
+// resolved, keyed by its funding outpoint. The maturity height is the close
+// height of the channel offset by the CSV delay of our outputs, as recorded
+// within its close summary, allowing sweeps to be scheduled without deriving
+// it each time.
+//
+// Our CSV delay only applies to our output of our own commitment, so only
+// channels we force closed with a time locked balance are included.
+// Cooperative closes, remote force closes and breaches pay out to us without
+// our delay, while canceled and abandoned channels have no outputs to sweep,
+// so all of these are skipped, as are channels with no CSV delay recorded.
+func (d *DB) FetchMaturityHeights() (map[wire.OutPoint]uint32, error) {
+	var heights map[wire.OutPoint]uint32
+	err := d.View(func(tx *bbolt.Tx) error {
+		heights = make(map[wire.OutPoint]uint32)
+
+		closeBucket := tx.Bucket(closedChannelBucket)
+		if closeBucket == nil {
+			return nil
+		}
+
+		return closeBucket.ForEach(func(_, summaryBytes []byte) error {
+			summary, err := deserializeCloseChannelSummary(
+				bytes.NewReader(summaryBytes),
+			)
+			if err != nil {
+				return err
+			}
+
+			csvDelay := summary.LocalChanConfig.CsvDelay
+			switch {
+			case !summary.IsPending:
+				return nil
+
+			case summary.CloseType != LocalForceClose:
+				return nil
+
+			case summary.TimeLockedBalance == 0 || csvDelay == 0:
+				return nil
+			}
+			heights[summary.ChanPoint] = summary.CloseHeight +
+				uint32(csvDelay)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return heights, nil
+}
+
+// ClosedChannelHeightRange returns the lowest and highest close height found
+// amongst all closed channels within the database. Only the close height of
+// each summary is decoded. If no channels have been closed yet, then
+// ErrNoClosedChannels is returned.
+func (d *DB) ClosedChannelHeightRange() (uint32, uint32, error) {
+	var (
+		minHeight uint32 = math.MaxUint32
+		maxHeight uint32
+		found     bool
+	)
+	err := d.View(func(tx *bbolt.Tx) error {
+		closeBucket := tx.Bucket(closedChannelBucket)
+		if closeBucket == nil {
+			return ErrNoClosedChannels
+		}
+
+		return closeBucket.ForEach(func(_, summaryBytes []byte) error {
+			height, err := deserializeCloseHeight(
+				bytes.NewReader(summaryBytes),
+			)
+			if err != nil {
+				return err
+			}
+
+			if height < minHeight {
+				minHeight = height
+			}
+			if height > maxHeight {
+				maxHeight = height
+			}
+			found = true
+
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if !found {
+		return 0, 0, ErrNoClosedChannels
+	}
+
+	return minHeight, maxHeight, nil
+}
+
+// FetchClosedChannelsInHeightRange returns all closed channel summaries whose
+// close height falls within the inclusive range [start, end]. As the closed
+// channel bucket is keyed by outpoint, every summary is visited, though only
+// the close height is decoded for summaries that fall outside the range.
+//
+// TODO: add a height ordered index of closed channels so we can seek directly
+// to the start of the range.
+func (d *DB) FetchClosedChannelsInHeightRange(start,
+	end uint32) ([]*ChannelCloseSummary, error) {
+
+	var chanSummaries []*ChannelCloseSummary
+	err := d.View(func(tx *bbolt.Tx) error {
+		closeBucket := tx.Bucket(closedChannelBucket)
+		if closeBucket == nil {
+			return ErrNoClosedChannels
+		}
+
+		return closeBucket.ForEach(func(_, summaryBytes []byte) error {
+			height, err := deserializeCloseHeight(
+				bytes.NewReader(summaryBytes),
+			)
+			if err != nil {
+				return err
+			}
+
+			if height < start || height > end {
+				return nil
+			}
+
+			chanSummary, err := deserializeCloseChannelSummary(
+				bytes.NewReader(summaryBytes),
+			)
+			if err != nil {
+				return err
+			}
+
+			chanSummaries = append(chanSummaries, chanSummary)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return chanSummaries, nil
+}
+
+// FetchClosedChannelsSkipErrors is identical to FetchClosedChannels, but
+// rather than aborting on the first summary that fails to deserialize, each
+// decode failure is logged and collected, and the scan continues. The
+// successfully decoded summaries are returned along with an error for each
+// corrupt entry, which identifies the offending key. This allows the bulk of
+// the close history to be recovered even if a subset of the entries have been
+// damaged, e.g. by a truncated write during an unclean shutdown.
+func (d *DB) FetchClosedChannelsSkipErrors(pendingOnly bool) (
+	[]*ChannelCloseSummary, []error, error) {
+
+	var (
+		chanSummaries []*ChannelCloseSummary
+		decodeErrs    []error
+	)
+	if err := d.View(func(tx *bbolt.Tx) error {
+		closeBucket := tx.Bucket(closedChannelBucket)
+		if closeBucket == nil {
+			return ErrNoClosedChannels
+		}
+
+		return closeBucket.ForEach(func(chanID []byte, summaryBytes []byte) error {
+			summaryReader := bytes.NewReader(summaryBytes)
+			chanSummary, err := deserializeCloseChannelSummary(summaryReader)
+			if err != nil {
+				log.Warnf("Unable to decode close summary for "+
+					"key=%x: %v", chanID, err)
+
+				decodeErrs = append(decodeErrs, fmt.Errorf(
+					"unable to decode close summary for "+
+						"key=%x: %v", chanID, err,
+				))
+				return nil
+			}
+
+			// If the query specified to only include pending
+			// channels, then we'll skip any channels which aren't
+			// currently pending.
+			if !chanSummary.IsPending && pendingOnly {
+				return nil
+			}
+
+			chanSummaries = append(chanSummaries, chanSummary)
+			return nil
+		})
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	return chanSummaries, decodeErrs, nil
+}
+
+// ErrClosedChannelNotFound signals that a closed channel could not be found in
+// the channeldb.
+var ErrClosedChannelNotFound = errors.New("unable to find closed channel summary")
+
+// FetchClosedChannel queries for a channel close summary using the channel
+// point of the channel in question.
+func (d *DB) FetchClosedChannel(chanID *wire.OutPoint) (*ChannelCloseSummary, error) {
+	var chanSummary *ChannelCloseSummary
+	if err := d.View(func(tx *bbolt.Tx) error {
+		var err error
+		chanSummary, err = FetchClosedChannelTx(tx, chanID)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return chanSummary, nil
+}
+
+// IsChannelClosed returns true if a close summary for the channel with the
+// passed channel point exists within the database. Unlike FetchClosedChannel,
+// the summary isn't deserialized, making this a cheap check.
+func (d *DB) IsChannelClosed(chanPoint wire.OutPoint) (bool, error) {
+	var b bytes.Buffer
+	if err := writeOutpoint(&b, &chanPoint); err != nil {
+		return false, err
+	}
+
+	var closed bool
+	err := d.View(func(tx *bbolt.Tx) error {
+		closeBucket := tx.Bucket(closedChannelBucket)
+		if closeBucket == nil {
+			return nil
+		}
+
+		closed = closeBucket.Get(b.Bytes()) != nil
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return closed, nil
+}
+
+// FetchClosedChannelRaw returns a copy of the serialized close summary of the
+// channel with the passed channel point, exactly as it's stored within the
+// database. This allows close summaries to be replicated to another database
+// using PutClosedChannelRaw without decoding and re-encoding them. If no close
+// summary exists, then ErrClosedChannelNotFound is returned.
+func (d *DB) FetchClosedChannelRaw(chanPoint wire.OutPoint) ([]byte, error) {
+	var b bytes.Buffer
+	if err := writeOutpoint(&b, &chanPoint); err != nil {
+		return nil, err
+	}
+
+	var summaryBytes []byte
+	err := d.View(func(tx *bbolt.Tx) error {
+		closeBucket := tx.Bucket(closedChannelBucket)
+		if closeBucket == nil {
+			return ErrClosedChannelNotFound
+		}
+
+		storedBytes := closeBucket.Get(b.Bytes())
+		if storedBytes == nil {
+			return ErrClosedChannelNotFound
+		}
+		summaryBytes = copySlice(storedBytes)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return summaryBytes, nil
+}
+
+// PutClosedChannelRaw stores the passed serialized close summary, as returned
+// by FetchClosedChannelRaw, for the channel with the passed channel point,
+// replacing any existing summary. The summary is stored byte for byte, but
+// it's decoded beforehand to ensure it's valid and belongs to the channel.
+// Only the close summary itself is written, so none of the other state
+// written when a channel is closed, such as the archived channel state, is
+// replicated.
+func (d *DB) PutClosedChannelRaw(chanPoint wire.OutPoint,
+	summaryBytes []byte) error {
+
+	summary, err := deserializeCloseChannelSummary(
+		bytes.NewReader(summaryBytes),
+	)
+	if err != nil {
+		return fmt.Errorf("invalid close summary: %v", err)
+	}
+	if summary.ChanPoint != chanPoint {
+		return fmt.Errorf("close summary is for chan_point=%v, "+
+			"expected chan_point=%v", summary.ChanPoint, chanPoint)
+	}
+
+	var b bytes.Buffer
+	if err := writeOutpoint(&b, &chanPoint); err != nil {
+		return err
+	}
+
+	return d.Update(func(tx *bbolt.Tx) error {
+		closeBucket, err := tx.CreateBucketIfNotExists(
+			closedChannelBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		return closeBucket.Put(b.Bytes(), summaryBytes)
+	})
+}
+
+// FetchClosedChannelTx is identical to FetchClosedChannel, but uses the
+// passed transaction rather than opening a new one.
+func FetchClosedChannelTx(tx *bbolt.Tx,
+	chanID *wire.OutPoint) (*ChannelCloseSummary, error) {
+
+	closeBucket := tx.Bucket(closedChannelBucket)
+	if closeBucket == nil {
+		return nil, ErrClosedChannelNotFound
+	}
+
+	var b bytes.Buffer
+	if err := writeOutpoint(&b, chanID); err != nil {
+		return nil, err
+	}
+
+	summaryBytes := closeBucket.Get(b.Bytes())
+	if summaryBytes == nil {
+		return nil, ErrClosedChannelNotFound
+	}
+
+	summaryReader := bytes.NewReader(summaryBytes)
+	return deserializeCloseChannelSummary(summaryReader)
+}
+
+// FetchClosedChannelForID queries for a channel close summary using the
+// channel ID of the channel in question.
+func (d *DB) FetchClosedChannelForID(cid lnwire.ChannelID) (
+	*ChannelCloseSummary, error) {
+
+	var chanSummary *ChannelCloseSummary
+	if err := d.View(func(tx *bbolt.Tx) error {
+		closeBucket := tx.Bucket(closedChannelBucket)
+		if closeBucket == nil {
+			return ErrClosedChannelNotFound
+		}
+
+		// The first 30 bytes of the channel ID and outpoint will be
+		// equal.
+		cursor := closeBucket.Cursor()
+		op, c := cursor.Seek(cid[:30])
+
+		// We scan over all possible candidates for this channel ID.
+		for ; op != nil && bytes.Compare(cid[:30], op[:30]) <= 0; op, c = cursor.Next() {
+			var outPoint wire.OutPoint
+			err := readOutpoint(bytes.NewReader(op), &outPoint)
+			if err != nil {
+				return err
+			}
+
+			// If the found outpoint does not correspond to this
+			// channel ID, we continue.
+			if !cid.IsChanPoint(&outPoint) {
+				continue
+			}
+
+			// Deserialize the close summary and return.
+			r := bytes.NewReader(c)
+			chanSummary, err = deserializeCloseChannelSummary(r)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		}
+		return ErrClosedChannelNotFound
+	}); err != nil {
+		return nil, err
+	}
+
+	return chanSummary, nil
+}
+
+// ImportClosedChannel writes the passed close summary into the set of closed
+// channels, for instance when consolidating the close history of another
+// node. If a summary for the same channel point already exists, then it's
+// left untouched, making the import safe to re-run.
+func (d *DB) ImportClosedChannel(summary *ChannelCloseSummary) error {
+	switch {
+	case summary.ChanPoint == (wire.OutPoint{}):
+		return fmt.Errorf("close summary has no channel point")
+
+	case summary.ChainHash == (chainhash.Hash{}):
+		return fmt.Errorf("close summary for channel %v has no chain "+
+			"hash", summary.ChanPoint)
+
+	case summary.RemotePub == nil:
+		return fmt.Errorf("close summary for channel %v has no remote "+
+			"pub", summary.ChanPoint)
+	}
+
+	var k, v bytes.Buffer
+	if err := writeOutpoint(&k, &summary.ChanPoint); err != nil {
+		return err
+	}
+	if err := serializeChannelCloseSummary(&v, summary); err != nil {
+		return err
+	}
+
+	// Imports are typically made in bulk, so we'll coalesce concurrent
+	// imports into shared transactions. As existing summaries are skipped,
+	// the import is idempotent.
+	return d.Batch(func(tx *bbolt.Tx) error {
+		closedChanBucket, err := tx.CreateBucketIfNotExists(
+			closedChannelBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		if closedChanBucket.Get(k.Bytes()) != nil {
+			log.Debugf("Close summary for ChannelPoint(%v) already "+
+				"exists, skipping import", summary.ChanPoint)
+			return nil
+		}
+
+		return closedChanBucket.Put(k.Bytes(), v.Bytes())
+	})
+}
+
+// PruneClosedChannels deletes the close summaries of all fully closed
+// channels with a close height strictly below the passed cutoff, along with
+// any state retained for them within the closed channel archive, the
+// historical channel bucket, the channel event log and the channel
+// resolutions, returning the number of summaries that were removed. Summaries
+// of channels that are still pending are never removed, regardless of their
+// close height, as they're yet to be resolved.
+func (d *DB) PruneClosedChannels(before uint32) (uint64, error) {
+	var numPruned uint64
+	err := d.Update(func(tx *bbolt.Tx) error {
+		numPruned = 0
+
+		closeBucket := tx.Bucket(closedChannelBucket)
+		if closeBucket == nil {
+			return nil
+		}
+
+		// We'll first collect the keys of all summaries to be pruned,
+		// as deleting entries while iterating over the bucket may
+		// cause entries to be skipped.
+		var staleKeys [][]byte
+		err := closeBucket.ForEach(func(k, summaryBytes []byte) error {
+			summary, err := deserializeCloseChannelSummary(
+				bytes.NewReader(summaryBytes),
+			)
+			if err != nil {
+				return err
+			}
+			if summary.IsPending || summary.CloseHeight >= before {
+				return nil
+			}
+
+			staleKeys = append(staleKeys, copySlice(k))
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range staleKeys {
+			if err := closeBucket.Delete(k); err != nil {
+				return err
+			}
+			if err := deleteClosedChannelState(tx, k); err != nil {
+				return err
+			}
+			numPruned++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numPruned, nil
+}
+
+// deleteClosedChannelState removes all state retained for the closed channel
+// with the passed serialized channel point outside of its close summary.
+func deleteClosedChannelState(tx *bbolt.Tx, chanPoint []byte) error {
+	archive := tx.Bucket(closedChannelArchiveBucket)
+	if archive != nil {
+		if err := archive.Delete(chanPoint); err != nil {
+			return err
+		}
+	}
+
+	// The remaining state is kept within a nested bucket per channel.
+	for _, bucket := range [][]byte{
+		historicalChannelBucket, channelEventLogBucket,
+		chanResolutionsBucket,
+	} {
+		parent := tx.Bucket(bucket)
+		if parent == nil || parent.Bucket(chanPoint) == nil {
+			continue
+		}
+		if err := parent.DeleteBucket(chanPoint); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarkChanFullyClosed marks a channel as fully closed within the database. A
+// channel should be marked as fully closed if the channel was initially
+// cooperatively closed and it's reached a single confirmation, or after all
+// the pending funds in a channel that has been forcibly closed have been
+// swept.
+func (d *DB) MarkChanFullyClosed(chanPoint *wire.OutPoint) error {
+	var chanSummary *ChannelCloseSummary
+	err := d.retryUpdate(func(tx *bbolt.Tx) error {
+		var b bytes.Buffer
+		if err := writeOutpoint(&b, chanPoint); err != nil {
+			return err
+		}
+
+		chanID := b.Bytes()
+
+		closedChanBucket, err := tx.CreateBucketIfNotExists(
+			closedChannelBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		chanSummaryBytes := closedChanBucket.Get(chanID)
+		if chanSummaryBytes == nil {
+			return fmt.Errorf("no closed channel for "+
+				"chan_point=%v found", chanPoint)
+		}
+
+		chanSummaryReader := bytes.NewReader(chanSummaryBytes)
+		chanSummary, err = deserializeCloseChannelSummary(
+			chanSummaryReader,
+		)
+		if err != nil {
+			return err
+		}
+
+		// The channel can only be fully closed once all of its outputs
+		// pending a sweep have been resolved.
+		chanOutputs, err := fetchChanResolutions(tx, chanPoint)
+		if err != nil {
+			return err
+		}
+		if chanOutputs != nil {
+			return ErrChanOutputsUnresolved
+		}
+
+		chanSummary.IsPending = false
+
+		var newSummary bytes.Buffer
+		err = serializeChannelCloseSummary(&newSummary, chanSummary)
+		if err != nil {
+			return err
+		}
+
+		err = closedChanBucket.Put(chanID, newSummary.Bytes())
+		if err != nil {
+			return err
+		}
+
+		// Now that the channel is closed, we'll check if we have any
+		// other open channels with this peer. If we don't we'll
+		// garbage collect it to ensure we don't establish persistent
+		// connections to peers without open channels.
+		return d.pruneLinkNode(tx, chanSummary.RemotePub)
+	})
+	if err != nil {
+		return err
+	}
+
+	d.notifyChannelClosed(chanSummary)
+
+	return nil
+}
+
+// pruneLinkNode determines whether we should garbage collect a link node from
+// the database due to no longer having any open channels with it on any
+// chain. If there are any left, then this acts as a no-op.
+func (d *DB) pruneLinkNode(tx *bbolt.Tx, remotePub *btcec.PublicKey) error {
+	hasChannels, err := hasOpenChannels(tx, remotePub)
+	if err != nil {
+		return fmt.Errorf("unable to fetch open channels for peer %x: "+
+			"%v", remotePub.SerializeCompressed(), err)
+	}
+
+	if hasChannels {
+		return nil
+	}
+
+	log.Infof("Pruning link node %x with zero open channels from database",
+		remotePub.SerializeCompressed())
+
+	return d.deleteLinkNode(tx, remotePub)
+}
+
+// HasOpenChannelsWith returns true if we have at least one open channel, on
+// any chain, with the passed peer. Unlike FetchOpenChannels, none of the
+// channels are deserialized, and the search stops at the first channel found.
+func (d *DB) HasOpenChannelsWith(nodePub *btcec.PublicKey) (bool, error) {
+	var hasChannels bool
+	err := d.View(func(tx *bbolt.Tx) error {
+		var err error
+		hasChannels, err = hasOpenChannels(tx, nodePub)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return hasChannels, nil
+}
+
+// hasOpenChannels returns true if we have at least one open channel with the
+// passed peer across every chain, without deserializing any of them.
+func hasOpenChannels(tx *bbolt.Tx, remotePub *btcec.PublicKey) (bool, error) {
+	openChanBucket := tx.Bucket(openChannelBucket)
+	if openChanBucket == nil {
+		return false, nil
+	}
+	nodeChanBucket := openChanBucket.Bucket(remotePub.SerializeCompressed())
+	if nodeChanBucket == nil {
+		return false, nil
+	}
+
+	chains := nodeChanBucket.Cursor()
+	for k, v := chains.First(); k != nil; k, v = chains.Next() {
+		// If there's a value, it's not a bucket so ignore it.
+		if v != nil {
+			continue
+		}
+
+		chainBucket := nodeChanBucket.Bucket(k)
+		if chainBucket == nil {
+			return false, fmt.Errorf("unable to read bucket for "+
+				"chain=%x", k)
+		}
+
+		// Each channel is stored within its own bucket, so the first
+		// nested bucket we come across is an open channel.
+		channels := chainBucket.Cursor()
+		for k, v := channels.First(); k != nil; k, v = channels.Next() {
+			if v == nil {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// PruneLinkNodes attempts to prune all link nodes found within the databse with
+// whom we no longer have any open channels with.
+func (d *DB) PruneLinkNodes() error {
+	return d.PruneLinkNodesExcept(nil)
+}
+
+// PruneLinkNodesExcept attempts to prune all link nodes found within the
+// database with whom we no longer have any open channels with, with the
+// exception of those in the keep set. This allows the caller to retain link
+// nodes for peers they'd like to stay connected to, even without a channel.
+func (d *DB) PruneLinkNodesExcept(keep []*btcec.PublicKey) error {
+	keepSet := make(map[[33]byte]struct{}, len(keep))
+	for _, pub := range keep {
+		var pubBytes [33]byte
+		copy(pubBytes[:], pub.SerializeCompressed())
+		keepSet[pubBytes] = struct{}{}
+	}
+
+	return d.Update(func(tx *bbolt.Tx) error {
+		linkNodes, err := d.fetchAllLinkNodes(tx)
+		if err != nil {
+			return err
+		}
+
+		for _, linkNode := range linkNodes {
+			var pubBytes [33]byte
+			copy(pubBytes[:], linkNode.IdentityPub.SerializeCompressed())
+			if _, ok := keepSet[pubBytes]; ok {
+				continue
+			}
+
+			err := d.pruneLinkNode(tx, linkNode.IdentityPub)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ChannelShell is a shell of a channel that is meant to be used for channel
+// recovery purposes. It contains a minimal OpenChannel instance along with
+// addresses for that target node.
+type ChannelShell struct {
+	// NodeAddrs the set of addresses that this node has known to be
+	// reachable at in the past.
+	NodeAddrs []net.Addr
+
+	// Chan is a shell of an OpenChannel, it contains only the items
+	// required to restore the channel on disk.
+	Chan *OpenChannel
+
+	// SkipGraphRestore, if true, indicates that only the OpenChannel and
+	// LinkNode should be restored. No shell edge or policy will be
+	// inserted into the channel graph, which is useful if the caller
+	// already has authoritative graph data for the channel.
+	SkipGraphRestore bool
+}
+
+// RestoreChannelShells is a method that allows the caller to reconstruct the
+// state of an OpenChannel from the ChannelShell. We'll attempt to write the
+// new channel to disk, create a LinkNode instance with the passed node
+// addresses, and finally create an edge within the graph for the channel as
+// well. This method is idempotent, so repeated calls with the same set of
+// channel shells won't modify the database after the initial call.
+func (d *DB) RestoreChannelShells(channelShells ...*ChannelShell) error {
+	return d.RestoreChannelShellsWithSource(nil, channelShells...)
+}
+
+// RestoreChannelShellsWithSource is identical to RestoreChannelShells, but if
+// the source node hasn't yet been set within the graph, as is the case when
+// restoring onto a fresh database, then a shell source node is created using
+// the passed public key of our own node. If selfPub is nil and the source node
+// isn't set, then ErrSourceNodeNotSet is returned for any channel that
+// restores its graph state.
+func (d *DB) RestoreChannelShellsWithSource(selfPub *btcec.PublicKey,
+	channelShells ...*ChannelShell) error {
+
+	chanGraph := d.ChannelGraph()
+
+	// TODO(conner): find way to do this w/o accessing internal members?
+	chanGraph.cacheMu.Lock()
+	defer chanGraph.cacheMu.Unlock()
+
+	var chansRestored []uint64
+	err := d.retryUpdate(func(tx *bbolt.Tx) error {
+		// As the transaction may be retried, we'll reset the set of
+		// restored channels gathered by any prior attempt.
+		chansRestored = nil
+
+		for _, channelShell := range channelShells {
+			restored, err := d.restoreChannelShell(
+				tx, selfPub, channelShell,
+			)
+			if err != nil {
+				return err
+			}
+			if !restored {
+				continue
+			}
+
+			chansRestored = append(
+				chansRestored,
+				channelShell.Chan.ShortChannelID.ToUint64(),
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, chanid := range chansRestored {
+		chanGraph.rejectCache.remove(chanid)
+		chanGraph.chanCache.remove(chanid)
+	}
+
+	return nil
+}
+
+// RestoreChannelShell restores a single channel shell just as
+// RestoreChannelShellsWithSource does, though its transaction may be shared
+// with those of concurrent calls through Batch, coalescing them into a single
+// commit. This suits callers restoring many channels concurrently, which
+// don't require them to be restored atomically as a set. As the restore is
+// idempotent, it's safe for the batch to retry it.
+func (d *DB) RestoreChannelShell(selfPub *btcec.PublicKey,
+	channelShell *ChannelShell) error {
+
+	var restored bool
+	err := d.Batch(func(tx *bbolt.Tx) error {
+		var err error
+		restored, err = d.restoreChannelShell(tx, selfPub, channelShell)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// The graph caches are only invalidated once the batch has been
+	// committed. Readers populate the caches while holding the cache
+	// mutex throughout, so any entry they added before the commit is
+	// removed here.
+	if restored {
+		chanGraph := d.ChannelGraph()
+		chanID := channelShell.Chan.ShortChannelID.ToUint64()
+
+		chanGraph.cacheMu.Lock()
+		chanGraph.rejectCache.remove(chanID)
+		chanGraph.chanCache.remove(chanID)
+		chanGraph.cacheMu.Unlock()
+	}
+
+	return nil
+}
+
+// restoreChannelShell writes the channel and link node of the passed shell
+// within the passed transaction, along with a shell edge within the graph
+// unless the shell opts out of it. It returns true if the graph state of the
+// channel was restored, in which case its graph cache entries must be
+// invalidated.
+func (d *DB) restoreChannelShell(tx *bbolt.Tx, selfPub *btcec.PublicKey,
+	channelShell *ChannelShell) (bool, error) {
+
+	channel := channelShell.Chan
+
+	// When we make a channel, we mark that the channel has been restored,
+	// this will signal to other sub-systems to not attempt to use the
+	// channel as if it was a regular one.
+	channel.chanStatus |= ChanStatusRestored
+
+	// First, we'll attempt to create a new open channel and link node for
+	// this channel. If the channel already exists, then in order to ensure
+	// this method is idempotent, we'll continue to the next step.
+	channel.Db = d
+	err := syncNewChannel(tx, channel, channelShell.NodeAddrs)
+	if err != nil {
+		return false, err
+	}
+
+	// If the caller has opted out of restoring the graph state for this
+	// channel, then we're done here.
+	if channelShell.SkipGraphRestore {
+		return false, nil
+	}
+
+	// Next, we'll create an active edge in the graph database for this
+	// channel in order to restore our partial view of the network.
+	//
+	// TODO(roasbeef): if we restore *after* the channel has been closed on
+	// chain, then need to inform the router that it should try and prune
+	// these values as we can detect them
+	nodes := tx.Bucket(nodeBucket)
+	if nodes == nil {
+		return false, ErrGraphNotFound
+	}
+	chanGraph := d.ChannelGraph()
+	selfNode, err := chanGraph.sourceNode(nodes)
+	switch {
+	// If the source node isn't set yet, and we know our own public key,
+	// then we'll insert a shell source node so the edge has an origin.
+	case err == ErrSourceNodeNotSet && selfPub != nil:
+		selfNode, err = putShellSourceNode(tx, nodes, selfPub)
+		if err != nil {
+			return false, err
+		}
+		selfNode.db = d
+
+	case err != nil:
+		return false, err
+	}
+
+	err = putChannelEdgeShell(tx, chanGraph, selfNode, channel)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// putChannelEdgeShell adds a shell edge for the passed channel to the graph,
+// along with a shell policy for our direction of the channel. Such an edge
+// lacks an announcement, but allows us to maintain a partial view of the
+// network comprising our own channels.
+func putChannelEdgeShell(tx *bbolt.Tx, chanGraph *ChannelGraph,
+	selfNode *LightningNode, channel *OpenChannel) error {
+
+	edgeInfo := ChannelEdgeInfo{
+		ChannelID:    channel.ShortChannelID.ToUint64(),
+		ChainHash:    channel.ChainHash,
+		ChannelPoint: channel.FundingOutpoint,
+		Capacity:     channel.Capacity,
+	}
+
+	// Depending on which pub key is smaller, we'll assign our roles as
+	// "node1" and "node2".
+	chanPeer := channel.IdentityPub.SerializeCompressed()
+	selfIsSmaller := bytes.Compare(selfNode.PubKeyBytes[:], chanPeer) == -1
+	if selfIsSmaller {
+		copy(edgeInfo.NodeKey1Bytes[:], selfNode.PubKeyBytes[:])
+		copy(edgeInfo.NodeKey2Bytes[:], chanPeer)
+	} else {
+		copy(edgeInfo.NodeKey1Bytes[:], chanPeer)
+		copy(edgeInfo.NodeKey2Bytes[:], selfNode.PubKeyBytes[:])
+	}
+
+	// With the edge info shell constructed, we'll now add it to the graph.
+	err := chanGraph.addChannelEdge(tx, &edgeInfo)
+	if err != nil && err != ErrEdgeAlreadyExist {
+		return err
+	}
+
+	// Similarly, we'll construct a channel edge shell and add that itself
+	// to the graph.
+	chanEdge := ChannelEdgePolicy{
+		ChannelID:  edgeInfo.ChannelID,
+		LastUpdate: time.Now(),
+	}
+
+	// If their pubkey is larger, then we'll flip the direction bit to
+	// indicate that us, the "second" node is updating their policy.
+	if !selfIsSmaller {
+		chanEdge.ChannelFlags |= lnwire.ChanUpdateDirection
+	}
+
+	_, err = updateEdgePolicy(tx, &chanEdge)
+	return err
+}
+
+// putShellSourceNode inserts a shell node, i.e. one without a node
+// announcement, for the passed public key into the graph and marks it as the
+// source node.
+func putShellSourceNode(tx *bbolt.Tx, nodes *bbolt.Bucket,
+	selfPub *btcec.PublicKey) (*LightningNode, error) {
+
+	selfNode := &LightningNode{
+		HaveNodeAnnouncement: false,
+	}
+	copy(selfNode.PubKeyBytes[:], selfPub.SerializeCompressed())
+
+	if err := addLightningNode(tx, selfNode); err != nil {
+		return nil, err
+	}
+	if err := nodes.Put(sourceKey, selfNode.PubKeyBytes[:]); err != nil {
+		return nil, err
+	}
+
+	return selfNode, nil
+}
+
+// AddrsForNode consults the graph and channel database for all addresses known
+// to the passed node public key.
+func (d *DB) AddrsForNode(nodePub *btcec.PublicKey) ([]net.Addr, error) {
+	var addrs []net.Addr
+	err := d.View(func(tx *bbolt.Tx) error {
 		var err error
-		if err = writeOutpoint(&b, chanID); err != nil {
+		addrs, err = AddrsForNodeTx(tx, nodePub)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return addrs, nil
+}
+
+// AddrsForNodeTx is identical to AddrsForNode, but uses the passed
+// transaction rather than opening a new one.
+func AddrsForNodeTx(tx *bbolt.Tx, nodePub *btcec.PublicKey) ([]net.Addr,
+	error) {
+
+	linkNode, err := fetchLinkNode(tx, nodePub)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll also query the graph for this peer to see if they have any
+	// addresses that we don't currently have stored within the link node
+	// database.
+	nodes := tx.Bucket(nodeBucket)
+	if nodes == nil {
+		return nil, ErrGraphNotFound
+	}
+	compressedPubKey := nodePub.SerializeCompressed()
+	graphNode, err := fetchLightningNode(nodes, compressedPubKey)
+	if err != nil && err != ErrGraphNodeNotFound {
+		// If the node isn't found, then that's OK, as we still have
+		// the link node data.
+		return nil, err
+	}
+
+	// Now that we have both sources of addrs for this node, we'll use a
+	// map to de-duplicate any addresses between the two sources, and
+	// produce a final list of the combined addrs.
+	addrs := make(map[string]net.Addr)
+	for _, addr := range linkNode.Addresses {
+		addrs[addr.String()] = addr
+	}
+	for _, addr := range graphNode.Addresses {
+		addrs[addr.String()] = addr
+	}
+	dedupedAddrs := make([]net.Addr, 0, len(addrs))
+	for _, addr := range addrs {
+		dedupedAddrs = append(dedupedAddrs, addr)
+	}
+
+	return dedupedAddrs, nil
+}
+
+// CheckAbandon returns ErrAbandonNonDefaultChannel if the channel is in a state
+// other than ChanStatusDefault, in which case it may be waiting for a close to
+// confirm, and abandoning it would destroy any information required to resolve
+// it. As channels are marked borked before being abandoned, such that they
+// aren't loaded back in while being removed, ChanStatusBorked is disregarded.
+func (c *OpenChannel) CheckAbandon() error {
+	status := c.ChanStatus()
+	if status&^ChanStatusBorked != ChanStatusDefault {
+		return ErrAbandonNonDefaultChannel{
+			ChanPoint: c.FundingOutpoint,
+			Status:    status,
+		}
+	}
+
+	return nil
+}
+
+// AbandonChannel attempts to remove the target channel from the open channel
+// database. If the channel was already removed (has a closed channel entry),
+// then we'll return a nil error. Otherwise, we'll insert a new close summary
+// into the database. If the channel can't be abandoned as reported by
+// CheckAbandon, then ErrAbandonNonDefaultChannel is returned unless force is
+// set, as abandoning it may destroy the state needed to recover from a force
+// close.
+func (d *DB) AbandonChannel(chanPoint *wire.OutPoint, bestHeight uint32,
+	force bool) error {
+
+	// With the chanPoint constructed, we'll first check whether the
+	// target channel exists in the database, without decoding it.
+	exists, err := d.ChannelExists(*chanPoint)
+	if err != nil {
+		return err
+	}
+
+	// If the channel wasn't found, then it's possible that it was already
+	// abandoned from the database.
+	if !exists {
+		closed, err := d.IsChannelClosed(*chanPoint)
+		if err != nil {
+			return err
+		}
+		if !closed {
+			return ErrClosedChannelNotFound
+		}
+
+		// If the channel was already closed, then we don't return an
+		// error as we'd like fro this step to be repeatable.
+		return nil
+	}
+
+	// Now that we know the channel exists, we'll fetch it in full. If we
+	// can't find the channel, then we'll return the error back to the
+	// caller.
+	dbChan, err := d.FetchChannel(*chanPoint)
+	if err != nil {
+		return err
+	}
+
+	// Unless the caller has forced the operation, we'll refuse to abandon
+	// a channel that may be waiting for a close to confirm.
+	if !force {
+		if err := dbChan.CheckAbandon(); err != nil {
 			return err
 		}
+	}
+
+	// Now that we've found the channel, we'll populate a close summary for
+	// the channel, so we can store as much information for this abounded
+	// channel as possible. We also ensure that we set Pending to false, to
+	// indicate that this channel has been "fully" closed.
+	summary := &ChannelCloseSummary{
+		CloseType:               Abandoned,
+		ChanPoint:               *chanPoint,
+		ChainHash:               dbChan.ChainHash,
+		CloseHeight:             bestHeight,
+		RemotePub:               dbChan.IdentityPub,
+		Capacity:                dbChan.Capacity,
+		SettledBalance:          dbChan.LocalCommitment.LocalBalance.ToSatoshis(),
+		ShortChanID:             dbChan.ShortChanID(),
+		RemoteCurrentRevocation: dbChan.RemoteCurrentRevocation,
+		RemoteNextRevocation:    dbChan.RemoteNextRevocation,
+		LocalChanConfig:         dbChan.LocalChanCfg,
+	}
+
+	// Finally, we'll close the channel in the DB, and return back to the
+	// caller.
+	dbChan.Lock()
+	defer dbChan.Unlock()
+
+	err = d.retryUpdate(func(tx *bbolt.Tx) error {
+		return dbChan.closeChannel(tx, summary)
+	})
+	if err != nil {
+		return err
+	}
+
+	d.notifyChannelClosed(summary)
+
+	return nil
+}
+
+// ChannelClose pairs an open channel with the summary it should be closed
+// with, as passed to CloseChannelBatch.
+type ChannelClose struct {
+	// Channel is the open channel to be closed.
+	Channel *OpenChannel
+
+	// Summary is the summary of the channel at the time of its closing,
+	// see OpenChannel.CloseChannel.
+	Summary *ChannelCloseSummary
+}
+
+// CloseChannelBatch closes each of the passed channels with its summary, just
+// as OpenChannel.CloseChannel does, but within a single transaction, so either
+// all of the channels are closed or none of them are. Once the channels are
+// closed, the graph caches are invalidated for all of them in a single pass.
+// This is far cheaper than closing a large number of channels one by one,
+// e.g. upon reconnecting to a peer after a prolonged period offline. All of
+// the channels must have been read from this database.
+func (d *DB) CloseChannelBatch(closes ...ChannelClose) error {
+	if len(closes) == 0 {
+		return nil
+	}
+
+	// The channels are closed within our transaction, though closing a
+	// channel is governed by the options of the database it was read
+	// from, so we'll only accept channels read from this one.
+	for _, chanClose := range closes {
+		if chanClose.Channel.Db != d {
+			return fmt.Errorf("channel %v doesn't belong to the "+
+				"database", chanClose.Channel.FundingOutpoint)
+		}
+	}
+
+	// We'll acquire the mutex of each channel in order of their funding
+	// outpoints, such that concurrent batches can't deadlock.
+	sorted := make([]ChannelClose, len(closes))
+	copy(sorted, closes)
+	sort.Slice(sorted, func(i, j int) bool {
+		return outPointLess(
+			&sorted[i].Channel.FundingOutpoint,
+			&sorted[j].Channel.FundingOutpoint,
+		)
+	})
+	for i := 1; i < len(sorted); i++ {
+		chanPoint := sorted[i].Channel.FundingOutpoint
+		if chanPoint == sorted[i-1].Channel.FundingOutpoint {
+			return fmt.Errorf("channel %v closed more than once "+
+				"within batch", chanPoint)
+		}
+	}
+	for _, chanClose := range sorted {
+		chanClose.Channel.Lock()
+		defer chanClose.Channel.Unlock()
+	}
+
+	chanGraph := d.ChannelGraph()
+	chanGraph.cacheMu.Lock()
+	defer chanGraph.cacheMu.Unlock()
+
+	err := d.retryUpdate(func(tx *bbolt.Tx) error {
+		for _, chanClose := range sorted {
+			channel := chanClose.Channel
+			err := channel.closeChannel(tx, chanClose.Summary)
+			if err != nil {
+				return fmt.Errorf("unable to close channel "+
+					"%v: %v", channel.FundingOutpoint, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, chanClose := range sorted {
+		chanID := chanClose.Channel.ShortChannelID.ToUint64()
+		chanGraph.rejectCache.remove(chanID)
+		chanGraph.chanCache.remove(chanID)
+	}
+
+	for _, chanClose := range closes {
+		d.notifyChannelClosed(chanClose.Summary)
+	}
+
+	return nil
+}
+
+// MigrationResult details the application of a single migration while
+// opening the database.
+type MigrationResult struct {
+	// Version is the database version the migration brings the database
+	// to.
+	Version uint32
+
+	// Duration is the amount of time the migration took, including its
+	// post check, if any.
+	Duration time.Duration
+
+	// NodesAllocated is the number of B+tree nodes bolt allocated within
+	// the migration transaction while applying the migration, as reported
+	// by its transaction stats. It's an indication of how many pages the
+	// migration rewrote, not a count of the keys it modified.
+	NodesAllocated uint64
+}
+
+// MigrationReport returns the results of the migrations that were applied
+// when the database was opened, in the order they were applied. If the
+// database was already at the latest version, then no results are returned.
+func (d *DB) MigrationReport() []MigrationResult {
+	return d.migrationResults
+}
+
+// syncVersions function is used for safe db version synchronization. It
+// applies migration functions to the current database and recovers the
+// previous state of db if at least one error/panic appeared during migration.
+func (d *DB) syncVersions(versions []version) error {
+	return d.syncVersionsContext(context.Background(), versions)
+}
+
+// syncVersionsContext is identical to syncVersions, but aborts the migrations
+// once the passed context is done. In that case, the migration transaction is
+// rolled back, leaving the database at its prior version, and
+// ErrMigrationTimeout is returned.
+func (d *DB) syncVersionsContext(ctx context.Context,
+	versions []version) error {
+
+	_, err := d.syncVersionsWithReport(ctx, versions)
+	return err
+}
+
+// syncVersionsWithReport is identical to syncVersionsContext, but also returns
+// the results of each migration that was applied. If a migration fails, then
+// the results of all migrations attempted up to and including the failed one
+// are still returned, even though they've been rolled back.
+func (d *DB) syncVersionsWithReport(ctx context.Context,
+	versions []version) ([]MigrationResult, error) {
+
+	meta, err := d.FetchMeta(nil)
+	if err != nil {
+		if err == ErrMetaNotFound {
+			meta = &Meta{}
+		} else {
+			return nil, err
+		}
+	}
 
-		summaryBytes := closeBucket.Get(b.Bytes())
-		if summaryBytes == nil {
-			return ErrClosedChannelNotFound
+	latestVersion := getLatestDBVersion(versions)
+	log.Infof("Checking for schema update: latest_version=%v, "+
+		"db_version=%v", latestVersion, meta.DbVersionNumber)
+
+	switch {
+
+	// If the database reports a higher version that we are aware of, the
+	// user is probably trying to revert to a prior version of lnd. We fail
+	// here to prevent reversions and unintended corruption.
+	case meta.DbVersionNumber > latestVersion:
+		log.Errorf("Refusing to revert from db_version=%d to "+
+			"lower version=%d", meta.DbVersionNumber,
+			latestVersion)
+		return nil, ErrDBReversion
+
+	// If the current database version matches the latest version number,
+	// then we don't need to perform any migrations.
+	case meta.DbVersionNumber == latestVersion:
+		return nil, nil
+	}
+
+	// Before touching the database, we'll make sure there's enough space
+	// left on disk for the migrations to complete if requested.
+	if d.migrationMinFreeBytes != 0 {
+		if err := d.checkDiskSpace(); err != nil {
+			return nil, err
+		}
+	}
+
+	// We'll also take a copy of the database if requested, such that the
+	// operator can restore it should the migrations fail.
+	if d.preMigrationBackupDir != "" {
+		err := d.backupBeforeMigration(meta.DbVersionNumber)
+		if err != nil {
+			return nil, fmt.Errorf("unable to back up database "+
+				"before migration: %v", err)
+		}
+	}
+
+	log.Infof("Performing database schema migration")
+
+	// Otherwise, we fetch the migrations which need to applied, and
+	// execute them serially within a single database transaction to ensure
+	// the migration is atomic.
+	migrations, migrationVersions := getMigrationsToApply(
+		versions, meta.DbVersionNumber,
+	)
+	ctxMigrations := getContextMigrationsToApply(
+		versions, meta.DbVersionNumber,
+	)
+	postChecks := getPostChecksToApply(versions, meta.DbVersionNumber)
+
+	var results []MigrationResult
+	err = d.Update(func(tx *bbolt.Tx) error {
+		results = nil
+
+		for i, migration := range migrations {
+			if migration == nil && ctxMigrations[i] == nil {
+				continue
+			}
+
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			log.Infof("Applying migration #%v", migrationVersions[i])
+
+			// We'll time the migration, and record its result
+			// regardless of whether it succeeds.
+			start := time.Now()
+			nodesBefore := tx.Stats().NodeCount
+			err := runMigration(
+				ctx, tx, migration, ctxMigrations[i],
+				postChecks[i], migrationVersions[i],
+			)
+			result := MigrationResult{
+				Version:  migrationVersions[i],
+				Duration: time.Since(start),
+				NodesAllocated: uint64(
+					tx.Stats().NodeCount - nodesBefore,
+				),
+			}
+			results = append(results, result)
+
+			if err != nil {
+				log.Errorf("Unable to apply migration #%v "+
+					"after %v: %v", result.Version,
+					result.Duration, err)
+				return err
+			}
+
+			log.Infof("Applied migration #%v in %v",
+				result.Version, result.Duration)
 		}
 
-		summaryReader := bytes.NewReader(summaryBytes)
-		chanSummary, err = deserializeCloseChannelSummary(summaryReader)
+		meta.DbVersionNumber = latestVersion
+		return putMeta(meta, tx)
+	})
+
+	// If the migrations were aborted due to the context being done, then
+	// the transaction has been rolled back, which we'll report as a
+	// timeout. Any other failure is returned as is, even if the context
+	// happened to expire at the same time.
+	if err != nil && err == ctx.Err() {
+		log.Errorf("Migration aborted, rolled back to db_version=%d: "+
+			"%v", meta.DbVersionNumber, err)
+		return results, ErrMigrationTimeout
+	}
+
+	return results, err
+}
+
+// checkDiskSpace ensures that at least the configured minimum number of bytes
+// is free on the filesystem of the database, returning ErrInsufficientDiskSpace
+// otherwise. If the free space can't be determined on the current platform,
+// then the check is skipped.
+func (d *DB) checkDiskSpace() error {
+	freeBytes, err := freeDiskSpace(d.dbPath)
+	switch {
+	case err == errDiskSpaceUnsupported:
+		log.Warnf("Skipping pre-migration disk space check: %v", err)
+		return nil
+
+	case err != nil:
+		return fmt.Errorf("unable to determine free disk space: %v",
+			err)
+	}
+
+	if freeBytes < d.migrationMinFreeBytes {
+		log.Errorf("Refusing to migrate database with %v bytes free on "+
+			"disk, at least %v bytes are required", freeBytes,
+			d.migrationMinFreeBytes)
+		return ErrInsufficientDiskSpace
+	}
+
+	return nil
+}
 
+// backupBeforeMigration writes a consistent copy of the database, which is at
+// the passed version, to the configured pre-migration backup directory. The
+// copy is named after the version and the current time, such that the copies
+// taken before subsequent migrations are retained alongside it.
+func (d *DB) backupBeforeMigration(dbVersion uint32) error {
+	if err := os.MkdirAll(d.preMigrationBackupDir, 0700); err != nil {
 		return err
-	}); err != nil {
-		return nil, err
 	}
 
-	return chanSummary, nil
+	backupName := fmt.Sprintf("%s.v%d.%s.backup", dbName, dbVersion,
+		d.now().UTC().Format("20060102T150405Z"))
+	backupPath := filepath.Join(d.preMigrationBackupDir, backupName)
+
+	log.Infof("Backing up database at db_version=%v to %v", dbVersion,
+		backupPath)
+
+	err := d.View(func(tx *bbolt.Tx) error {
+		return tx.CopyFile(backupPath, dbFilePermission)
+	})
+	if err != nil {
+		os.Remove(backupPath)
+		return err
+	}
+
+	return nil
 }
 
-// FetchClosedChannelForID queries for a channel close summary using the
-// channel ID of the channel in question.
-func (d *DB) FetchClosedChannelForID(cid lnwire.ChannelID) (
-	*ChannelCloseSummary, error) {
+// withMigrationOverrides returns a copy of the passed versions, in which the
+// migration of each version with an override is replaced by the override. The
+// override records itself as applied within the migration transaction, such
+// that it isn't applied again as a fix by applyMigrationFixes.
+func withMigrationOverrides(versions []version,
+	overrides map[uint32]func(*bbolt.Tx) error) ([]version, error) {
 
-	var chanSummary *ChannelCloseSummary
-	if err := d.View(func(tx *bbolt.Tx) error {
-		closeBucket := tx.Bucket(closedChannelBucket)
-		if closeBucket == nil {
-			return ErrClosedChannelNotFound
+	latestVersion := getLatestDBVersion(versions)
+	for number := range overrides {
+		if number == 0 || number > latestVersion {
+			return nil, fmt.Errorf("unable to override unknown "+
+				"migration #%v", number)
 		}
+	}
 
-		// The first 30 bytes of the channel ID and outpoint will be
-		// equal.
-		cursor := closeBucket.Cursor()
-		op, c := cursor.Seek(cid[:30])
+	overridden := make([]version, len(versions))
+	copy(overridden, versions)
+	for i := range overridden {
+		fix, ok := overrides[overridden[i].number]
+		if !ok {
+			continue
+		}
 
-		// We scan over all possible candidates for this channel ID.
-		for ; op != nil && bytes.Compare(cid[:30], op[:30]) <= 0; op, c = cursor.Next() {
-			var outPoint wire.OutPoint
-			err := readOutpoint(bytes.NewReader(op), &outPoint)
-			if err != nil {
+		number := overridden[i].number
+		overridden[i].migration = func(tx *bbolt.Tx) error {
+			if err := fix(tx); err != nil {
 				return err
 			}
+			return putAppliedMigrationFix(tx, number)
+		}
+		overridden[i].ctxMigration = nil
+	}
 
-			// If the found outpoint does not correspond to this
-			// channel ID, we continue.
-			if !cid.IsChanPoint(&outPoint) {
+	return overridden, nil
+}
+
+// applyMigrationFixes applies each of the passed migration overrides that
+// hasn't been applied yet, in order of their version, within a single
+// transaction. This is expected to be called once the database has been
+// migrated to the latest version.
+func (d *DB) applyMigrationFixes(
+	overrides map[uint32]func(*bbolt.Tx) error) error {
+
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	numbers := make([]uint32, 0, len(overrides))
+	for number := range overrides {
+		numbers = append(numbers, number)
+	}
+	sort.Slice(numbers, func(i, j int) bool {
+		return numbers[i] < numbers[j]
+	})
+
+	return d.Update(func(tx *bbolt.Tx) error {
+		for _, number := range numbers {
+			applied, err := isMigrationFixApplied(tx, number)
+			if err != nil {
+				return err
+			}
+			if applied {
 				continue
 			}
 
-			// Deserialize the close summary and return.
-			r := bytes.NewReader(c)
-			chanSummary, err = deserializeCloseChannelSummary(r)
+			log.Infof("Applying fix for migration #%v", number)
+
+			if err := overrides[number](tx); err != nil {
+				return fmt.Errorf("unable to apply fix for "+
+					"migration #%v: %v", number, err)
+			}
+			err = putAppliedMigrationFix(tx, number)
 			if err != nil {
 				return err
 			}
-
-			return nil
 		}
-		return ErrClosedChannelNotFound
-	}); err != nil {
-		return nil, err
+
+		return nil
+	})
+}
+
+// putAppliedMigrationFix records the override of the migration to the passed
+// version as applied.
+func putAppliedMigrationFix(tx *bbolt.Tx, number uint32) error {
+	meta, err := tx.CreateBucketIfNotExists(metaBucket)
+	if err != nil {
+		return err
+	}
+	fixes, err := meta.CreateBucketIfNotExists(appliedMigrationFixesBucket)
+	if err != nil {
+		return err
 	}
 
-	return chanSummary, nil
+	var k [4]byte
+	byteOrder.PutUint32(k[:], number)
+	return fixes.Put(k[:], []byte{})
 }
 
-// MarkChanFullyClosed marks a channel as fully closed within the database. A
-// channel should be marked as fully closed if the channel was initially
-// cooperatively closed and it's reached a single confirmation, or after all
-// the pending funds in a channel that has been forcibly closed have been
-// swept.
-func (d *DB) MarkChanFullyClosed(chanPoint *wire.OutPoint) error {
-	return d.Update(func(tx *bbolt.Tx) error {
-		var b bytes.Buffer
-		if err := writeOutpoint(&b, chanPoint); err != nil {
-			return err
-		}
+// isMigrationFixApplied returns true if the override of the migration to the
+// passed version has already been applied.
+func isMigrationFixApplied(tx *bbolt.Tx, number uint32) (bool, error) {
+	meta := tx.Bucket(metaBucket)
+	if meta == nil {
+		return false, ErrMetaNotFound
+	}
+	fixes := meta.Bucket(appliedMigrationFixesBucket)
+	if fixes == nil {
+		return false, nil
+	}
 
-		chanID := b.Bytes()
+	var k [4]byte
+	byteOrder.PutUint32(k[:], number)
+	return fixes.Get(k[:]) != nil, nil
+}
 
-		closedChanBucket, err := tx.CreateBucketIfNotExists(
-			closedChannelBucket,
-		)
-		if err != nil {
-			return err
-		}
+// runMigration applies a single migration within the passed transaction,
+// followed by its post check, if any. Only one of migration and ctxMigration
+// should be set.
+func runMigration(ctx context.Context, tx *bbolt.Tx, migration migration,
+	ctxMigration contextMigration, postCheck postMigrationCheck,
+	version uint32) error {
+
+	var err error
+	if ctxMigration != nil {
+		err = ctxMigration(ctx, tx)
+	} else {
+		err = migration(tx)
+	}
+	if err != nil {
+		return err
+	}
 
-		chanSummaryBytes := closedChanBucket.Get(chanID)
-		if chanSummaryBytes == nil {
-			return fmt.Errorf("no closed channel for "+
-				"chan_point=%v found", chanPoint)
+	// If the migration carries a post check, we'll run it now so a
+	// migration that left the database in an inconsistent state is never
+	// committed.
+	if postCheck == nil {
+		return nil
+	}
+
+	if err := postCheck(tx); err != nil {
+		log.Errorf("Post check for migration #%v failed: %v", version,
+			err)
+		return fmt.Errorf("post check for migration #%v failed: %v",
+			version, err)
+	}
+
+	return nil
+}
+
+// Downgrade reverts the database from its current version to the target
+// version by applying the reverse migrations of all versions in between. This
+// is intended purely for development purposes, and is never run
+// automatically: the database must have been opened with
+// OptionSetAllowDowngrade, otherwise ErrDowngradeNotAllowed is returned. If
+// any of the versions in between lacks a reverse migration, then no changes
+// are made to the database.
+func (d *DB) Downgrade(target uint32) error {
+	if !d.allowDowngrade {
+		return ErrDowngradeNotAllowed
+	}
+
+	return d.downgrade(dbVersions, target)
+}
+
+// downgrade applies the reverse migrations found within the passed versions to
+// bring the database from its current version down to the target version. All
+// reverse migrations are executed within a single database transaction to
+// ensure the downgrade is atomic.
+func (d *DB) downgrade(versions []version, target uint32) error {
+	meta, err := d.FetchMeta(nil)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case target > meta.DbVersionNumber:
+		return fmt.Errorf("cannot downgrade from db_version=%v to "+
+			"higher version=%v", meta.DbVersionNumber, target)
+
+	case target == meta.DbVersionNumber:
+		return nil
+
+	case meta.DbVersionNumber > getLatestDBVersion(versions):
+		return ErrDBReversion
+	}
+
+	// Collect the reverse migrations from the current version down to the
+	// target, ensuring every one of them is present before we touch the
+	// database.
+	var (
+		reverseMigrations []migration
+		reverseVersions   []uint32
+	)
+	for i := len(versions) - 1; i >= 0; i-- {
+		v := versions[i]
+		if v.number <= target || v.number > meta.DbVersionNumber {
+			continue
 		}
 
-		chanSummaryReader := bytes.NewReader(chanSummaryBytes)
-		chanSummary, err := deserializeCloseChannelSummary(
-			chanSummaryReader,
-		)
-		if err != nil {
-			return err
+		if v.reverse == nil {
+			return fmt.Errorf("no reverse migration for "+
+				"db_version=%v, refusing to downgrade", v.number)
 		}
 
-		chanSummary.IsPending = false
+		reverseMigrations = append(reverseMigrations, v.reverse)
+		reverseVersions = append(reverseVersions, v.number)
+	}
 
-		var newSummary bytes.Buffer
-		err = serializeChannelCloseSummary(&newSummary, chanSummary)
-		if err != nil {
-			return err
-		}
+	log.Warnf("Downgrading database from db_version=%v to version=%v",
+		meta.DbVersionNumber, target)
 
-		err = closedChanBucket.Put(chanID, newSummary.Bytes())
-		if err != nil {
-			return err
+	return d.Update(func(tx *bbolt.Tx) error {
+		for i, reverse := range reverseMigrations {
+			log.Infof("Reverting migration #%v", reverseVersions[i])
+
+			if err := reverse(tx); err != nil {
+				log.Infof("Unable to revert migration #%v",
+					reverseVersions[i])
+				return err
+			}
 		}
 
-		// Now that the channel is closed, we'll check if we have any
-		// other open channels with this peer. If we don't we'll
-		// garbage collect it to ensure we don't establish persistent
-		// connections to peers without open channels.
-		return d.pruneLinkNode(tx, chanSummary.RemotePub)
+		meta.DbVersionNumber = target
+		return putMeta(meta, tx)
 	})
 }
 
-// pruneLinkNode determines whether we should garbage collect a link node from
-// the database due to no longer having any open channels with it. If there are
-// any left, then this acts as a no-op.
-func (d *DB) pruneLinkNode(tx *bbolt.Tx, remotePub *btcec.PublicKey) error {
-	openChannels, err := d.fetchOpenChannels(tx, remotePub)
+// ChannelGraph returns a new instance of the directed channel graph.
+func (d *DB) ChannelGraph() *ChannelGraph {
+	return d.graph
+}
+
+// ForEachGraphEdge iterates through all the channel edges within the channel
+// graph, invoking the passed callback with each edge along with both of its
+// directional policies. If the callback returns an error, then the iteration
+// stops early and the error is returned.
+//
+// NOTE: If a policy can't be found, or wasn't advertised, then a nil pointer
+// for that policy will be passed into the callback.
+func (d *DB) ForEachGraphEdge(cb func(*ChannelEdgeInfo, *ChannelEdgePolicy,
+	*ChannelEdgePolicy) error) error {
+
+	return d.graph.ForEachChannel(cb)
+}
+
+// TrimGraphToChannels removes all edges from the channel graph that aren't
+// within the given number of hops of our node, along with any nodes that are
+// left unconnected as a result. The peers of all of our open channels are
+// considered to be a single hop away, even if the channels with them haven't
+// been announced. A value of one only retains the edges of our own channels.
+// This is intended for nodes that operate in constrained environments, and
+// can be called repeatedly as new channels are opened.
+func (d *DB) TrimGraphToChannels(hops int) error {
+	if hops < 1 {
+		return fmt.Errorf("unable to trim graph to %v hops, at least "+
+			"one hop is required", hops)
+	}
+
+	channels, err := d.FetchAllOpenChannels()
 	if err != nil {
-		return fmt.Errorf("unable to fetch open channels for peer %x: "+
-			"%v", remotePub.SerializeCompressed(), err)
+		return err
 	}
 
-	if len(openChannels) > 0 {
-		return nil
+	peers := make([][33]byte, 0, len(channels))
+	for _, channel := range channels {
+		var peer [33]byte
+		copy(peer[:], channel.IdentityPub.SerializeCompressed())
+		peers = append(peers, peer)
+	}
+
+	return d.graph.trimGraph(peers, hops)
+}
+
+// ResizeGraphCaches changes the capacity of the reject and channel caches of
+// the channel graph at runtime, evicting entries as needed. Both sizes must be
+// positive.
+func (d *DB) ResizeGraphCaches(rejectCacheSize, chanCacheSize int) error {
+	if rejectCacheSize <= 0 || chanCacheSize <= 0 {
+		return fmt.Errorf("invalid graph cache sizes: reject=%v, "+
+			"channel=%v", rejectCacheSize, chanCacheSize)
 	}
 
-	log.Infof("Pruning link node %x with zero open channels from database",
-		remotePub.SerializeCompressed())
+	d.graph.resizeCaches(rejectCacheSize, chanCacheSize)
+
+	log.Infof("Resized graph caches: reject=%v, channel=%v",
+		rejectCacheSize, chanCacheSize)
+
+	return nil
+}
+
+// GraphCacheStats returns the current capacity and utilization of the reject
+// and channel caches of the channel graph.
+func (d *DB) GraphCacheStats() *GraphCacheStats {
+	return d.graph.cacheStats()
+}
+
+// GraphPruneTip returns the height and hash of the block the channel graph was
+// last pruned at, as recorded by the latest entry of the prune log. If the
+// graph has never been pruned, then ErrGraphNeverPruned is returned.
+func (d *DB) GraphPruneTip() (uint32, chainhash.Hash, error) {
+	tipHash, tipHeight, err := d.graph.PruneTip()
+	if err != nil {
+		return 0, chainhash.Hash{}, err
+	}
 
-	return d.deleteLinkNode(tx, remotePub)
+	return tipHeight, *tipHash, nil
 }
 
-// PruneLinkNodes attempts to prune all link nodes found within the databse with
-// whom we no longer have any open channels with.
-func (d *DB) PruneLinkNodes() error {
+// SetGraphPruneTip records the passed block as the prune tip of the channel
+// graph. Any entries of the prune log above the passed height are removed,
+// such that the passed block becomes the latest entry. The graph itself isn't
+// modified.
+func (d *DB) SetGraphPruneTip(height uint32, hash chainhash.Hash) error {
 	return d.Update(func(tx *bbolt.Tx) error {
-		linkNodes, err := d.fetchAllLinkNodes(tx)
+		graphMeta, err := tx.CreateBucketIfNotExists(graphMetaBucket)
+		if err != nil {
+			return err
+		}
+		pruneBucket, err := graphMeta.CreateBucketIfNotExists(
+			pruneLogBucket,
+		)
 		if err != nil {
 			return err
 		}
 
-		for _, linkNode := range linkNodes {
-			err := d.pruneLinkNode(tx, linkNode.IdentityPub)
-			if err != nil {
+		var heightKey [4]byte
+		byteOrder.PutUint32(heightKey[:], height)
+
+		// We'll gather the entries above the new tip first, as we
+		// can't delete them while iterating.
+		var staleKeys [][]byte
+		pruneCursor := pruneBucket.Cursor()
+		k, _ := pruneCursor.Seek(heightKey[:])
+		for ; k != nil; k, _ = pruneCursor.Next() {
+			if bytes.Equal(k, heightKey[:]) {
+				continue
+			}
+			staleKeys = append(staleKeys, copySlice(k))
+		}
+		for _, k := range staleKeys {
+			if err := pruneBucket.Delete(k); err != nil {
 				return err
 			}
 		}
 
-		return nil
-	})
-}
-
-// ChannelShell is a shell of a channel that is meant to be used for channel
-// recovery purposes. It contains a minimal OpenChannel instance along with
-// addresses for that target node.
-type ChannelShell struct {
-	// NodeAddrs the set of addresses that this node has known to be
-	// reachable at in the past.
-	NodeAddrs []net.Addr
+		var newTip [pruneTipBytes]byte
+		copy(newTip[:], hash[:])
 
-	// Chan is a shell of an OpenChannel, it contains only the items
-	// required to restore the channel on disk.
-	Chan *OpenChannel
+		return pruneBucket.Put(heightKey[:], newTip[:])
+	})
 }
 
-// RestoreChannelShells is a method that allows the caller to reconstruct the
-// state of an OpenChannel from the ChannelShell. We'll attempt to write the
-// new channel to disk, create a LinkNode instance with the passed node
-// addresses, and finally create an edge within the graph for the channel as
-// well. This method is idempotent, so repeated calls with the same set of
-// channel shells won't modify the database after the initial call.
-func (d *DB) RestoreChannelShells(channelShells ...*ChannelShell) error {
-	chanGraph := d.ChannelGraph()
-
-	// TODO(conner): find way to do this w/o accessing internal members?
-	chanGraph.cacheMu.Lock()
-	defer chanGraph.cacheMu.Unlock()
-
-	var chansRestored []uint64
-	err := d.Update(func(tx *bbolt.Tx) error {
-		for _, channelShell := range channelShells {
-			channel := channelShell.Chan
-
-			// When we make a channel, we mark that the channel has
-			// been restored, this will signal to other sub-systems
-			// to not attempt to use the channel as if it was a
-			// regular one.
-			channel.chanStatus |= ChanStatusRestored
-
-			// First, we'll attempt to create a new open channel
-			// and link node for this channel. If the channel
-			// already exists, then in order to ensure this method
-			// is idempotent, we'll continue to the next step.
-			channel.Db = d
-			err := syncNewChannel(
-				tx, channel, channelShell.NodeAddrs,
-			)
-			if err != nil {
-				return err
-			}
+// FetchRecentlyUpdatedChannels returns the short channel IDs of all edges
+// within the channel graph with a policy that was last updated after the
+// passed time. Rather than scanning every edge, the edge update index is
+// seeked into, so only the updates after the passed time are visited. As
+// update times are stored with second precision, any fraction of a second of
+// the passed time is disregarded. The channel IDs are ordered by the earliest
+// of their updates after the passed time, and each is returned only once.
+func (d *DB) FetchRecentlyUpdatedChannels(since time.Time) ([]uint64, error) {
+	var chanIDs []uint64
+	err := d.View(func(tx *bbolt.Tx) error {
+		edges := tx.Bucket(edgeBucket)
+		if edges == nil {
+			return ErrGraphNoEdgesFound
+		}
+		edgeUpdateIndex := edges.Bucket(edgeUpdateIndexBucket)
+		if edgeUpdateIndex == nil {
+			return ErrGraphNoEdgesFound
+		}
 
-			// Next, we'll create an active edge in the graph
-			// database for this channel in order to restore our
-			// partial view of the network.
-			//
-			// TODO(roasbeef): if we restore *after* the channel
-			// has been closed on chain, then need to inform the
-			// router that it should try and prune these values as
-			// we can detect them
-			edgeInfo := ChannelEdgeInfo{
-				ChannelID:    channel.ShortChannelID.ToUint64(),
-				ChainHash:    channel.ChainHash,
-				ChannelPoint: channel.FundingOutpoint,
-				Capacity:     channel.Capacity,
+		// The index is keyed by the update time followed by the
+		// channel ID, so we'll seek to the first update within the
+		// second after the passed time.
+		var startKey [8]byte
+		byteOrder.PutUint64(startKey[:], uint64(since.Unix()+1))
+
+		// Each direction of an edge has its own entry within the
+		// index, so we'll skip any channel that we've already seen.
+		seen := make(map[uint64]struct{})
+		updateCursor := edgeUpdateIndex.Cursor()
+		k, _ := updateCursor.Seek(startKey[:])
+		for ; k != nil; k, _ = updateCursor.Next() {
+			if len(k) != 8+8 {
+				continue
 			}
 
-			nodes := tx.Bucket(nodeBucket)
-			if nodes == nil {
-				return ErrGraphNotFound
-			}
-			selfNode, err := chanGraph.sourceNode(nodes)
-			if err != nil {
-				return err
+			chanID := byteOrder.Uint64(k[8:])
+			if _, ok := seen[chanID]; ok {
+				continue
 			}
+			seen[chanID] = struct{}{}
 
-			// Depending on which pub key is smaller, we'll assign
-			// our roles as "node1" and "node2".
-			chanPeer := channel.IdentityPub.SerializeCompressed()
-			selfIsSmaller := bytes.Compare(
-				selfNode.PubKeyBytes[:], chanPeer,
-			) == -1
-			if selfIsSmaller {
-				copy(edgeInfo.NodeKey1Bytes[:], selfNode.PubKeyBytes[:])
-				copy(edgeInfo.NodeKey2Bytes[:], chanPeer)
-			} else {
-				copy(edgeInfo.NodeKey1Bytes[:], chanPeer)
-				copy(edgeInfo.NodeKey2Bytes[:], selfNode.PubKeyBytes[:])
-			}
-
-			// With the edge info shell constructed, we'll now add
-			// it to the graph.
-			err = chanGraph.addChannelEdge(tx, &edgeInfo)
-			if err != nil && err != ErrEdgeAlreadyExist {
-				return err
-			}
+			chanIDs = append(chanIDs, chanID)
+		}
 
-			// Similarly, we'll construct a channel edge shell and
-			// add that itself to the graph.
-			chanEdge := ChannelEdgePolicy{
-				ChannelID:  edgeInfo.ChannelID,
-				LastUpdate: time.Now(),
-			}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-			// If their pubkey is larger, then we'll flip the
-			// direction bit to indicate that us, the "second" node
-			// is updating their policy.
-			if !selfIsSmaller {
-				chanEdge.ChannelFlags |= lnwire.ChanUpdateDirection
-			}
+	return chanIDs, nil
+}
 
-			_, err = updateEdgePolicy(tx, &chanEdge)
-			if err != nil {
-				return err
-			}
+// GraphNodeCount returns the number of nodes within the channel graph,
+// including the source node. The nodes are counted without being decoded,
+// skipping the source key and the index sub-buckets of the node bucket.
+func (d *DB) GraphNodeCount() (uint32, error) {
+	var numNodes uint32
+	err := d.View(func(tx *bbolt.Tx) error {
+		numNodes = 0
 
-			chansRestored = append(chansRestored, edgeInfo.ChannelID)
+		nodes := tx.Bucket(nodeBucket)
+		if nodes == nil {
+			return ErrGraphNotFound
 		}
 
-		return nil
+		return nodes.ForEach(func(pubKey, nodeBytes []byte) error {
+			// Nested buckets have a nil value, and the source key
+			// is too short to be a public key.
+			if nodeBytes == nil || len(pubKey) != 33 {
+				return nil
+			}
+			numNodes++
+
+			return nil
+		})
 	})
 	if err != nil {
-		return err
-	}
-
-	for _, chanid := range chansRestored {
-		chanGraph.rejectCache.remove(chanid)
-		chanGraph.chanCache.remove(chanid)
+		return 0, err
 	}
 
-	return nil
+	return numNodes, nil
 }
 
-// AddrsForNode consults the graph and channel database for all addresses known
-// to the passed node public key.
-func (d *DB) AddrsForNode(nodePub *btcec.PublicKey) ([]net.Addr, error) {
-	var (
-		linkNode  *LinkNode
-		graphNode LightningNode
-	)
-
-	dbErr := d.View(func(tx *bbolt.Tx) error {
-		var err error
-
-		linkNode, err = fetchLinkNode(tx, nodePub)
-		if err != nil {
-			return err
-		}
+// GraphEdgeCount returns the number of edges within the channel graph, as
+// counted by the entries of the edge index, without decoding them.
+func (d *DB) GraphEdgeCount() (uint32, error) {
+	var numEdges uint32
+	err := d.View(func(tx *bbolt.Tx) error {
+		numEdges = 0
 
-		// We'll also query the graph for this peer to see if they have
-		// any addresses that we don't currently have stored within the
-		// link node database.
-		nodes := tx.Bucket(nodeBucket)
-		if nodes == nil {
+		edges := tx.Bucket(edgeBucket)
+		if edges == nil {
 			return ErrGraphNotFound
 		}
-		compressedPubKey := nodePub.SerializeCompressed()
-		graphNode, err = fetchLightningNode(nodes, compressedPubKey)
-		if err != nil && err != ErrGraphNodeNotFound {
-			// If the node isn't found, then that's OK, as we still
-			// have the link node data.
-			return err
+		edgeIndex := edges.Bucket(edgeIndexBucket)
+		if edgeIndex == nil {
+			return ErrGraphNotFound
 		}
 
-		return nil
+		return edgeIndex.ForEach(func(_, _ []byte) error {
+			numEdges++
+			return nil
+		})
 	})
-	if dbErr != nil {
-		return nil, dbErr
+	if err != nil {
+		return 0, err
 	}
 
-	// Now that we have both sources of addrs for this node, we'll use a
-	// map to de-duplicate any addresses between the two sources, and
-	// produce a final list of the combined addrs.
-	addrs := make(map[string]net.Addr)
-	for _, addr := range linkNode.Addresses {
-		addrs[addr.String()] = addr
-	}
-	for _, addr := range graphNode.Addresses {
-		addrs[addr.String()] = addr
-	}
-	dedupedAddrs := make([]net.Addr, 0, len(addrs))
-	for _, addr := range addrs {
-		dedupedAddrs = append(dedupedAddrs, addr)
+	return numEdges, nil
+}
+
+// ZombieReport describes the inconsistencies found within the zombie index of
+// the channel graph by CheckZombieIndex and RepairZombieIndex.
+type ZombieReport struct {
+	// NumZombies is the number of entries within the zombie index,
+	// including any inconsistent ones.
+	NumZombies uint64
+
+	// LiveZombies are the channel IDs of the entries within the zombie
+	// index whose edge is present within the edge index, such as channels
+	// that were revived after being pruned. These channels are excluded
+	// from pathfinding despite being live.
+	LiveZombies []uint64
+
+	// MalformedZombies are the channel IDs of the entries within the
+	// zombie index that don't map to the public keys of both nodes of the
+	// channel.
+	MalformedZombies []uint64
+
+	// Repaired is true if the inconsistencies have been removed from the
+	// zombie index.
+	Repaired bool
+}
+
+// CheckZombieIndex cross-checks the zombie index of the channel graph against
+// the edge index, returning a report of the inconsistencies found without
+// modifying the database.
+func (d *DB) CheckZombieIndex() (*ZombieReport, error) {
+	var report *ZombieReport
+	err := d.View(func(tx *bbolt.Tx) error {
+		var err error
+		report, err = checkZombieIndex(tx, false)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return dedupedAddrs, nil
+	return report, nil
 }
 
-// AbandonChannel attempts to remove the target channel from the open channel
-// database. If the channel was already removed (has a closed channel entry),
-// then we'll return a nil error. Otherwise, we'll insert a new close summary
-// into the database.
-func (d *DB) AbandonChannel(chanPoint *wire.OutPoint, bestHeight uint32) error {
-	// With the chanPoint constructed, we'll attempt to find the target
-	// channel in the database. If we can't find the channel, then we'll
-	// return the error back to the caller.
-	dbChan, err := d.FetchChannel(*chanPoint)
-	switch {
-	// If the channel wasn't found, then it's possible that it was already
-	// abandoned from the database.
-	case err == ErrChannelNotFound:
-		_, closedErr := d.FetchClosedChannel(chanPoint)
-		if closedErr != nil {
-			return closedErr
-		}
+// RepairZombieIndex cross-checks the zombie index of the channel graph against
+// the edge index, and removes the inconsistent entries found, such that live
+// channels are no longer deemed zombies. A report of the removed entries is
+// returned.
+func (d *DB) RepairZombieIndex() (*ZombieReport, error) {
+	d.graph.cacheMu.Lock()
+	defer d.graph.cacheMu.Unlock()
 
-		// If the channel was already closed, then we don't return an
-		// error as we'd like fro this step to be repeatable.
-		return nil
-	case err != nil:
+	var report *ZombieReport
+	err := d.Update(func(tx *bbolt.Tx) error {
+		var err error
+		report, err = checkZombieIndex(tx, true)
 		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Now that we've found the channel, we'll populate a close summary for
-	// the channel, so we can store as much information for this abounded
-	// channel as possible. We also ensure that we set Pending to false, to
-	// indicate that this channel has been "fully" closed.
-	summary := &ChannelCloseSummary{
-		CloseType:               Abandoned,
-		ChanPoint:               *chanPoint,
-		ChainHash:               dbChan.ChainHash,
-		CloseHeight:             bestHeight,
-		RemotePub:               dbChan.IdentityPub,
-		Capacity:                dbChan.Capacity,
-		SettledBalance:          dbChan.LocalCommitment.LocalBalance.ToSatoshis(),
-		ShortChanID:             dbChan.ShortChanID(),
-		RemoteCurrentRevocation: dbChan.RemoteCurrentRevocation,
-		RemoteNextRevocation:    dbChan.RemoteNextRevocation,
-		LocalChanConfig:         dbChan.LocalChanCfg,
+	// The caches may hold the zombie status of the repaired channels, so
+	// we'll evict them.
+	for _, chanIDs := range [][]uint64{
+		report.LiveZombies, report.MalformedZombies,
+	} {
+		for _, chanID := range chanIDs {
+			d.graph.rejectCache.remove(chanID)
+			d.graph.chanCache.remove(chanID)
+		}
 	}
 
-	// Finally, we'll close the channel in the DB, and return back to the
-	// caller.
-	return dbChan.CloseChannel(summary)
+	return report, nil
 }
 
-// syncVersions function is used for safe db version synchronization. It
-// applies migration functions to the current database and recovers the
-// previous state of db if at least one error/panic appeared during migration.
-func (d *DB) syncVersions(versions []version) error {
-	meta, err := d.FetchMeta(nil)
-	if err != nil {
-		if err == ErrMetaNotFound {
-			meta = &Meta{}
-		} else {
-			return err
-		}
+// checkZombieIndex cross-checks the zombie index against the edge index within
+// the passed transaction. If fix is true, then the inconsistent entries are
+// removed from the zombie index.
+func checkZombieIndex(tx *bbolt.Tx, fix bool) (*ZombieReport, error) {
+	report := &ZombieReport{}
+
+	edges := tx.Bucket(edgeBucket)
+	if edges == nil {
+		return report, nil
+	}
+	zombieIndex := edges.Bucket(zombieBucket)
+	if zombieIndex == nil {
+		return report, nil
 	}
+	edgeIndex := edges.Bucket(edgeIndexBucket)
 
-	latestVersion := getLatestDBVersion(versions)
-	log.Infof("Checking for schema update: latest_version=%v, "+
-		"db_version=%v", latestVersion, meta.DbVersionNumber)
+	var staleKeys [][]byte
+	err := zombieIndex.ForEach(func(k, v []byte) error {
+		report.NumZombies++
 
-	switch {
+		// Keys that aren't channel IDs can't have been written by us,
+		// so we'll leave them be.
+		if len(k) != 8 {
+			return nil
+		}
+		chanID := byteOrder.Uint64(k)
 
-	// If the database reports a higher version that we are aware of, the
-	// user is probably trying to revert to a prior version of lnd. We fail
-	// here to prevent reversions and unintended corruption.
-	case meta.DbVersionNumber > latestVersion:
-		log.Errorf("Refusing to revert from db_version=%d to "+
-			"lower version=%d", meta.DbVersionNumber,
-			latestVersion)
-		return ErrDBReversion
+		switch {
+		case len(v) != 33+33:
+			report.MalformedZombies = append(
+				report.MalformedZombies, chanID,
+			)
 
-	// If the current database version matches the latest version number,
-	// then we don't need to perform any migrations.
-	case meta.DbVersionNumber == latestVersion:
-		return nil
-	}
+		case edgeIndex != nil && edgeIndex.Get(k) != nil:
+			report.LiveZombies = append(report.LiveZombies, chanID)
 
-	log.Infof("Performing database schema migration")
+		default:
+			return nil
+		}
 
-	// Otherwise, we fetch the migrations which need to applied, and
-	// execute them serially within a single database transaction to ensure
-	// the migration is atomic.
-	migrations, migrationVersions := getMigrationsToApply(
-		versions, meta.DbVersionNumber,
-	)
-	return d.Update(func(tx *bbolt.Tx) error {
-		for i, migration := range migrations {
-			if migration == nil {
-				continue
-			}
+		staleKeys = append(staleKeys, copySlice(k))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-			log.Infof("Applying migration #%v", migrationVersions[i])
+	if !fix {
+		return report, nil
+	}
 
-			if err := migration(tx); err != nil {
-				log.Infof("Unable to apply migration #%v",
-					migrationVersions[i])
-				return err
-			}
+	for _, k := range staleKeys {
+		if err := zombieIndex.Delete(k); err != nil {
+			return nil, err
 		}
+	}
+	report.Repaired = true
 
-		meta.DbVersionNumber = latestVersion
-		return putMeta(meta, tx)
-	})
-}
+	if len(staleKeys) > 0 {
+		log.Infof("Repaired zombie index: removed %v live and %v "+
+			"malformed zombie entries", len(report.LiveZombies),
+			len(report.MalformedZombies))
+	}
 
-// ChannelGraph returns a new instance of the directed channel graph.
-func (d *DB) ChannelGraph() *ChannelGraph {
-	return d.graph
+	return report, nil
 }
 
 func getLatestDBVersion(versions []version) uint32 {
@@ -1228,3 +4424,37 @@ func getMigrationsToApply(versions []version, version uint32) ([]migration, []ui
 
 	return migrations, migrationVersions
 }
+
+// getContextMigrationsToApply retrieves the context accepting migrations that
+// should be applied in place of those returned by getMigrationsToApply. The
+// returned slice is index aligned with the migrations, with a nil entry for
+// each version that doesn't carry a context accepting migration.
+func getContextMigrationsToApply(versions []version,
+	version uint32) []contextMigration {
+
+	ctxMigrations := make([]contextMigration, 0, len(versions))
+	for _, v := range versions {
+		if v.number > version {
+			ctxMigrations = append(ctxMigrations, v.ctxMigration)
+		}
+	}
+
+	return ctxMigrations
+}
+
+// getPostChecksToApply retrieves the post migration checks that should be run
+// after each of the migrations returned by getMigrationsToApply. The returned
+// slice is index aligned with the migrations, with a nil entry for each
+// migration that doesn't carry a check.
+func getPostChecksToApply(versions []version,
+	version uint32) []postMigrationCheck {
+
+	postChecks := make([]postMigrationCheck, 0, len(versions))
+	for _, v := range versions {
+		if v.number > version {
+			postChecks = append(postChecks, v.postCheck)
+		}
+	}
+
+	return postChecks
+}
@@ -1,6 +1,9 @@
 package channeldb
 
 import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
 	"io/ioutil"
 	"math"
 	"math/rand"
@@ -8,12 +11,17 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
+	"sync"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -85,6 +93,67 @@ func TestWipe(t *testing.T) {
 	}
 }
 
+// TestWipeWithReport asserts that WipeWithReport reports the buckets that were
+// present, and that wiping an already wiped database deletes nothing.
+func TestWipeWithReport(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// We'll remove one of the buckets beforehand to ensure that only the
+	// buckets present are reported.
+	err = cdb.Update(func(tx *bbolt.Tx) error {
+		return tx.DeleteBucket(invoiceBucket)
+	})
+	if err != nil {
+		t.Fatalf("unable to delete bucket: %v", err)
+	}
+
+	var expected []string
+	err = cdb.View(func(tx *bbolt.Tx) error {
+		for _, bucket := range wipeBuckets {
+			if tx.Bucket(bucket) != nil {
+				expected = append(expected, string(bucket))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read buckets: %v", err)
+	}
+	if len(expected) == 0 {
+		t.Fatalf("expected buckets to be present")
+	}
+
+	deleted, err := cdb.WipeWithReport()
+	if err != nil {
+		t.Fatalf("unable to wipe channeldb: %v", err)
+	}
+	if !reflect.DeepEqual(deleted, expected) {
+		t.Fatalf("expected deleted buckets %v, got %v", expected,
+			deleted)
+	}
+	for _, bucket := range deleted {
+		if bucket == string(invoiceBucket) {
+			t.Fatalf("absent bucket %v reported as deleted",
+				bucket)
+		}
+	}
+
+	// Wiping again should be a no-op.
+	deleted, err = cdb.WipeWithReport()
+	if err != nil {
+		t.Fatalf("unable to wipe channeldb: %v", err)
+	}
+	if len(deleted) != 0 {
+		t.Fatalf("expected no deleted buckets, got %v", deleted)
+	}
+}
+
 // TestFetchClosedChannelForID tests that we are able to properly retrieve a
 // ChannelCloseSummary from the DB given a ChannelID.
 func TestFetchClosedChannelForID(t *testing.T) {
@@ -486,7 +555,7 @@ func TestAbandonChannel(t *testing.T) {
 	// If we attempt to abandon the state of a channel that doesn't exist
 	// in the open or closed channel bucket, then we should receive an
 	// error.
-	err = cdb.AbandonChannel(&wire.OutPoint{}, 0)
+	err = cdb.AbandonChannel(&wire.OutPoint{}, 0, false)
 	if err == nil {
 		t.Fatalf("removing non-existent channel should have failed")
 	}
@@ -507,7 +576,9 @@ func TestAbandonChannel(t *testing.T) {
 
 	// We should now be able to abandon the channel without any errors.
 	closeHeight := uint32(11)
-	err = cdb.AbandonChannel(&chanState.FundingOutpoint, closeHeight)
+	err = cdb.AbandonChannel(
+		&chanState.FundingOutpoint, closeHeight, false,
+	)
 	if err != nil {
 		t.Fatalf("unable to abandon channel: %v", err)
 	}
@@ -528,8 +599,3485 @@ func TestAbandonChannel(t *testing.T) {
 
 	// Finally, if we attempt to abandon the channel again, we should get a
 	// nil error as the channel has already been abandoned.
-	err = cdb.AbandonChannel(&chanState.FundingOutpoint, closeHeight)
+	err = cdb.AbandonChannel(
+		&chanState.FundingOutpoint, closeHeight, false,
+	)
 	if err != nil {
 		t.Fatalf("unable to abandon channel: %v", err)
 	}
 }
+
+// TestCloseChannelBatch asserts that a batch of channels is closed atomically.
+func TestCloseChannelBatch(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	newClose := func(sync bool) ChannelClose {
+		t.Helper()
+
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if sync {
+			err := channel.SyncPending(addr, 10)
+			if err != nil {
+				t.Fatalf("unable to sync pending channel: %v",
+					err)
+			}
+		}
+
+		return ChannelClose{
+			Channel: channel,
+			Summary: &ChannelCloseSummary{
+				ChanPoint: channel.FundingOutpoint,
+				RemotePub: channel.IdentityPub,
+			},
+		}
+	}
+
+	var closes []ChannelClose
+	for i := 0; i < 3; i++ {
+		closes = append(closes, newClose(true))
+	}
+
+	// A batch that closes the same channel twice should be rejected.
+	err = cdb.CloseChannelBatch(closes[0], closes[1], closes[0])
+	if err == nil {
+		t.Fatalf("expected duplicate channel to be rejected")
+	}
+
+	// A batch containing a channel that wasn't read from the database
+	// should be rejected.
+	foreign := newClose(true)
+	foreign.Channel.Db = nil
+	err = cdb.CloseChannelBatch(closes[0], foreign)
+	if err == nil {
+		t.Fatalf("expected channel without database to be rejected")
+	}
+	foreign.Channel.Db = cdb
+	closes = append(closes, foreign)
+
+	// If any of the channels within the batch can't be closed, then none
+	// of them should be.
+	err = cdb.CloseChannelBatch(append(closes, newClose(false))...)
+	if err == nil {
+		t.Fatalf("expected unknown channel to fail the batch")
+	}
+	channels, err := cdb.FetchAllChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(channels) != len(closes) {
+		t.Fatalf("expected %v open channels, got %v", len(closes),
+			len(channels))
+	}
+
+	if err := cdb.CloseChannelBatch(closes...); err != nil {
+		t.Fatalf("unable to close channels: %v", err)
+	}
+	channels, err = cdb.FetchAllChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(channels) != 0 {
+		t.Fatalf("expected no open channels, got %v", len(channels))
+	}
+	for _, chanClose := range closes {
+		chanPoint := chanClose.Channel.FundingOutpoint
+		if _, err := cdb.FetchClosedChannel(&chanPoint); err != nil {
+			t.Fatalf("unable to fetch closed channel: %v", err)
+		}
+	}
+}
+
+// TestOpenTimeout asserts that attempting to open a database that is already
+// held open elsewhere fails with ErrDatabaseLocked once the timeout elapses.
+func TestOpenTimeout(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	cdb, err := Open(tempDirName)
+	if err != nil {
+		t.Fatalf("unable to create channeldb: %v", err)
+	}
+	defer cdb.Close()
+
+	// With the database still open, a second attempt to open it should
+	// time out rather than block indefinitely.
+	_, err = Open(tempDirName, OptionSetOpenTimeout(100*time.Millisecond))
+	if err != ErrDatabaseLocked {
+		t.Fatalf("expected ErrDatabaseLocked, got: %v", err)
+	}
+}
+
+// TestFetchChannelConfig tests that we're able to fetch the static channel
+// configurations of a channel without loading its full state.
+func TestFetchChannelConfig(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	channelState, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channelState.SyncPending(addr, 9); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	localCfg, remoteCfg, err := cdb.FetchChannelConfig(
+		channelState.FundingOutpoint,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch channel config: %v", err)
+	}
+
+	if !reflect.DeepEqual(*localCfg, channelState.LocalChanCfg) {
+		t.Fatalf("local config doesn't match: %v vs %v",
+			spew.Sdump(channelState.LocalChanCfg),
+			spew.Sdump(localCfg))
+	}
+	if !reflect.DeepEqual(*remoteCfg, channelState.RemoteChanCfg) {
+		t.Fatalf("remote config doesn't match: %v vs %v",
+			spew.Sdump(channelState.RemoteChanCfg),
+			spew.Sdump(remoteCfg))
+	}
+
+	// Querying for an unknown channel should return ErrChannelNotFound.
+	unknownPoint := channelState.FundingOutpoint
+	unknownPoint.Index ^= 1
+	_, _, err = cdb.FetchChannelConfig(unknownPoint)
+	if err != ErrChannelNotFound {
+		t.Fatalf("expected ErrChannelNotFound, got: %v", err)
+	}
+}
+
+// TestOpenInitializesEmptyFile asserts that a database file which was created
+// but never initialized, as would be the case after a crash during creation,
+// is fully initialized at the latest version on the next Open.
+func TestOpenInitializesEmptyFile(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	// Create an empty bbolt file in place of the channel database.
+	bdb, err := bbolt.Open(
+		filepath.Join(tempDirName, dbName), dbFilePermission, nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to create bbolt file: %v", err)
+	}
+	bdb.Close()
+
+	cdb, err := Open(tempDirName)
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+	defer cdb.Close()
+
+	meta, err := cdb.FetchMeta(nil)
+	if err != nil {
+		t.Fatalf("unable to fetch meta: %v", err)
+	}
+	if meta.DbVersionNumber != getLatestDBVersion(dbVersions) {
+		t.Fatalf("expected version %v, got %v",
+			getLatestDBVersion(dbVersions), meta.DbVersionNumber)
+	}
+
+	err = cdb.View(func(tx *bbolt.Tx) error {
+		if tx.Bucket(openChannelBucket) == nil {
+			return ErrNoChanDBExists
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("database not initialized: %v", err)
+	}
+}
+
+// TestFetchOpenChannelForID tests that we are able to properly retrieve an
+// OpenChannel from the DB given a ChannelID.
+func TestFetchOpenChannelForID(t *testing.T) {
+	t.Parallel()
+
+	const numChans = 101
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	// Now run through the number of channels, and modify the outpoint
+	// index to create new channel IDs that share a common prefix. To make
+	// sure we retrieve the correct channel later, we make them differ in
+	// their capacity.
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	for i := uint32(0); i < numChans; i++ {
+		state.FundingOutpoint.Index = i
+		state.Capacity = btcutil.Amount(500 + i)
+		if err := state.SyncPending(addr, 101); err != nil {
+			t.Fatalf("unable to save and serialize channel "+
+				"state: %v", err)
+		}
+	}
+
+	for i := uint32(0); i < numChans; i++ {
+		state.FundingOutpoint.Index = i
+
+		cid := lnwire.NewChanIDFromOutPoint(&state.FundingOutpoint)
+		channel, err := cdb.FetchOpenChannelForID(cid)
+		if err != nil {
+			t.Fatalf("unable to fetch channel: %v", err)
+		}
+
+		if channel.Capacity != btcutil.Amount(500+i) {
+			t.Fatalf("channels don't match: expected %v got %v",
+				btcutil.Amount(500+i), channel.Capacity)
+		}
+	}
+
+	// As a final test we make sure that we get ErrChannelNotFound for a
+	// ChannelID we didn't add to the DB.
+	state.FundingOutpoint.Index++
+	cid := lnwire.NewChanIDFromOutPoint(&state.FundingOutpoint)
+	_, err = cdb.FetchOpenChannelForID(cid)
+	if err != ErrChannelNotFound {
+		t.Fatalf("expected ErrChannelNotFound, instead got: %v", err)
+	}
+}
+
+// TestWithTx tests that callers are able to compose several reads within a
+// single transaction using the tx-accepting fetch variants, and that a
+// read-only transaction rejects writes.
+func TestWithTx(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	channelState, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channelState.SyncPending(addr, 9); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	// Within a single read-only transaction, we should be able to fetch
+	// the open channel, the addresses of our peer, and find that no close
+	// summary exists for the channel.
+	err = cdb.WithTx(true, func(tx *bbolt.Tx) error {
+		dbChannel, err := cdb.FetchChannelTx(
+			tx, channelState.FundingOutpoint,
+		)
+		if err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(channelState, dbChannel) {
+			t.Fatalf("channel state doesn't match:: %v vs %v",
+				spew.Sdump(channelState), spew.Sdump(dbChannel))
+		}
+
+		addrs, err := AddrsForNodeTx(tx, channelState.IdentityPub)
+		if err != nil {
+			return err
+		}
+		if len(addrs) != 1 || addrs[0].String() != addr.String() {
+			t.Fatalf("expected addr %v, got %v", addr, addrs)
+		}
+
+		_, err = FetchClosedChannelTx(tx, &channelState.FundingOutpoint)
+		if err != ErrClosedChannelNotFound {
+			t.Fatalf("expected ErrClosedChannelNotFound, got: %v",
+				err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to execute read tx: %v", err)
+	}
+
+	// A read-only transaction shouldn't permit any writes.
+	err = cdb.WithTx(true, func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte("test"))
+		return err
+	})
+	if err != bbolt.ErrTxNotWritable {
+		t.Fatalf("expected ErrTxNotWritable, got: %v", err)
+	}
+
+	// While a read-write one should.
+	err = cdb.WithTx(false, func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists([]byte("test"))
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to execute write tx: %v", err)
+	}
+}
+
+// TestFetchClosedChannelsSkipErrors tests that a corrupt close summary doesn't
+// prevent the remaining summaries from being retrieved.
+func TestFetchClosedChannelsSkipErrors(t *testing.T) {
+	t.Parallel()
+
+	const numChans = 5
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	for i := uint32(0); i < numChans; i++ {
+		state.FundingOutpoint.Index = i
+		if err := state.SyncPending(addr, 101); err != nil {
+			t.Fatalf("unable to save and serialize channel "+
+				"state: %v", err)
+		}
+
+		closeSummary := &ChannelCloseSummary{
+			ChanPoint:      state.FundingOutpoint,
+			RemotePub:      state.IdentityPub,
+			SettledBalance: btcutil.Amount(500 + i),
+		}
+		if err := state.CloseChannel(closeSummary); err != nil {
+			t.Fatalf("unable to close channel: %v", err)
+		}
+	}
+
+	// We'll now truncate the summary of the final channel, simulating a
+	// partial write.
+	err = cdb.Update(func(tx *bbolt.Tx) error {
+		closeBucket := tx.Bucket(closedChannelBucket)
+
+		var b bytes.Buffer
+		if err := writeOutpoint(&b, &state.FundingOutpoint); err != nil {
+			return err
+		}
+
+		summary := closeBucket.Get(b.Bytes())
+		return closeBucket.Put(b.Bytes(), summary[:10])
+	})
+	if err != nil {
+		t.Fatalf("unable to corrupt summary: %v", err)
+	}
+
+	// The regular scan should fail outright.
+	if _, err := cdb.FetchClosedChannels(false); err == nil {
+		t.Fatalf("expected error fetching corrupt summaries")
+	}
+
+	// While the error tolerant variant should return all but the corrupt
+	// summary, along with a single decode error.
+	summaries, decodeErrs, err := cdb.FetchClosedChannelsSkipErrors(false)
+	if err != nil {
+		t.Fatalf("unable to fetch closed channels: %v", err)
+	}
+	if len(summaries) != numChans-1 {
+		t.Fatalf("expected %v summaries, got %v", numChans-1,
+			len(summaries))
+	}
+	if len(decodeErrs) != 1 {
+		t.Fatalf("expected 1 decode error, got %v", len(decodeErrs))
+	}
+	for _, summary := range summaries {
+		if summary.ChanPoint == state.FundingOutpoint {
+			t.Fatalf("corrupt summary returned")
+		}
+	}
+}
+
+// TestRestoreChannelShellsSkipGraph tests that a channel shell which opts out
+// of graph restoration is restored without inserting an edge into the graph.
+func TestRestoreChannelShellsSkipGraph(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	channelShell, err := genRandomChannelShell()
+	if err != nil {
+		t.Fatalf("unable to gen channel shell: %v", err)
+	}
+	channelShell.SkipGraphRestore = true
+
+	// As we don't touch the graph, we don't need a source node in order
+	// to restore the channel.
+	if err := cdb.RestoreChannelShells(channelShell); err != nil {
+		t.Fatalf("unable to restore channel shell: %v", err)
+	}
+
+	// The channel and link node should be found as normal.
+	_, err = cdb.FetchChannel(channelShell.Chan.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to fetch channel: %v", err)
+	}
+	_, err = cdb.FetchLinkNode(channelShell.Chan.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch link node: %v", err)
+	}
+
+	// However, no edge should have been inserted for the channel.
+	chanInfos, err := cdb.ChannelGraph().FetchChanInfos(
+		[]uint64{channelShell.Chan.ShortChannelID.ToUint64()},
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch edges: %v", err)
+	}
+	if len(chanInfos) != 0 {
+		t.Fatalf("expected no edges, found %v", len(chanInfos))
+	}
+}
+
+// TestMoveTo tests that the database can be relocated to a new directory, and
+// that a database with a pending migration is refused.
+func TestMoveTo(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	channelState, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channelState.SyncPending(addr, 9); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	tempDir, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+	newDir := filepath.Join(tempDir, "moved")
+
+	oldPath := filepath.Join(cdb.Path(), dbName)
+	if err := cdb.MoveTo(newDir); err != nil {
+		t.Fatalf("unable to move database: %v", err)
+	}
+
+	// The database should now reside solely at its new location.
+	if cdb.Path() != newDir {
+		t.Fatalf("expected path %v, got %v", newDir, cdb.Path())
+	}
+	if fileExists(oldPath) {
+		t.Fatalf("database still exists at %v", oldPath)
+	}
+	if !fileExists(filepath.Join(newDir, dbName)) {
+		t.Fatalf("database not found at %v", newDir)
+	}
+
+	// And we should still be able to read our channel through the moved
+	// handle.
+	if _, err := cdb.FetchChannel(channelState.FundingOutpoint); err != nil {
+		t.Fatalf("unable to fetch channel: %v", err)
+	}
+
+	// Finally, we'll roll back the version of the database to simulate a
+	// pending migration. The move should now be refused, leaving the
+	// database in place.
+	err = cdb.Update(func(tx *bbolt.Tx) error {
+		return putMeta(&Meta{DbVersionNumber: 1}, tx)
+	})
+	if err != nil {
+		t.Fatalf("unable to update meta: %v", err)
+	}
+	err = cdb.MoveTo(filepath.Join(tempDir, "moved-again"))
+	if err != ErrMigrationPending {
+		t.Fatalf("expected ErrMigrationPending, got: %v", err)
+	}
+	if cdb.Path() != newDir {
+		t.Fatalf("expected path %v, got %v", newDir, cdb.Path())
+	}
+}
+
+// TestAbandonNonDefaultChannel tests that a channel which isn't in the default
+// state can only be abandoned if the operation is forced, unless it's only been
+// marked borked.
+func TestAbandonNonDefaultChannel(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	chanState, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := chanState.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+	if err := chanState.MarkCommitmentBroadcasted(testTx); err != nil {
+		t.Fatalf("unable to mark commitment broadcast: %v", err)
+	}
+	if err := chanState.MarkBorked(); err != nil {
+		t.Fatalf("unable to mark channel borked: %v", err)
+	}
+
+	// Without forcing the operation, we should be refused.
+	closeHeight := uint32(11)
+	err = cdb.AbandonChannel(&chanState.FundingOutpoint, closeHeight, false)
+	if _, ok := err.(ErrAbandonNonDefaultChannel); !ok {
+		t.Fatalf("expected ErrAbandonNonDefaultChannel, got: %v", err)
+	}
+
+	// The channel should still be open.
+	_, err = cdb.FetchChannel(chanState.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to fetch channel: %v", err)
+	}
+
+	// Once we force the abandon, it should go through.
+	err = cdb.AbandonChannel(&chanState.FundingOutpoint, closeHeight, true)
+	if err != nil {
+		t.Fatalf("unable to abandon channel: %v", err)
+	}
+	_, err = cdb.FetchChannel(chanState.FundingOutpoint)
+	if err != ErrChannelNotFound {
+		t.Fatalf("channel should not have been found: %v", err)
+	}
+
+	// A channel that has only been marked borked should be abandoned
+	// without forcing the operation.
+	chanState, err = createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	if err := chanState.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+	if err := chanState.MarkBorked(); err != nil {
+		t.Fatalf("unable to mark channel borked: %v", err)
+	}
+	err = cdb.AbandonChannel(&chanState.FundingOutpoint, closeHeight, false)
+	if err != nil {
+		t.Fatalf("unable to abandon channel: %v", err)
+	}
+}
+
+// TestSetChannelStatus tests that we're able to set and clear the status flags
+// of a channel directly through the database.
+func TestSetChannelStatus(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	chanState, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := chanState.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+
+	assertStatus := func(expected ChannelStatus) {
+		t.Helper()
+
+		dbChan, err := cdb.FetchChannel(chanState.FundingOutpoint)
+		if err != nil {
+			t.Fatalf("unable to fetch channel: %v", err)
+		}
+		if dbChan.ChanStatus() != expected {
+			t.Fatalf("expected status %v, got %v", expected,
+				dbChan.ChanStatus())
+		}
+	}
+
+	// We'll set two flags, then clear one of them, ensuring the other
+	// remains untouched.
+	err = cdb.SetChannelStatus(
+		chanState.FundingOutpoint, ChanStatusBorked, true,
+	)
+	if err != nil {
+		t.Fatalf("unable to set status: %v", err)
+	}
+	err = cdb.SetChannelStatus(
+		chanState.FundingOutpoint, ChanStatusLocalDataLoss, true,
+	)
+	if err != nil {
+		t.Fatalf("unable to set status: %v", err)
+	}
+	assertStatus(ChanStatusBorked | ChanStatusLocalDataLoss)
+
+	err = cdb.SetChannelStatus(
+		chanState.FundingOutpoint, ChanStatusBorked, false,
+	)
+	if err != nil {
+		t.Fatalf("unable to clear status: %v", err)
+	}
+	assertStatus(ChanStatusLocalDataLoss)
+
+	// As our channel is a single funder channel we initiated, marking it
+	// as restored drops the funding transaction, so the restored status
+	// can't be cleared again.
+	err = cdb.SetChannelStatus(
+		chanState.FundingOutpoint, ChanStatusRestored, true,
+	)
+	if err != nil {
+		t.Fatalf("unable to set status: %v", err)
+	}
+	err = cdb.SetChannelStatus(
+		chanState.FundingOutpoint, ChanStatusRestored, false,
+	)
+	if err == nil {
+		t.Fatalf("expected failure clearing restored status")
+	}
+	assertStatus(ChanStatusLocalDataLoss | ChanStatusRestored)
+
+	// Setting the status of a channel that doesn't exist should fail.
+	unknown := chanState.FundingOutpoint
+	unknown.Index++
+	err = cdb.SetChannelStatus(unknown, ChanStatusRestored, true)
+	if err != ErrChannelNotFound {
+		t.Fatalf("expected ErrChannelNotFound, got: %v", err)
+	}
+}
+
+// TestChannelExists tests that we're able to determine whether a channel
+// exists without fetching it.
+func TestChannelExists(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	chanState, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	// Before the channel is synced, it shouldn't be found.
+	exists, err := cdb.ChannelExists(chanState.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to check channel existence: %v", err)
+	}
+	if exists {
+		t.Fatalf("channel shouldn't exist")
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := chanState.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+
+	// Now that it has been synced it should be found, while a channel
+	// with a different output index still shouldn't be.
+	exists, err = cdb.ChannelExists(chanState.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to check channel existence: %v", err)
+	}
+	if !exists {
+		t.Fatalf("channel should exist")
+	}
+
+	unknown := chanState.FundingOutpoint
+	unknown.Index++
+	exists, err = cdb.ChannelExists(unknown)
+	if err != nil {
+		t.Fatalf("unable to check channel existence: %v", err)
+	}
+	if exists {
+		t.Fatalf("channel shouldn't exist")
+	}
+}
+
+// TestFetchChannelsByFundingTXID asserts that all channels funded by the same
+// transaction are returned, regardless of their output index.
+func TestFetchChannelsByFundingTXID(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+
+	// We'll create two channels funded by the same transaction, along
+	// with a third funded by another.
+	var batch []*OpenChannel
+	for i := uint32(0); i < 3; i++ {
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if i < 2 {
+			channel.FundingOutpoint.Index = i
+			batch = append(batch, channel)
+		} else {
+			channel.FundingOutpoint.Hash[0] ^= 0xff
+		}
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+	}
+
+	channels, err := cdb.FetchChannelsByFundingTXID(
+		batch[0].FundingOutpoint.Hash,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(channels) != len(batch) {
+		t.Fatalf("expected %v channels, got %v", len(batch),
+			len(channels))
+	}
+	for i, channel := range channels {
+		if channel.FundingOutpoint != batch[i].FundingOutpoint {
+			t.Fatalf("expected channel %v, got %v",
+				batch[i].FundingOutpoint,
+				channel.FundingOutpoint)
+		}
+	}
+
+	// A txid that doesn't fund any channel should yield no channels.
+	var unknown chainhash.Hash
+	channels, err = cdb.FetchChannelsByFundingTXID(unknown)
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(channels) != 0 {
+		t.Fatalf("expected no channels, got %v", len(channels))
+	}
+}
+
+// TestFetchOpenChannelRaw asserts that the raw contents of a channel's bucket
+// are returned, excluding any nested buckets.
+func TestFetchOpenChannelRaw(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	chanPoint := channel.FundingOutpoint
+
+	_, err = cdb.FetchOpenChannelRaw(chanPoint)
+	if err != ErrNoActiveChannels && err != ErrChannelNotFound {
+		t.Fatalf("expected channel not to be found, got: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channel.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+
+	kvs, err := cdb.FetchOpenChannelRaw(chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch raw channel: %v", err)
+	}
+
+	// The raw contents should match the bucket exactly.
+	err = cdb.View(func(tx *bbolt.Tx) error {
+		chanBucket, err := findChanBucket(tx, &chanPoint)
+		if err != nil {
+			return err
+		}
+
+		var numKeys int
+		err = chanBucket.ForEach(func(k, v []byte) error {
+			if v == nil {
+				if _, ok := kvs[string(k)]; ok {
+					return fmt.Errorf("nested bucket %x "+
+						"included", k)
+				}
+				return nil
+			}
+
+			numKeys++
+			if !bytes.Equal(kvs[string(k)], v) {
+				return fmt.Errorf("mismatched value for "+
+					"key %x", k)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if numKeys != len(kvs) {
+			return fmt.Errorf("expected %v keys, got %v", numKeys,
+				len(kvs))
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("raw channel mismatch: %v", err)
+	}
+	if _, ok := kvs[string(chanInfoKey)]; !ok {
+		t.Fatalf("expected channel info to be included")
+	}
+}
+
+// TestIsChannelClosed tests that we're able to cheaply determine whether a
+// channel has been closed.
+func TestIsChannelClosed(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	chanState, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := chanState.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+
+	// While the channel is still open, it shouldn't be reported as
+	// closed.
+	closed, err := cdb.IsChannelClosed(chanState.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to check whether channel is closed: %v", err)
+	}
+	if closed {
+		t.Fatalf("channel shouldn't be closed")
+	}
+
+	err = chanState.CloseChannel(&ChannelCloseSummary{
+		ChanPoint: chanState.FundingOutpoint,
+		RemotePub: chanState.IdentityPub,
+	})
+	if err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+
+	// Once closed, it should be reported as such, while a channel with a
+	// different output index still shouldn't be.
+	closed, err = cdb.IsChannelClosed(chanState.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to check whether channel is closed: %v", err)
+	}
+	if !closed {
+		t.Fatalf("channel should be closed")
+	}
+
+	unknown := chanState.FundingOutpoint
+	unknown.Index++
+	closed, err = cdb.IsChannelClosed(unknown)
+	if err != nil {
+		t.Fatalf("unable to check whether channel is closed: %v", err)
+	}
+	if closed {
+		t.Fatalf("channel shouldn't be closed")
+	}
+}
+
+// TestClosedChannelRaw asserts that a raw close summary can be replicated to
+// another database byte for byte.
+func TestClosedChannelRaw(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	replica, cleanUpReplica, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUpReplica()
+
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	chanPoint := channel.FundingOutpoint
+
+	// Before the channel is closed, there's no summary to fetch.
+	_, err = cdb.FetchClosedChannelRaw(chanPoint)
+	if err != ErrClosedChannelNotFound {
+		t.Fatalf("expected ErrClosedChannelNotFound, got: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channel.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+	err = channel.CloseChannel(&ChannelCloseSummary{
+		ChanPoint:      chanPoint,
+		RemotePub:      channel.IdentityPub,
+		SettledBalance: 1000,
+		CloseType:      CooperativeClose,
+	})
+	if err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+
+	summaryBytes, err := cdb.FetchClosedChannelRaw(chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch raw summary: %v", err)
+	}
+
+	// A summary can't be stored under the outpoint of another channel,
+	// nor can garbage be stored.
+	otherChanPoint := chanPoint
+	otherChanPoint.Index++
+	err = replica.PutClosedChannelRaw(otherChanPoint, summaryBytes)
+	if err == nil {
+		t.Fatalf("expected mismatched channel point to be rejected")
+	}
+	err = replica.PutClosedChannelRaw(chanPoint, []byte{1, 2, 3})
+	if err == nil {
+		t.Fatalf("expected invalid summary to be rejected")
+	}
+
+	err = replica.PutClosedChannelRaw(chanPoint, summaryBytes)
+	if err != nil {
+		t.Fatalf("unable to put raw summary: %v", err)
+	}
+	replicaBytes, err := replica.FetchClosedChannelRaw(chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch raw summary: %v", err)
+	}
+	if !bytes.Equal(replicaBytes, summaryBytes) {
+		t.Fatalf("replicated summary doesn't match")
+	}
+
+	summary, err := replica.FetchClosedChannel(&chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch closed channel: %v", err)
+	}
+	if summary.SettledBalance != 1000 {
+		t.Fatalf("expected settled balance 1000, got %v",
+			summary.SettledBalance)
+	}
+}
+
+// TestClosedChannelHeightRange tests that we're able to determine the range
+// of close heights of all closed channels, and to fetch the closed channels
+// within a given height range.
+func TestClosedChannelHeightRange(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// Without any closed channels, we should get ErrNoClosedChannels.
+	_, _, err = cdb.ClosedChannelHeightRange()
+	if err != ErrNoClosedChannels {
+		t.Fatalf("expected ErrNoClosedChannels, got: %v", err)
+	}
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	closeHeights := []uint32{500, 100, 300}
+	for i, height := range closeHeights {
+		state.FundingOutpoint.Index = uint32(i)
+		if err := state.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to save and serialize channel "+
+				"state: %v", err)
+		}
+
+		closeSummary := &ChannelCloseSummary{
+			ChanPoint:   state.FundingOutpoint,
+			RemotePub:   state.IdentityPub,
+			CloseHeight: height,
+		}
+		if err := state.CloseChannel(closeSummary); err != nil {
+			t.Fatalf("unable to close channel: %v", err)
+		}
+	}
+
+	minHeight, maxHeight, err := cdb.ClosedChannelHeightRange()
+	if err != nil {
+		t.Fatalf("unable to fetch height range: %v", err)
+	}
+	if minHeight != 100 || maxHeight != 500 {
+		t.Fatalf("expected range [100, 500], got [%v, %v]",
+			minHeight, maxHeight)
+	}
+
+	// We'll also ensure that we're able to filter the summaries by their
+	// close height, including the bounds of the range.
+	tests := []struct {
+		start, end uint32
+		expected   []uint32
+	}{
+		{start: 0, end: 1000, expected: []uint32{100, 300, 500}},
+		{start: 100, end: 300, expected: []uint32{100, 300}},
+		{start: 301, end: 499, expected: nil},
+		{start: 500, end: 500, expected: []uint32{500}},
+	}
+	for _, test := range tests {
+		summaries, err := cdb.FetchClosedChannelsInHeightRange(
+			test.start, test.end,
+		)
+		if err != nil {
+			t.Fatalf("unable to fetch closed channels: %v", err)
+		}
+
+		var heights []uint32
+		for _, summary := range summaries {
+			heights = append(heights, summary.CloseHeight)
+		}
+		sort.Slice(heights, func(i, j int) bool {
+			return heights[i] < heights[j]
+		})
+
+		if !reflect.DeepEqual(heights, test.expected) {
+			t.Fatalf("range [%v, %v]: expected heights %v, got %v",
+				test.start, test.end, test.expected, heights)
+		}
+	}
+}
+
+// TestFetchMaturityHeights asserts that the maturity height is returned for
+// each channel that isn't yet fully resolved with a time lock applied to it.
+func TestFetchMaturityHeights(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	heights, err := cdb.FetchMaturityHeights()
+	if err != nil {
+		t.Fatalf("unable to fetch maturity heights: %v", err)
+	}
+	if len(heights) != 0 {
+		t.Fatalf("expected no maturity heights, got %v", heights)
+	}
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	closes := []struct {
+		closeType   ClosureType
+		closeHeight uint32
+		csvDelay    uint16
+		timeLocked  btcutil.Amount
+		isPending   bool
+	}{
+		{LocalForceClose, 500, 144, 1000, true},
+		{LocalForceClose, 100, 10, 1000, true},
+		{LocalForceClose, 300, 144, 1000, false},
+		{LocalForceClose, 200, 144, 0, true},
+		{LocalForceClose, 400, 0, 1000, true},
+		{CooperativeClose, 100, 144, 1000, true},
+		{RemoteForceClose, 100, 144, 1000, true},
+		{BreachClose, 100, 144, 1000, true},
+		{FundingCanceled, 100, 144, 1000, true},
+		{Abandoned, 100, 144, 1000, true},
+	}
+	for i, closure := range closes {
+		// The CSV delay of our outputs is recorded within the close
+		// summary from the channel's config.
+		state.FundingOutpoint.Index = uint32(i)
+		state.LocalChanCfg.CsvDelay = closure.csvDelay
+		if err := state.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to save and serialize channel "+
+				"state: %v", err)
+		}
+
+		closeSummary := &ChannelCloseSummary{
+			ChanPoint:         state.FundingOutpoint,
+			RemotePub:         state.IdentityPub,
+			CloseType:         closure.closeType,
+			CloseHeight:       closure.closeHeight,
+			TimeLockedBalance: closure.timeLocked,
+			IsPending:         closure.isPending,
+		}
+		if err := state.CloseChannel(closeSummary); err != nil {
+			t.Fatalf("unable to close channel: %v", err)
+		}
+	}
+
+	// Only the unresolved channels we force closed with a time locked
+	// balance and a CSV delay should be returned.
+	expected := map[wire.OutPoint]uint32{
+		{Hash: state.FundingOutpoint.Hash, Index: 0}: 644,
+		{Hash: state.FundingOutpoint.Hash, Index: 1}: 110,
+	}
+	heights, err = cdb.FetchMaturityHeights()
+	if err != nil {
+		t.Fatalf("unable to fetch maturity heights: %v", err)
+	}
+	if !reflect.DeepEqual(heights, expected) {
+		t.Fatalf("expected maturity heights %v, got %v", expected,
+			heights)
+	}
+}
+
+// TestPruneClosedChannels tests that only the close summaries of fully closed
+// channels below the cutoff height are pruned, along with their event logs.
+func TestPruneClosedChannels(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// Pruning before any channels have been closed is a noop.
+	numPruned, err := cdb.PruneClosedChannels(1000)
+	if err != nil {
+		t.Fatalf("unable to prune closed channels: %v", err)
+	}
+	if numPruned != 0 {
+		t.Fatalf("expected no channels to be pruned, got %v", numPruned)
+	}
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	closes := []struct {
+		height  uint32
+		pending bool
+	}{
+		{height: 100, pending: false},
+		{height: 200, pending: true},
+		{height: 300, pending: false},
+	}
+	var chanPoints []wire.OutPoint
+	for i, chanClose := range closes {
+		state.FundingOutpoint.Index = uint32(i)
+		if err := state.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to save and serialize channel "+
+				"state: %v", err)
+		}
+		err := cdb.LogChannelEvent(
+			&state.FundingOutpoint, ChanStatusDefault,
+			ChanStatusBorked,
+		)
+		if err != nil {
+			t.Fatalf("unable to log channel event: %v", err)
+		}
+
+		closeSummary := &ChannelCloseSummary{
+			ChanPoint:   state.FundingOutpoint,
+			RemotePub:   state.IdentityPub,
+			CloseHeight: chanClose.height,
+			IsPending:   chanClose.pending,
+		}
+		if err := state.CloseChannel(closeSummary); err != nil {
+			t.Fatalf("unable to close channel: %v", err)
+		}
+		chanPoints = append(chanPoints, state.FundingOutpoint)
+	}
+
+	// Pruning below a height of 300 should only remove the first channel,
+	// as the second one is still pending.
+	numPruned, err = cdb.PruneClosedChannels(300)
+	if err != nil {
+		t.Fatalf("unable to prune closed channels: %v", err)
+	}
+	if numPruned != 1 {
+		t.Fatalf("expected 1 channel to be pruned, got %v", numPruned)
+	}
+
+	_, err = cdb.FetchClosedChannel(&chanPoints[0])
+	if err != ErrClosedChannelNotFound {
+		t.Fatalf("expected ErrClosedChannelNotFound, got: %v", err)
+	}
+	events, err := cdb.FetchChannelEvents(&chanPoints[0])
+	if err != nil {
+		t.Fatalf("unable to fetch channel events: %v", err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("expected event log to be pruned, got %v events",
+			len(events))
+	}
+
+	for _, chanPoint := range chanPoints[1:] {
+		_, err := cdb.FetchClosedChannel(&chanPoint)
+		if err != nil {
+			t.Fatalf("unable to fetch closed channel %v: %v",
+				chanPoint, err)
+		}
+		events, err := cdb.FetchChannelEvents(&chanPoint)
+		if err != nil {
+			t.Fatalf("unable to fetch channel events: %v", err)
+		}
+		if len(events) == 0 {
+			t.Fatalf("expected event log of %v to be retained",
+				chanPoint)
+		}
+	}
+
+	// The pending channel should be kept even once it's far below the
+	// cutoff.
+	numPruned, err = cdb.PruneClosedChannels(math.MaxUint32)
+	if err != nil {
+		t.Fatalf("unable to prune closed channels: %v", err)
+	}
+	if numPruned != 1 {
+		t.Fatalf("expected 1 channel to be pruned, got %v", numPruned)
+	}
+	if _, err := cdb.FetchClosedChannel(&chanPoints[1]); err != nil {
+		t.Fatalf("unable to fetch pending closed channel: %v", err)
+	}
+}
+
+// TestFetchChannelsWithStatus tests that we're able to fetch the set of
+// channels matching any of a set of status flags.
+func TestFetchChannelsWithStatus(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	// We'll create a channel for each of the following statuses, using
+	// the output index to tell them apart later on.
+	chanStatuses := []ChannelStatus{
+		ChanStatusDefault,
+		ChanStatusBorked,
+		ChanStatusBorked | ChanStatusCommitBroadcasted,
+		ChanStatusLocalDataLoss,
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	for i, status := range chanStatuses {
+		state.FundingOutpoint.Index = uint32(i)
+		if err := state.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to save and serialize channel "+
+				"state: %v", err)
+		}
+
+		if status == ChanStatusDefault {
+			continue
+		}
+		err := cdb.SetChannelStatus(state.FundingOutpoint, status, true)
+		if err != nil {
+			t.Fatalf("unable to set channel status: %v", err)
+		}
+	}
+
+	tests := []struct {
+		statuses []ChannelStatus
+		expected []uint32
+	}{
+		{
+			statuses: []ChannelStatus{ChanStatusDefault},
+			expected: []uint32{0},
+		},
+		{
+			statuses: []ChannelStatus{ChanStatusBorked},
+			expected: []uint32{1, 2},
+		},
+		{
+			statuses: []ChannelStatus{
+				ChanStatusDefault, ChanStatusLocalDataLoss,
+			},
+			expected: []uint32{0, 3},
+		},
+		{
+			statuses: []ChannelStatus{ChanStatusRestored},
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		channels, err := cdb.FetchChannelsWithStatus(test.statuses...)
+		if err != nil {
+			t.Fatalf("unable to fetch channels: %v", err)
+		}
+
+		var indexes []uint32
+		for _, channel := range channels {
+			indexes = append(indexes, channel.FundingOutpoint.Index)
+		}
+		sort.Slice(indexes, func(i, j int) bool {
+			return indexes[i] < indexes[j]
+		})
+
+		if !reflect.DeepEqual(indexes, test.expected) {
+			t.Fatalf("statuses %v: expected channels %v, got %v",
+				test.statuses, test.expected, indexes)
+		}
+	}
+}
+
+// TestChannelStatusCounts asserts that open channels are counted once for each
+// of the status flags they have set.
+func TestChannelStatusCounts(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// Without any channels, there should be nothing to count.
+	counts, err := cdb.ChannelStatusCounts()
+	if err != nil {
+		t.Fatalf("unable to count channel statuses: %v", err)
+	}
+	if len(counts) != 0 {
+		t.Fatalf("expected no counts, got %v", counts)
+	}
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	chanStatuses := []ChannelStatus{
+		ChanStatusDefault,
+		ChanStatusDefault,
+		ChanStatusBorked,
+		ChanStatusBorked | ChanStatusLocalDataLoss,
+		ChanStatusLocalDataLoss,
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	for i, status := range chanStatuses {
+		state.FundingOutpoint.Index = uint32(i)
+		if err := state.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to save and serialize channel "+
+				"state: %v", err)
+		}
+
+		if status == ChanStatusDefault {
+			continue
+		}
+		err := cdb.SetChannelStatus(state.FundingOutpoint, status, true)
+		if err != nil {
+			t.Fatalf("unable to set channel status: %v", err)
+		}
+	}
+
+	counts, err = cdb.ChannelStatusCounts()
+	if err != nil {
+		t.Fatalf("unable to count channel statuses: %v", err)
+	}
+	expected := map[ChannelStatus]uint32{
+		ChanStatusDefault:       2,
+		ChanStatusBorked:        2,
+		ChanStatusLocalDataLoss: 2,
+	}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Fatalf("expected counts %v, got %v", expected, counts)
+	}
+}
+
+// TestFetchChannelInitiators asserts that the initiator of each open channel,
+// including pending ones, is reported correctly.
+func TestFetchChannelInitiators(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	initiators, err := cdb.FetchChannelInitiators()
+	if err != nil {
+		t.Fatalf("unable to fetch initiators: %v", err)
+	}
+	if len(initiators) != 0 {
+		t.Fatalf("expected no channels, got %v", initiators)
+	}
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	expected := make(map[wire.OutPoint]bool)
+	for i, isInitiator := range []bool{true, false, true} {
+		state.FundingOutpoint.Index = uint32(i)
+		state.IsInitiator = isInitiator
+		if err := state.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to save and serialize channel "+
+				"state: %v", err)
+		}
+		expected[state.FundingOutpoint] = isInitiator
+	}
+
+	// Opening the first channel shouldn't affect its initiator.
+	state.FundingOutpoint.Index = 0
+	err = state.MarkAsOpen(lnwire.NewShortChanIDFromInt(1))
+	if err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+
+	initiators, err = cdb.FetchChannelInitiators()
+	if err != nil {
+		t.Fatalf("unable to fetch initiators: %v", err)
+	}
+	if !reflect.DeepEqual(initiators, expected) {
+		t.Fatalf("expected initiators %v, got %v", expected,
+			initiators)
+	}
+}
+
+// TestFetchChannelsByCsvDelay asserts that only the channels whose remote CSV
+// delay exceeds the threshold are returned.
+func TestFetchChannelsByCsvDelay(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	channels, err := cdb.FetchChannelsByCsvDelay(0)
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(channels) != 0 {
+		t.Fatalf("expected no channels, got %v", len(channels))
+	}
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	for i, csvDelay := range []uint16{144, 2016, 1000} {
+		state.FundingOutpoint.Index = uint32(i)
+		state.RemoteChanCfg.CsvDelay = csvDelay
+		if err := state.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to save and serialize channel "+
+				"state: %v", err)
+		}
+	}
+
+	// Only the channels with a delay strictly above the threshold should
+	// be returned.
+	channels, err = cdb.FetchChannelsByCsvDelay(1000)
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(channels) != 1 {
+		t.Fatalf("expected 1 channel, got %v", len(channels))
+	}
+	if channels[0].FundingOutpoint.Index != 1 {
+		t.Fatalf("expected channel %v, got %v", 1,
+			channels[0].FundingOutpoint)
+	}
+	if channels[0].RemoteChanCfg.CsvDelay != 2016 {
+		t.Fatalf("expected csv delay %v, got %v", 2016,
+			channels[0].RemoteChanCfg.CsvDelay)
+	}
+
+	channels, err = cdb.FetchChannelsByCsvDelay(100)
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(channels) != 3 {
+		t.Fatalf("expected 3 channels, got %v", len(channels))
+	}
+}
+
+// TestFetchChannelsByCapacityRange asserts that only open channels whose
+// capacity lies within the queried range are returned.
+func TestFetchChannelsByCapacityRange(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	addChannel := func(capacity btcutil.Amount, open bool) *OpenChannel {
+		t.Helper()
+
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		channel.Capacity = capacity
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+		if !open {
+			return channel
+		}
+
+		openLoc := lnwire.NewShortChanIDFromInt(uint64(capacity))
+		if err := channel.MarkAsOpen(openLoc); err != nil {
+			t.Fatalf("unable to mark channel open: %v", err)
+		}
+		return channel
+	}
+
+	// We'll add open channels of increasing capacity, along with a pending
+	// and a borked channel within the range we'll query for, neither of
+	// which should be returned.
+	for _, capacity := range []btcutil.Amount{1000, 2000, 3000, 4000} {
+		addChannel(capacity, true)
+	}
+	addChannel(2500, false)
+	borked := addChannel(2500, true)
+	if err := borked.MarkBorked(); err != nil {
+		t.Fatalf("unable to mark channel borked: %v", err)
+	}
+
+	channels, err := cdb.FetchChannelsByCapacityRange(2000, 3000)
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+
+	var capacities []btcutil.Amount
+	for _, channel := range channels {
+		capacities = append(capacities, channel.Capacity)
+	}
+	sort.Slice(capacities, func(i, j int) bool {
+		return capacities[i] < capacities[j]
+	})
+
+	expected := []btcutil.Amount{2000, 3000}
+	if !reflect.DeepEqual(capacities, expected) {
+		t.Fatalf("expected capacities %v, got %v", expected,
+			capacities)
+	}
+}
+
+// TestChannelAgeBuckets asserts that open channels are classified by their age
+// relative to the current height.
+func TestChannelAgeBuckets(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	addChannel := func(openHeight uint32, open bool) {
+		t.Helper()
+
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+		if !open {
+			return
+		}
+
+		openLoc := lnwire.ShortChannelID{BlockHeight: openHeight}
+		if err := channel.MarkAsOpen(openLoc); err != nil {
+			t.Fatalf("unable to mark channel open: %v", err)
+		}
+	}
+
+	const currentHeight = 100000
+	addChannel(currentHeight, true)
+	addChannel(currentHeight+10, true)
+	addChannel(currentHeight-1008, true)
+	addChannel(currentHeight-4319, true)
+	addChannel(currentHeight-30000, true)
+	addChannel(0, false)
+
+	buckets, err := cdb.ChannelAgeBuckets(currentHeight)
+	if err != nil {
+		t.Fatalf("unable to fetch channel age buckets: %v", err)
+	}
+	expected := map[string]uint32{
+		ChanAgeUnderWeek:  2,
+		ChanAgeUnderMonth: 2,
+		ChanAgeOlder:      1,
+	}
+	if !reflect.DeepEqual(buckets, expected) {
+		t.Fatalf("expected buckets %v, got %v", expected, buckets)
+	}
+}
+
+// TestFetchChannelsNeedingResolution asserts that only waiting close channels
+// with outputs left to resolve are returned.
+func TestFetchChannelsNeedingResolution(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	state.LocalChanCfg.DustLimit = 500
+
+	// We'll create a channel for each of the following cases, using the
+	// output index to tell them apart later on.
+	htlc := HTLC{
+		Amt:           lnwire.NewMSatFromSatoshis(100),
+		OutputIndex:   -1,
+		OnionBlob:     []byte{},
+		HtlcIndex:     1,
+		LogIndex:      1,
+		RefundTimeout: 500,
+	}
+	tests := []struct {
+		status       ChannelStatus
+		localBalance btcutil.Amount
+		localHtlcs   []HTLC
+		remoteHtlcs  []HTLC
+		unresolved   bool
+		expected     bool
+	}{
+		// An open channel doesn't need to be resolved.
+		{
+			status:       ChanStatusDefault,
+			localBalance: 1000,
+			expected:     false,
+		},
+		// A balance above the dust limit must be swept.
+		{
+			status:       ChanStatusCommitBroadcasted,
+			localBalance: 1000,
+			expected:     true,
+		},
+		// A dust balance without HTLCs has no outputs to resolve.
+		{
+			status:       ChanStatusCommitBroadcasted,
+			localBalance: 100,
+			expected:     false,
+		},
+		// HTLCs on either commitment must be resolved.
+		{
+			status:     ChanStatusCommitBroadcasted,
+			localHtlcs: []HTLC{htlc},
+			expected:   true,
+		},
+		{
+			status:      ChanStatusBorked,
+			remoteHtlcs: []HTLC{htlc},
+			expected:    true,
+		},
+		// Channels that lost state are always returned.
+		{
+			status:   ChanStatusLocalDataLoss,
+			expected: true,
+		},
+		// Recorded unresolved outputs take precedence over the
+		// heuristic, so a dust balance without HTLCs is returned.
+		{
+			status:       ChanStatusCommitBroadcasted,
+			localBalance: 100,
+			unresolved:   true,
+			expected:     true,
+		},
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	var expected []uint32
+	for i, test := range tests {
+		state.FundingOutpoint.Index = uint32(i)
+		state.LocalCommitment.LocalBalance = lnwire.NewMSatFromSatoshis(
+			test.localBalance,
+		)
+		state.LocalCommitment.Htlcs = test.localHtlcs
+		state.RemoteCommitment.Htlcs = test.remoteHtlcs
+		if err := state.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to save and serialize channel "+
+				"state: %v", err)
+		}
+
+		if test.status != ChanStatusDefault {
+			err := cdb.SetChannelStatus(
+				state.FundingOutpoint, test.status, true,
+			)
+			if err != nil {
+				t.Fatalf("unable to set channel status: %v",
+					err)
+			}
+		}
+
+		if test.unresolved {
+			output := wire.OutPoint{Hash: rev, Index: uint32(i)}
+			err := cdb.AddUnresolvedOutputs(
+				state.FundingOutpoint, output,
+			)
+			if err != nil {
+				t.Fatalf("unable to add unresolved outputs: "+
+					"%v", err)
+			}
+		}
+
+		if test.expected {
+			expected = append(expected, uint32(i))
+		}
+	}
+
+	channels, err := cdb.FetchChannelsNeedingResolution()
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+
+	var indexes []uint32
+	for _, channel := range channels {
+		indexes = append(indexes, channel.FundingOutpoint.Index)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i] < indexes[j]
+	})
+
+	if !reflect.DeepEqual(indexes, expected) {
+		t.Fatalf("expected channels %v, got %v", expected, indexes)
+	}
+}
+
+// TestGraphPruneTip asserts that the prune tip of the graph can be read and
+// set through the database.
+func TestGraphPruneTip(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	if _, _, err := cdb.GraphPruneTip(); err != ErrGraphNeverPruned {
+		t.Fatalf("expected ErrGraphNeverPruned, got: %v", err)
+	}
+
+	assertPruneTip := func(height uint32, hash chainhash.Hash) {
+		t.Helper()
+
+		tipHeight, tipHash, err := cdb.GraphPruneTip()
+		if err != nil {
+			t.Fatalf("unable to fetch prune tip: %v", err)
+		}
+		if tipHeight != height || tipHash != hash {
+			t.Fatalf("expected prune tip %v@%d, got %v@%d", hash,
+				height, tipHash, tipHeight)
+		}
+
+		// The prune tip should match the one reported by the graph.
+		graphHash, graphHeight, err := cdb.ChannelGraph().PruneTip()
+		if err != nil {
+			t.Fatalf("unable to fetch graph prune tip: %v", err)
+		}
+		if graphHeight != height || *graphHash != hash {
+			t.Fatalf("expected graph prune tip %v@%d, got %v@%d",
+				hash, height, graphHash, graphHeight)
+		}
+	}
+
+	// Setting increasing prune tips should advance the tip.
+	hash1 := chainhash.Hash{1}
+	if err := cdb.SetGraphPruneTip(100, hash1); err != nil {
+		t.Fatalf("unable to set prune tip: %v", err)
+	}
+	assertPruneTip(100, hash1)
+
+	hash2 := chainhash.Hash{2}
+	if err := cdb.SetGraphPruneTip(200, hash2); err != nil {
+		t.Fatalf("unable to set prune tip: %v", err)
+	}
+	assertPruneTip(200, hash2)
+
+	// Setting a lower prune tip should rewind the prune log, while leaving
+	// the entries below it in place.
+	hash3 := chainhash.Hash{3}
+	if err := cdb.SetGraphPruneTip(150, hash3); err != nil {
+		t.Fatalf("unable to set prune tip: %v", err)
+	}
+	assertPruneTip(150, hash3)
+
+	err = cdb.View(func(tx *bbolt.Tx) error {
+		pruneBucket := tx.Bucket(graphMetaBucket).Bucket(pruneLogBucket)
+		if pruneBucket.Stats().KeyN != 2 {
+			return fmt.Errorf("expected 2 prune log entries, got %d",
+				pruneBucket.Stats().KeyN)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Overwriting the current tip should replace its hash.
+	if err := cdb.SetGraphPruneTip(150, hash1); err != nil {
+		t.Fatalf("unable to set prune tip: %v", err)
+	}
+	assertPruneTip(150, hash1)
+}
+
+// TestFetchRecentlyUpdatedChannels asserts that only the channels with a policy
+// updated after the queried time are returned, each of them only once.
+func TestFetchRecentlyUpdatedChannels(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	graph := cdb.ChannelGraph()
+	node1, err := createTestVertex(cdb)
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+	if err := graph.AddLightningNode(node1); err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+	node2, err := createTestVertex(cdb)
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+	if err := graph.AddLightningNode(node2); err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+
+	// We'll create channels whose policies are updated 100 seconds apart,
+	// with the second policy of each updated another 10 seconds later.
+	const numChans = 4
+	var chanIDs []uint64
+	for i := 0; i < numChans; i++ {
+		channel, chanID := createEdge(
+			uint32(i*10), 0, 0, 0, node1, node2,
+		)
+		if err := graph.AddChannelEdge(&channel); err != nil {
+			t.Fatalf("unable to create channel edge: %v", err)
+		}
+		chanIDs = append(chanIDs, chanID.ToUint64())
+
+		updateTime := int64(1000 + i*100)
+		for j, node := range []*LightningNode{node2, node1} {
+			edge := newEdgePolicy(
+				chanID.ToUint64(), channel.ChannelPoint, cdb,
+				updateTime+int64(j*10),
+			)
+			edge.ChannelFlags = lnwire.ChanUpdateChanFlags(j)
+			edge.Node = node
+			edge.SigBytes = testSig.Serialize()
+			if err := graph.UpdateEdgePolicy(edge); err != nil {
+				t.Fatalf("unable to update edge: %v", err)
+			}
+		}
+	}
+
+	tests := []struct {
+		since    time.Time
+		expected []uint64
+	}{
+		{
+			since:    time.Unix(0, 0),
+			expected: chanIDs,
+		},
+		{
+			// An update at exactly the queried time isn't after
+			// it, but the second policy of the channel is.
+			since:    time.Unix(1100, 0),
+			expected: chanIDs[1:],
+		},
+		{
+			since:    time.Unix(1110, 0),
+			expected: chanIDs[2:],
+		},
+		{
+			since:    time.Unix(1310, 0),
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		updated, err := cdb.FetchRecentlyUpdatedChannels(test.since)
+		if err != nil {
+			t.Fatalf("unable to fetch updated channels: %v", err)
+		}
+		if !reflect.DeepEqual(updated, test.expected) {
+			t.Fatalf("since %v: expected channels %v, got %v",
+				test.since.Unix(), test.expected, updated)
+		}
+	}
+}
+
+// TestGraphCounts tests that the nodes and edges of the channel graph are
+// counted correctly, including the source node.
+func TestGraphCounts(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	assertCounts := func(expNodes, expEdges uint32) {
+		t.Helper()
+
+		numNodes, err := cdb.GraphNodeCount()
+		if err != nil {
+			t.Fatalf("unable to count nodes: %v", err)
+		}
+		if numNodes != expNodes {
+			t.Fatalf("expected %v nodes, got %v", expNodes,
+				numNodes)
+		}
+
+		numEdges, err := cdb.GraphEdgeCount()
+		if err != nil {
+			t.Fatalf("unable to count edges: %v", err)
+		}
+		if numEdges != expEdges {
+			t.Fatalf("expected %v edges, got %v", expEdges,
+				numEdges)
+		}
+	}
+
+	assertCounts(0, 0)
+
+	graph := cdb.ChannelGraph()
+	sourceNode, err := createTestVertex(cdb)
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+	if err := graph.SetSourceNode(sourceNode); err != nil {
+		t.Fatalf("unable to set source node: %v", err)
+	}
+	node, err := createTestVertex(cdb)
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+	if err := graph.AddLightningNode(node); err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+
+	assertCounts(2, 0)
+
+	for i := 0; i < 3; i++ {
+		channel, _ := createEdge(
+			uint32(i*10), 0, 0, 0, sourceNode, node,
+		)
+		if err := graph.AddChannelEdge(&channel); err != nil {
+			t.Fatalf("unable to create channel edge: %v", err)
+		}
+	}
+
+	assertCounts(2, 3)
+}
+
+// TestRepairZombieIndex tests that zombie entries of live edges and malformed
+// zombie entries are reported, and only removed once repaired.
+func TestRepairZombieIndex(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	graph := cdb.ChannelGraph()
+	node1, err := createTestVertex(cdb)
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+	node2, err := createTestVertex(cdb)
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+
+	// We'll create two edges, the first of which is pruned, making it a
+	// genuine zombie.
+	var chanIDs []uint64
+	for i := 0; i < 2; i++ {
+		channel, chanID := createEdge(
+			uint32(i*10), 0, 0, 0, node1, node2,
+		)
+		if err := graph.AddChannelEdge(&channel); err != nil {
+			t.Fatalf("unable to create channel edge: %v", err)
+		}
+		chanIDs = append(chanIDs, chanID.ToUint64())
+	}
+	if err := graph.DeleteChannelEdges(chanIDs[0]); err != nil {
+		t.Fatalf("unable to delete edge: %v", err)
+	}
+
+	// We'll then mark the live edge as a zombie, and add a malformed
+	// entry to the zombie index.
+	const malformedID = 1234
+	err = cdb.Update(func(tx *bbolt.Tx) error {
+		zombieIndex := tx.Bucket(edgeBucket).Bucket(zombieBucket)
+		err := markEdgeZombie(
+			zombieIndex, chanIDs[1], node1.PubKeyBytes,
+			node2.PubKeyBytes,
+		)
+		if err != nil {
+			return err
+		}
+
+		var k [8]byte
+		byteOrder.PutUint64(k[:], malformedID)
+		return zombieIndex.Put(k[:], []byte{1, 2, 3})
+	})
+	if err != nil {
+		t.Fatalf("unable to update zombie index: %v", err)
+	}
+
+	expected := &ZombieReport{
+		NumZombies:       3,
+		LiveZombies:      []uint64{chanIDs[1]},
+		MalformedZombies: []uint64{malformedID},
+	}
+
+	// Checking the index shouldn't modify it.
+	report, err := cdb.CheckZombieIndex()
+	if err != nil {
+		t.Fatalf("unable to check zombie index: %v", err)
+	}
+	if !reflect.DeepEqual(report, expected) {
+		t.Fatalf("expected report %v, got %v", spew.Sdump(expected),
+			spew.Sdump(report))
+	}
+	assertNumZombies(t, graph, 3)
+
+	expected.Repaired = true
+	report, err = cdb.RepairZombieIndex()
+	if err != nil {
+		t.Fatalf("unable to repair zombie index: %v", err)
+	}
+	if !reflect.DeepEqual(report, expected) {
+		t.Fatalf("expected report %v, got %v", spew.Sdump(expected),
+			spew.Sdump(report))
+	}
+
+	// Only the genuine zombie should remain.
+	assertNumZombies(t, graph, 1)
+	if isZombie, _, _ := graph.IsZombieEdge(chanIDs[0]); !isZombie {
+		t.Fatal("expected pruned edge to remain a zombie")
+	}
+	if isZombie, _, _ := graph.IsZombieEdge(chanIDs[1]); isZombie {
+		t.Fatal("expected live edge to no longer be a zombie")
+	}
+
+	report, err = cdb.CheckZombieIndex()
+	if err != nil {
+		t.Fatalf("unable to check zombie index: %v", err)
+	}
+	if len(report.LiveZombies) != 0 || len(report.MalformedZombies) != 0 {
+		t.Fatalf("expected no inconsistencies, got %v",
+			spew.Sdump(report))
+	}
+}
+
+// TestRestoreChannelShellsWithSource tests that channel shells can be
+// restored onto a fresh database without a source node, as long as our own
+// public key is provided.
+func TestRestoreChannelShellsWithSource(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	channelShell, err := genRandomChannelShell()
+	if err != nil {
+		t.Fatalf("unable to gen channel shell: %v", err)
+	}
+
+	// Without a source node, restoring the shell should fail, and leave
+	// no trace of the channel behind.
+	err = cdb.RestoreChannelShells(channelShell)
+	if err != ErrSourceNodeNotSet {
+		t.Fatalf("expected ErrSourceNodeNotSet, got: %v", err)
+	}
+	_, err = cdb.FetchChannel(channelShell.Chan.FundingOutpoint)
+	if err != ErrChannelNotFound {
+		t.Fatalf("expected ErrChannelNotFound, got: %v", err)
+	}
+
+	// If we provide our own public key however, a shell source node
+	// should be created for us.
+	selfPriv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	selfPub := selfPriv.PubKey()
+	err = cdb.RestoreChannelShellsWithSource(selfPub, channelShell)
+	if err != nil {
+		t.Fatalf("unable to restore channel shell: %v", err)
+	}
+
+	graph := cdb.ChannelGraph()
+	sourceNode, err := graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+	if !bytes.Equal(
+		sourceNode.PubKeyBytes[:], selfPub.SerializeCompressed(),
+	) {
+		t.Fatalf("wrong source node: expected %x, got %x",
+			selfPub.SerializeCompressed(), sourceNode.PubKeyBytes)
+	}
+
+	_, err = cdb.FetchChannel(channelShell.Chan.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to fetch channel: %v", err)
+	}
+	chanInfos, err := graph.FetchChanInfos(
+		[]uint64{channelShell.Chan.ShortChannelID.ToUint64()},
+	)
+	if err != nil {
+		t.Fatalf("unable to find edges: %v", err)
+	}
+	if len(chanInfos) != 1 {
+		t.Fatalf("wrong amount of chan infos: expected %v got %v",
+			1, len(chanInfos))
+	}
+}
+
+// TestRestoreChannelShell asserts that channel shells restored concurrently
+// one by one through a shared batch are all restored, along with their graph
+// state.
+func TestRestoreChannelShell(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	selfPriv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	selfPub := selfPriv.PubKey()
+
+	const numShells = 5
+	channelShells := make([]*ChannelShell, numShells)
+	for i := range channelShells {
+		channelShells[i], err = genRandomChannelShell()
+		if err != nil {
+			t.Fatalf("unable to gen channel shell: %v", err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, numShells)
+	for i, channelShell := range channelShells {
+		wg.Add(1)
+		go func(i int, channelShell *ChannelShell) {
+			defer wg.Done()
+			errs[i] = cdb.RestoreChannelShell(selfPub, channelShell)
+		}(i, channelShell)
+	}
+	wg.Wait()
+
+	chanIDs := make([]uint64, 0, numShells)
+	for i, channelShell := range channelShells {
+		if errs[i] != nil {
+			t.Fatalf("unable to restore channel shell: %v", errs[i])
+		}
+
+		chanPoint := channelShell.Chan.FundingOutpoint
+		channel, err := cdb.FetchChannel(chanPoint)
+		if err != nil {
+			t.Fatalf("unable to fetch channel: %v", err)
+		}
+		if !channel.HasChanStatus(ChanStatusRestored) {
+			t.Fatalf("expected channel to be marked restored")
+		}
+
+		chanIDs = append(
+			chanIDs, channelShell.Chan.ShortChannelID.ToUint64(),
+		)
+	}
+
+	chanInfos, err := cdb.ChannelGraph().FetchChanInfos(chanIDs)
+	if err != nil {
+		t.Fatalf("unable to find edges: %v", err)
+	}
+	if len(chanInfos) != numShells {
+		t.Fatalf("wrong amount of chan infos: expected %v got %v",
+			numShells, len(chanInfos))
+	}
+}
+
+// TestImportClosedChannel tests that close summaries can be imported into the
+// database, that the import is idempotent, and that invalid summaries are
+// rejected.
+func TestImportClosedChannel(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	_, remotePub := btcec.PrivKeyFromBytes(btcec.S256(), key[:])
+	summary := &ChannelCloseSummary{
+		ChanPoint: wire.OutPoint{
+			Hash:  rev,
+			Index: 1,
+		},
+		ChainHash:   key,
+		CloseHeight: 100,
+		RemotePub:   remotePub,
+		Capacity:    10000,
+		CloseType:   CooperativeClose,
+	}
+
+	// Summaries lacking a channel point or chain hash should be rejected.
+	invalid := *summary
+	invalid.ChanPoint = wire.OutPoint{}
+	if err := cdb.ImportClosedChannel(&invalid); err == nil {
+		t.Fatalf("expected summary without chan point to be rejected")
+	}
+	invalid = *summary
+	invalid.ChainHash = chainhash.Hash{}
+	if err := cdb.ImportClosedChannel(&invalid); err == nil {
+		t.Fatalf("expected summary without chain hash to be rejected")
+	}
+
+	if err := cdb.ImportClosedChannel(summary); err != nil {
+		t.Fatalf("unable to import summary: %v", err)
+	}
+
+	// Importing a conflicting summary for the same channel point should
+	// leave the original untouched.
+	conflicting := *summary
+	conflicting.CloseHeight = 200
+	if err := cdb.ImportClosedChannel(&conflicting); err != nil {
+		t.Fatalf("unable to re-import summary: %v", err)
+	}
+
+	closedChans, err := cdb.FetchClosedChannels(false)
+	if err != nil {
+		t.Fatalf("unable to fetch closed channels: %v", err)
+	}
+	if len(closedChans) != 1 {
+		t.Fatalf("expected 1 closed channel, got %v", len(closedChans))
+	}
+	if !reflect.DeepEqual(summary, closedChans[0]) {
+		t.Fatalf("summary mismatch: expected %v, got %v",
+			spew.Sdump(summary), spew.Sdump(closedChans[0]))
+	}
+}
+
+// TestOpenFreelistType asserts that the freelist type defaults to the hashmap
+// backend, and that it can be overridden both with and without freelist
+// syncing.
+func TestOpenFreelistType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		modifiers    []OptionModifier
+		freelistType bbolt.FreelistType
+	}{
+		{
+			name:         "default",
+			freelistType: bbolt.FreelistMapType,
+		},
+		{
+			name: "array no sync",
+			modifiers: []OptionModifier{
+				OptionSetFreelistType(bbolt.FreelistArrayType),
+			},
+			freelistType: bbolt.FreelistArrayType,
+		},
+		{
+			name: "array sync",
+			modifiers: []OptionModifier{
+				OptionSetFreelistType(bbolt.FreelistArrayType),
+				OptionSetSyncFreelist(true),
+			},
+			freelistType: bbolt.FreelistArrayType,
+		},
+	}
+
+	for _, test := range tests {
+		tempDirName, err := ioutil.TempDir("", "channeldb")
+		if err != nil {
+			t.Fatalf("unable to create temp dir: %v", err)
+		}
+		defer os.RemoveAll(tempDirName)
+
+		// We'll open the database twice to ensure the freelist can be
+		// reconstructed from an existing database.
+		for i := 0; i < 2; i++ {
+			cdb, err := Open(tempDirName, test.modifiers...)
+			if err != nil {
+				t.Fatalf("%v: unable to open channeldb: %v",
+					test.name, err)
+			}
+
+			if cdb.FreelistType != test.freelistType {
+				t.Fatalf("%v: expected freelist type %v, got %v",
+					test.name, test.freelistType,
+					cdb.FreelistType)
+			}
+
+			if _, err := createTestChannelState(cdb); err != nil {
+				t.Fatalf("%v: unable to create channel "+
+					"state: %v", test.name, err)
+			}
+			cdb.Close()
+		}
+	}
+}
+
+// TestOpenInitialMmapSize asserts that the initial mmap size is left to bolt
+// by default, and that it can be overridden when opening the database.
+func TestOpenInitialMmapSize(t *testing.T) {
+	t.Parallel()
+
+	const mmapSize = 16 * 1024 * 1024
+	tests := []struct {
+		name      string
+		modifiers []OptionModifier
+		mmapSize  int
+	}{
+		{
+			name:     "default",
+			mmapSize: 0,
+		},
+		{
+			name: "pre-sized",
+			modifiers: []OptionModifier{
+				OptionSetInitialMmapSize(mmapSize),
+			},
+			mmapSize: mmapSize,
+		},
+	}
+
+	for _, test := range tests {
+		tempDirName, err := ioutil.TempDir("", "channeldb")
+		if err != nil {
+			t.Fatalf("unable to create temp dir: %v", err)
+		}
+		defer os.RemoveAll(tempDirName)
+
+		// We'll open the database twice to ensure an existing database
+		// can be mapped with the initial size as well.
+		for i := 0; i < 2; i++ {
+			cdb, err := Open(tempDirName, test.modifiers...)
+			if err != nil {
+				t.Fatalf("%v: unable to open channeldb: %v",
+					test.name, err)
+			}
+
+			if cdb.boltOpts.InitialMmapSize != test.mmapSize {
+				t.Fatalf("%v: expected initial mmap size %v, "+
+					"got %v", test.name, test.mmapSize,
+					cdb.boltOpts.InitialMmapSize)
+			}
+
+			if _, err := createTestChannelState(cdb); err != nil {
+				t.Fatalf("%v: unable to create channel "+
+					"state: %v", test.name, err)
+			}
+			cdb.Close()
+		}
+	}
+}
+
+// TestOpenPageSize asserts that the page size is applied when creating a new
+// database, and ignored when opening an existing one.
+func TestOpenPageSize(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	// Page sizes that aren't a power of two should be rejected.
+	_, err = Open(tempDirName, OptionSetPageSize(5000))
+	if err == nil {
+		t.Fatal("expected invalid page size to be rejected")
+	}
+
+	const pageSize = 16 * 1024
+	for _, modifier := range []OptionModifier{
+		OptionSetPageSize(pageSize), OptionSetPageSize(pageSize / 2),
+	} {
+		cdb, err := Open(tempDirName, modifier)
+		if err != nil {
+			t.Fatalf("unable to open channeldb: %v", err)
+		}
+
+		if cdb.Info().PageSize != pageSize {
+			t.Fatalf("expected page size %v, got %v", pageSize,
+				cdb.Info().PageSize)
+		}
+		if cdb.boltOpts.PageSize != pageSize {
+			t.Fatalf("expected retained page size %v, got %v",
+				pageSize, cdb.boltOpts.PageSize)
+		}
+
+		if _, err := createTestChannelState(cdb); err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		cdb.Close()
+	}
+}
+
+// TestOpenWithBackend asserts that a channeldb can be opened on top of a bolt
+// database opened by the caller, and that it's initialized and usable.
+func TestOpenWithBackend(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	path := filepath.Join(tempDirName, dbName)
+	bdb, err := bbolt.Open(path, dbFilePermission, nil)
+	if err != nil {
+		t.Fatalf("unable to open bolt db: %v", err)
+	}
+
+	cdb, err := OpenWithBackend(bdb, tempDirName)
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+	if cdb.Path() != tempDirName {
+		t.Fatalf("expected path %v, got %v", tempDirName, cdb.Path())
+	}
+
+	meta, err := cdb.FetchMeta(nil)
+	if err != nil {
+		t.Fatalf("unable to fetch meta: %v", err)
+	}
+	latest := getLatestDBVersion(dbVersions)
+	if meta.DbVersionNumber != latest {
+		t.Fatalf("expected version %v, got %v", latest,
+			meta.DbVersionNumber)
+	}
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	if err := state.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+	cdb.Close()
+
+	// Closing the channeldb should have closed the bolt database, so we
+	// should be able to open it read-only, in which case the channeldb
+	// should be read-only as well.
+	bdb, err = bbolt.Open(path, dbFilePermission, &bbolt.Options{
+		ReadOnly: true,
+		Timeout:  time.Second,
+	})
+	if err != nil {
+		t.Fatalf("unable to open bolt db: %v", err)
+	}
+	cdb, err = OpenWithBackend(bdb, tempDirName)
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+	defer cdb.Close()
+
+	if !cdb.boltOpts.ReadOnly {
+		t.Fatal("expected channeldb to be read-only")
+	}
+	channels, err := cdb.FetchAllChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(channels) != 1 {
+		t.Fatalf("expected 1 channel, got %v", len(channels))
+	}
+	if cdb.boltOpts.PageSize != bdb.Info().PageSize {
+		t.Fatalf("expected page size %v, got %v",
+			bdb.Info().PageSize, cdb.boltOpts.PageSize)
+	}
+}
+
+// TestOpenPostOpenHook asserts that the post-open hook is handed a usable
+// database, and that Open fails and closes the database if the hook fails.
+func TestOpenPostOpenHook(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	// A failing hook should cause Open to fail.
+	hookErr := fmt.Errorf("hook failed")
+	_, err = Open(tempDirName, OptionSetPostOpenHook(func(*DB) error {
+		return hookErr
+	}))
+	if err == nil {
+		t.Fatal("expected failing hook to fail open")
+	}
+
+	// The database should've been closed, so re-opening it shouldn't time
+	// out waiting for the lock. Within the hook, the database should be
+	// at the latest version, and usable.
+	var hookCalled bool
+	cdb, err := Open(
+		tempDirName, OptionSetOpenTimeout(time.Second),
+		OptionSetPostOpenHook(func(d *DB) error {
+			hookCalled = true
+
+			meta, err := d.FetchMeta(nil)
+			if err != nil {
+				return err
+			}
+			latest := getLatestDBVersion(dbVersions)
+			if meta.DbVersionNumber != latest {
+				return fmt.Errorf("expected version %v, got %v",
+					latest, meta.DbVersionNumber)
+			}
+
+			_, err = createTestChannelState(d)
+			return err
+		}),
+	)
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+	defer cdb.Close()
+
+	if !hookCalled {
+		t.Fatal("expected post-open hook to be called")
+	}
+}
+
+// TestOpenReadOnly asserts that an existing database at the latest version can
+// be opened read-only, and that it's never written to.
+func TestOpenReadOnly(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	// A database that doesn't exist yet can't be opened read-only, nor
+	// should its directory be created.
+	dbPath := filepath.Join(tempDirName, "cdb")
+	_, err = Open(dbPath, OptionSetReadOnly(true))
+	if err != ErrNoChanDBExists {
+		t.Fatalf("expected ErrNoChanDBExists, got: %v", err)
+	}
+	if fileExists(dbPath) {
+		t.Fatalf("database directory shouldn't have been created")
+	}
+
+	cdb, err := Open(dbPath)
+	if err != nil {
+		t.Fatalf("unable to open database: %v", err)
+	}
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channel.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+	cdb.Close()
+
+	cdb, err = Open(dbPath, OptionSetReadOnly(true))
+	if err != nil {
+		t.Fatalf("unable to open database read-only: %v", err)
+	}
+
+	// The channel should be readable, while any writes should fail.
+	channels, err := cdb.FetchAllChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(channels) != 1 {
+		t.Fatalf("expected 1 channel, got %v", len(channels))
+	}
+	err = cdb.PutMetaField("test", []byte{1})
+	if err != bbolt.ErrDatabaseReadOnly {
+		t.Fatalf("expected ErrDatabaseReadOnly, got: %v", err)
+	}
+	cdb.Close()
+
+	// Finally, a database that isn't at the latest version can't be
+	// migrated, so it shouldn't be opened read-only.
+	cdb, err = Open(dbPath)
+	if err != nil {
+		t.Fatalf("unable to open database: %v", err)
+	}
+	latestVersion := getLatestDBVersion(dbVersions)
+	err = cdb.PutMeta(&Meta{DbVersionNumber: latestVersion - 1})
+	if err != nil {
+		t.Fatalf("unable to put meta: %v", err)
+	}
+	cdb.Close()
+
+	_, err = Open(dbPath, OptionSetReadOnly(true))
+	if err != ErrDBNeedsMigration {
+		t.Fatalf("expected ErrDBNeedsMigration, got: %v", err)
+	}
+}
+
+// syncedFreelist returns true if the most recent meta page of the bolt database
+// at the passed path references a freelist synced to disk.
+func syncedFreelist(t *testing.T, path string) bool {
+	t.Helper()
+
+	dbBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to read db file: %v", err)
+	}
+
+	// Each of the two meta pages starts with a 16 byte page header,
+	// followed by the magic, version, page size and flags, the root bucket,
+	// and finally the freelist page, high water mark and txid.
+	const (
+		pageSizeOffset = 16 + 8
+		freelistOffset = 16 + 16 + 16
+		txidOffset     = freelistOffset + 16
+		noFreelist     = 0xffffffffffffffff
+	)
+	pageSize := int(binary.LittleEndian.Uint32(dbBytes[pageSizeOffset:]))
+
+	var freelist, txid uint64
+	for _, meta := range [][]byte{dbBytes, dbBytes[pageSize:]} {
+		metaTxid := binary.LittleEndian.Uint64(meta[txidOffset:])
+		if metaTxid >= txid {
+			txid = metaTxid
+			freelist = binary.LittleEndian.Uint64(
+				meta[freelistOffset:],
+			)
+		}
+	}
+
+	return freelist != noFreelist
+}
+
+// TestSetFreelistSync asserts that syncing of the freelist can be toggled after
+// the database has been opened.
+func TestSetFreelistSync(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	dbPath := filepath.Join(cdb.dbPath, dbName)
+	for _, enabled := range []bool{true, false, true} {
+		if err := cdb.SetFreelistSync(enabled); err != nil {
+			t.Fatalf("unable to set freelist sync: %v", err)
+		}
+		if cdb.NoFreelistSync == enabled {
+			t.Fatalf("expected freelist sync to be %v", enabled)
+		}
+
+		// The next commit should reflect whether the freelist is
+		// synced.
+		if err := cdb.PutMetaField("test", []byte{1}); err != nil {
+			t.Fatalf("unable to put meta field: %v", err)
+		}
+		if syncedFreelist(t, dbPath) != enabled {
+			t.Fatalf("expected synced freelist to be %v", enabled)
+		}
+	}
+}
+
+// TestFlush asserts that flushing the database writes out the freelist even
+// while freelist syncing is disabled, without changing the setting itself.
+func TestFlush(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	if err := cdb.SetFreelistSync(false); err != nil {
+		t.Fatalf("unable to set freelist sync: %v", err)
+	}
+	if err := cdb.PutMetaField("test", []byte{1}); err != nil {
+		t.Fatalf("unable to put meta field: %v", err)
+	}
+
+	dbPath := filepath.Join(cdb.dbPath, dbName)
+	if syncedFreelist(t, dbPath) {
+		t.Fatalf("expected freelist not to be synced")
+	}
+
+	if err := cdb.Flush(); err != nil {
+		t.Fatalf("unable to flush database: %v", err)
+	}
+	if !syncedFreelist(t, dbPath) {
+		t.Fatalf("expected freelist to be synced")
+	}
+	if !cdb.NoFreelistSync {
+		t.Fatalf("expected freelist sync to remain disabled")
+	}
+
+	// Subsequent commits shouldn't sync the freelist.
+	if err := cdb.PutMetaField("test", []byte{2}); err != nil {
+		t.Fatalf("unable to put meta field: %v", err)
+	}
+	if syncedFreelist(t, dbPath) {
+		t.Fatalf("expected freelist not to be synced")
+	}
+}
+
+// TestForEachChannelPeer asserts that each remote peer we have an open channel
+// with is iterated over exactly once.
+func TestForEachChannelPeer(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	addChannel := func(peer *btcec.PublicKey) *OpenChannel {
+		t.Helper()
+
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		channel.IdentityPub = peer
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+		return channel
+	}
+
+	// We'll create two channels with the first peer, and a single one
+	// with the second peer. The channel with the third peer is closed.
+	var peers []*btcec.PublicKey
+	for i := 0; i < 3; i++ {
+		priv, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatalf("unable to generate private key: %v", err)
+		}
+		peers = append(peers, priv.PubKey())
+	}
+	addChannel(peers[0])
+	addChannel(peers[0])
+	addChannel(peers[1])
+	closedChan := addChannel(peers[2])
+	err = closedChan.CloseChannel(&ChannelCloseSummary{
+		ChanPoint: closedChan.FundingOutpoint,
+		RemotePub: closedChan.IdentityPub,
+	})
+	if err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+
+	seen := make(map[[33]byte]int)
+	err = cdb.ForEachChannelPeer(func(nodePub *btcec.PublicKey) error {
+		var peer [33]byte
+		copy(peer[:], nodePub.SerializeCompressed())
+		seen[peer]++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to iterate channel peers: %v", err)
+	}
+
+	if len(seen) != 2 {
+		t.Fatalf("expected 2 channel peers, got %d", len(seen))
+	}
+	for _, peer := range peers[:2] {
+		var peerKey [33]byte
+		copy(peerKey[:], peer.SerializeCompressed())
+		if seen[peerKey] != 1 {
+			t.Fatalf("expected peer %x to be seen once, got %d",
+				peerKey, seen[peerKey])
+		}
+	}
+
+	// An error returned by the callback should halt the iteration.
+	var numCalls int
+	errHalt := fmt.Errorf("halt")
+	err = cdb.ForEachChannelPeer(func(*btcec.PublicKey) error {
+		numCalls++
+		return errHalt
+	})
+	if err != errHalt {
+		t.Fatalf("expected errHalt, got: %v", err)
+	}
+	if numCalls != 1 {
+		t.Fatalf("expected a single callback, got %d", numCalls)
+	}
+}
+
+// TestHasOpenChannelsWith asserts that we can determine whether we have any
+// open channels with a peer, across their pending, open and closed states.
+func TestHasOpenChannelsWith(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	assertHasChannels := func(expected bool) {
+		t.Helper()
+
+		hasChannels, err := cdb.HasOpenChannelsWith(channel.IdentityPub)
+		if err != nil {
+			t.Fatalf("unable to check open channels: %v", err)
+		}
+		if hasChannels != expected {
+			t.Fatalf("expected open channels to be %v, got %v",
+				expected, hasChannels)
+		}
+	}
+
+	// Before the channel is synced, we shouldn't have any channels with
+	// the peer.
+	assertHasChannels(false)
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channel.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+	assertHasChannels(true)
+
+	// Once the channel is closed, the peer's bucket remains, but it should
+	// no longer hold any channels.
+	err = channel.CloseChannel(&ChannelCloseSummary{
+		ChanPoint: channel.FundingOutpoint,
+		RemotePub: channel.IdentityPub,
+	})
+	if err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+	assertHasChannels(false)
+}
+
+// TestFetchOpenChannelsForPeerOnChain asserts that only the channels of the
+// target peer on the target chain are returned.
+func TestFetchOpenChannelsForPeerOnChain(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+
+	// We'll create a channel with the peer on the main chain, and another
+	// on a different chain.
+	var otherChain chainhash.Hash
+	otherChain[0] = 1
+	var channels []*OpenChannel
+	for i := 0; i < 2; i++ {
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if i == 1 {
+			channel.ChainHash = otherChain
+		}
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+
+		channels = append(channels, channel)
+	}
+
+	peer := channels[0].IdentityPub
+	for _, channel := range channels {
+		dbChannels, err := cdb.FetchOpenChannelsForPeerOnChain(
+			peer, channel.ChainHash,
+		)
+		if err != nil {
+			t.Fatalf("unable to fetch channels: %v", err)
+		}
+		if len(dbChannels) != 1 {
+			t.Fatalf("expected 1 channel, got %v", len(dbChannels))
+		}
+		if dbChannels[0].FundingOutpoint != channel.FundingOutpoint {
+			t.Fatalf("expected channel %v, got %v",
+				channel.FundingOutpoint,
+				dbChannels[0].FundingOutpoint)
+		}
+	}
+
+	// An unknown chain should yield no channels.
+	var unknownChain chainhash.Hash
+	unknownChain[0] = 2
+	dbChannels, err := cdb.FetchOpenChannelsForPeerOnChain(
+		peer, unknownChain,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(dbChannels) != 0 {
+		t.Fatalf("expected no channels, got %v", len(dbChannels))
+	}
+}
+
+// TestRetryUpdate asserts that transactions failing due to transient errors
+// are retried up to the configured number of times, while those failing due to
+// other errors aren't.
+func TestRetryUpdate(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	cdb.updateMaxRetries = 2
+	cdb.updateRetryBackoff = time.Millisecond
+
+	transientErr := &os.PathError{Op: "write", Err: syscall.EIO}
+	permanentErr := fmt.Errorf("unable to decode")
+
+	tests := []struct {
+		name string
+
+		// errs are the errors returned by each consecutive attempt,
+		// with attempts beyond these succeeding.
+		errs []error
+
+		expErr      error
+		expAttempts int
+	}{
+		{
+			name:        "no error",
+			expAttempts: 1,
+		},
+		{
+			name:        "transient error",
+			errs:        []error{transientErr, transientErr},
+			expAttempts: 3,
+		},
+		{
+			name: "retries exhausted",
+			errs: []error{
+				transientErr, transientErr, transientErr,
+			},
+			expErr:      transientErr,
+			expAttempts: 3,
+		},
+		{
+			name:        "permanent error",
+			errs:        []error{permanentErr},
+			expErr:      permanentErr,
+			expAttempts: 1,
+		},
+	}
+
+	for _, test := range tests {
+		var attempts int
+		err := cdb.retryUpdate(func(tx *bbolt.Tx) error {
+			attempts++
+			if attempts <= len(test.errs) {
+				return test.errs[attempts-1]
+			}
+			return nil
+		})
+		if err != test.expErr {
+			t.Fatalf("%v: expected error %v, got %v", test.name,
+				test.expErr, err)
+		}
+		if attempts != test.expAttempts {
+			t.Fatalf("%v: expected %v attempts, got %v", test.name,
+				test.expAttempts, attempts)
+		}
+	}
+}
+
+// TestMarkChannelOpen asserts that a pending channel can be marked open through
+// the DB, and that doing so again or for an unknown channel fails.
+func TestMarkChannelOpen(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channel.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+
+	chanPoint := channel.FundingOutpoint
+	shortChanID := lnwire.NewShortChanIDFromInt(1234)
+	if err := cdb.MarkChannelOpen(chanPoint, shortChanID); err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+
+	dbChannel, err := cdb.FetchChannel(chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch channel: %v", err)
+	}
+	if dbChannel.IsPending {
+		t.Fatalf("expected channel to no longer be pending")
+	}
+	if dbChannel.ShortChannelID != shortChanID {
+		t.Fatalf("expected short chan id %v, got %v", shortChanID,
+			dbChannel.ShortChannelID)
+	}
+
+	// The channel should now be returned amongst the open channels.
+	openChans, err := cdb.FetchAllOpenChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch open channels: %v", err)
+	}
+	if len(openChans) != 1 {
+		t.Fatalf("expected 1 open channel, got %v", len(openChans))
+	}
+
+	err = cdb.MarkChannelOpen(chanPoint, shortChanID)
+	if err != ErrChannelAlreadyOpen {
+		t.Fatalf("expected ErrChannelAlreadyOpen, got: %v", err)
+	}
+
+	unknownPoint := chanPoint
+	unknownPoint.Index ^= 1
+	err = cdb.MarkChannelOpen(unknownPoint, shortChanID)
+	if err != ErrChannelNotFound {
+		t.Fatalf("expected ErrChannelNotFound, got: %v", err)
+	}
+}
+
+// TestFetchChannelCommitHeights asserts that the commitment heights and
+// revocation counter of each open channel are read from the database.
+func TestFetchChannelCommitHeights(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	var channels []*OpenChannel
+	for i := 0; i < 2; i++ {
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+
+		channels = append(channels, channel)
+	}
+
+	assertHeights := func(expected map[wire.OutPoint]CommitHeights) {
+		t.Helper()
+
+		heights, err := cdb.FetchChannelCommitHeights()
+		if err != nil {
+			t.Fatalf("unable to fetch commit heights: %v", err)
+		}
+		if !reflect.DeepEqual(heights, expected) {
+			t.Fatalf("expected commit heights %v, got %v",
+				spew.Sdump(expected), spew.Sdump(heights))
+		}
+	}
+
+	assertHeights(map[wire.OutPoint]CommitHeights{
+		channels[0].FundingOutpoint: {},
+		channels[1].FundingOutpoint: {},
+	})
+
+	// We'll now advance both commitments of the first channel, revoking
+	// the prior remote commitment in the process.
+	channel := channels[0]
+	localCommit := channel.LocalCommitment
+	localCommit.CommitHeight = 2
+	if err := channel.UpdateCommitment(&localCommit); err != nil {
+		t.Fatalf("unable to update commitment: %v", err)
+	}
+
+	remoteCommit := channel.RemoteCommitment
+	remoteCommit.CommitHeight = 1
+	commitDiff := &CommitDiff{
+		Commitment: remoteCommit,
+		CommitSig: &lnwire.CommitSig{
+			ChanID:    lnwire.ChannelID(key),
+			CommitSig: wireSig,
+		},
+	}
+	if err := channel.AppendRemoteCommitChain(commitDiff); err != nil {
+		t.Fatalf("unable to add to commit chain: %v", err)
+	}
+	fwdPkg := NewFwdPkg(channel.ShortChanID(), 0, nil, nil)
+	if err := channel.AdvanceCommitChainTail(fwdPkg); err != nil {
+		t.Fatalf("unable to append to revocation log: %v", err)
+	}
+
+	assertHeights(map[wire.OutPoint]CommitHeights{
+		channels[0].FundingOutpoint: {
+			LocalCommitHeight:  2,
+			RemoteCommitHeight: 1,
+			RevocationCounter:  1,
+		},
+		channels[1].FundingOutpoint: {},
+	})
+}
+
+// TestFetchChannelsWithPendingHtlcs asserts that only the channels with a
+// pending HTLC on either of their commitments are returned.
+func TestFetchChannelsWithPendingHtlcs(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	channels, err := cdb.FetchChannelsWithPendingHtlcs()
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(channels) != 0 {
+		t.Fatalf("expected no channels, got %v", len(channels))
+	}
+
+	htlc := HTLC{
+		Signature:     testSig.Serialize(),
+		Amt:           10,
+		RHash:         key,
+		RefundTimeout: 1,
+		OnionBlob:     []byte("onionblob"),
+	}
+
+	// We'll create three channels: the first with an HTLC on its local
+	// commitment, the second with one on its remote commitment, and the
+	// third without any HTLCs.
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	expected := make(map[wire.OutPoint]struct{})
+	for i := 0; i < 3; i++ {
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		switch i {
+		case 0:
+			channel.LocalCommitment.Htlcs = []HTLC{htlc}
+			expected[channel.FundingOutpoint] = struct{}{}
+
+		case 1:
+			channel.RemoteCommitment.Htlcs = []HTLC{htlc}
+			expected[channel.FundingOutpoint] = struct{}{}
+		}
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+	}
+
+	channels, err = cdb.FetchChannelsWithPendingHtlcs()
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	found := make(map[wire.OutPoint]struct{})
+	for _, channel := range channels {
+		numHtlcs := len(channel.LocalCommitment.Htlcs) +
+			len(channel.RemoteCommitment.Htlcs)
+		if numHtlcs != 1 {
+			t.Fatalf("expected 1 htlc, got %v", numHtlcs)
+		}
+		found[channel.FundingOutpoint] = struct{}{}
+	}
+	if !reflect.DeepEqual(found, expected) {
+		t.Fatalf("expected channels %v, got %v", expected, found)
+	}
+}
+
+// TestChannelStateFingerprint asserts that the fingerprint of the channel set
+// is deterministic, and that it changes with the set of open channels and
+// their commitment heights.
+func TestChannelStateFingerprint(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	fingerprint := func() []byte {
+		t.Helper()
+
+		fp, err := cdb.ChannelStateFingerprint()
+		if err != nil {
+			t.Fatalf("unable to compute fingerprint: %v", err)
+		}
+		return fp
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	addChannel := func() *OpenChannel {
+		t.Helper()
+
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+		return channel
+	}
+
+	emptyFingerprint := fingerprint()
+
+	addChannel()
+	fp1 := fingerprint()
+	if bytes.Equal(fp1, emptyFingerprint) {
+		t.Fatalf("expected fingerprint to change after adding channel")
+	}
+	if !bytes.Equal(fp1, fingerprint()) {
+		t.Fatalf("expected fingerprint to be deterministic")
+	}
+
+	// Adding, then closing, a second channel should restore the prior
+	// fingerprint.
+	channel := addChannel()
+	if bytes.Equal(fp1, fingerprint()) {
+		t.Fatalf("expected fingerprint to change after adding channel")
+	}
+	err = channel.CloseChannel(&ChannelCloseSummary{
+		ChanPoint: channel.FundingOutpoint,
+		RemotePub: channel.IdentityPub,
+	})
+	if err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+	if !bytes.Equal(fp1, fingerprint()) {
+		t.Fatalf("expected fingerprint to be restored after close")
+	}
+
+	// Finally, advancing the height of a commitment should change the
+	// fingerprint.
+	channel = addChannel()
+	fp2 := fingerprint()
+	commitment := channel.LocalCommitment
+	commitment.CommitHeight++
+	if err := channel.UpdateCommitment(&commitment); err != nil {
+		t.Fatalf("unable to update commitment: %v", err)
+	}
+	if bytes.Equal(fp2, fingerprint()) {
+		t.Fatalf("expected fingerprint to change after updating " +
+			"commitment")
+	}
+}
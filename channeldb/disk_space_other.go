@@ -0,0 +1,10 @@
+// +build !darwin,!freebsd,!linux
+
+package channeldb
+
+// freeDiskSpace returns the number of bytes available to unprivileged users on
+// the filesystem the passed path resides on. It isn't supported on this
+// platform, so errDiskSpaceUnsupported is always returned.
+func freeDiskSpace(path string) (uint64, error) {
+	return 0, errDiskSpaceUnsupported
+}
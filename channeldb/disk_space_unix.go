@@ -0,0 +1,16 @@
+// +build darwin freebsd linux
+
+package channeldb
+
+import "syscall"
+
+// freeDiskSpace returns the number of bytes available to unprivileged users on
+// the filesystem the passed path resides on.
+func freeDiskSpace(path string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
@@ -3,6 +3,8 @@ package channeldb
 import (
 	"errors"
 	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
 )
 
 var (
@@ -10,10 +12,58 @@ var (
 	// created.
 	ErrNoChanDBExists = fmt.Errorf("channel db has not yet been created")
 
+	// The following errors describe the on-disk version of the database
+	// relative to the latest version known to this code, allowing callers
+	// to tell an uninitialized database, one that needs a migration, and
+	// one written by a future version apart. They're returned as is, so
+	// they can be compared against directly.
+
+	// ErrMetaNotFound is returned when meta bucket hasn't been
+	// created.
+	ErrMetaNotFound = fmt.Errorf("unable to locate meta information")
+
+	// ErrDBNeedsMigration is returned when the database is opened
+	// read-only, but its on-disk version is behind the latest version,
+	// meaning migrations would need to be applied before it can be used.
+	ErrDBNeedsMigration = fmt.Errorf("channel db requires migration to " +
+		"latest version")
+
 	// ErrDBReversion is returned when detecting an attempt to revert to a
 	// prior database version.
 	ErrDBReversion = fmt.Errorf("channel db cannot revert to prior version")
 
+	// ErrDowngradeNotAllowed is returned when a downgrade of the database
+	// is requested, but the database wasn't opened with downgrades
+	// enabled.
+	ErrDowngradeNotAllowed = fmt.Errorf("channel db downgrades are not " +
+		"enabled")
+
+	// ErrDatabaseLocked is returned when the database file is locked by
+	// another process and the configured open timeout elapsed before the
+	// lock could be acquired.
+	ErrDatabaseLocked = fmt.Errorf("channel db is locked by another " +
+		"process")
+
+	// ErrSetDBVersionUnforced is returned when attempting to overwrite the
+	// version of the database without forcing the operation.
+	ErrSetDBVersionUnforced = fmt.Errorf("refusing to set db version " +
+		"without force")
+
+	// ErrMigrationPending is returned when an operation requires the
+	// database to be at the latest version, but a migration is pending.
+	ErrMigrationPending = fmt.Errorf("channel db has a pending migration")
+
+	// ErrMigrationTimeout is returned when the migrations applied while
+	// opening the database didn't complete in time, in which case they've
+	// been rolled back.
+	ErrMigrationTimeout = fmt.Errorf("channel db migration timed out")
+
+	// ErrInsufficientDiskSpace is returned when there isn't enough free
+	// space on the filesystem of the database to safely apply the pending
+	// migrations, as configured with OptionSetMigrationDiskCheck.
+	ErrInsufficientDiskSpace = fmt.Errorf("insufficient free disk space " +
+		"to migrate channel db")
+
 	// ErrLinkNodesNotFound is returned when node info bucket hasn't been
 	// created.
 	ErrLinkNodesNotFound = fmt.Errorf("no link nodes exist")
@@ -50,9 +100,13 @@ var (
 	// for a specific chain, but it is not found.
 	ErrChannelNotFound = fmt.Errorf("channel not found")
 
-	// ErrMetaNotFound is returned when meta bucket hasn't been
-	// created.
-	ErrMetaNotFound = fmt.Errorf("unable to locate meta information")
+	// ErrMetaFieldNotFound is returned when the targeted metadata field
+	// hasn't been written to the meta bucket.
+	ErrMetaFieldNotFound = fmt.Errorf("meta field not found")
+
+	// ErrMetaFieldReserved is returned when attempting to write a metadata
+	// field under a key that is reserved for use by the database itself.
+	ErrMetaFieldReserved = fmt.Errorf("meta field key is reserved")
 
 	// ErrGraphNotFound is returned when at least one of the components of
 	// graph doesn't exist.
@@ -111,10 +165,46 @@ var (
 	ErrEdgePolicyOptionalFieldNotFound = fmt.Errorf("optional field not " +
 		"present")
 
+	// ErrArchivedChannelNotFound is returned when no snapshot of the final
+	// state of a closed channel has been archived.
+	ErrArchivedChannelNotFound = fmt.Errorf("archived channel not found")
+
+	// ErrChannelAlreadyOpen is returned when attempting to mark a channel
+	// as open that is no longer pending.
+	ErrChannelAlreadyOpen = fmt.Errorf("channel is already open")
+
+	// ErrDBNotEmpty is returned when attempting to import records into a
+	// database that already contains records of its own.
+	ErrDBNotEmpty = fmt.Errorf("channel db is not empty")
+
+	// ErrChannelAliasNotFound is returned when no channel has been
+	// assigned the target alias.
+	ErrChannelAliasNotFound = fmt.Errorf("channel alias not found")
+
+	// ErrChannelAliasInUse is returned when attempting to assign an alias
+	// to a channel that is already assigned to another channel.
+	ErrChannelAliasInUse = fmt.Errorf("channel alias already in use")
+
+	// ErrChannelAliasTooLong is returned when attempting to assign an
+	// alias to a channel that exceeds MaxChannelAliasLength.
+	ErrChannelAliasTooLong = fmt.Errorf("channel alias too long")
+
 	// ErrChanAlreadyExists is return when the caller attempts to create a
 	// channel with a channel point that is already present in the
 	// database.
 	ErrChanAlreadyExists = fmt.Errorf("channel already exists")
+
+	// ErrUnresolvedOutputNotFound is returned when attempting to mark an
+	// output of a channel as resolved that isn't tracked as unresolved.
+	ErrUnresolvedOutputNotFound = fmt.Errorf("unresolved output not found")
+
+	// ErrChanOutputsUnresolved is returned when attempting to mark a
+	// channel as fully closed while it still has unresolved outputs.
+	ErrChanOutputsUnresolved = fmt.Errorf("channel has unresolved outputs")
+
+	// ErrNotForceClosed is returned when the force close context of a
+	// channel is requested, but the channel wasn't force closed.
+	ErrNotForceClosed = fmt.Errorf("channel was not force closed")
 )
 
 // ErrTooManyExtraOpaqueBytes creates an error which should be returned if the
@@ -126,3 +216,41 @@ func ErrTooManyExtraOpaqueBytes(numBytes int) error {
 	return fmt.Errorf("max allowed number of opaque bytes is %v, received "+
 		"%v bytes", MaxAllowedExtraOpaqueBytes, numBytes)
 }
+
+// ErrAbandonNonDefaultChannel is returned when the caller attempts to abandon
+// a channel that isn't in the ChanStatusDefault state without forcing the
+// operation. Such a channel may, for instance, be waiting for a force close
+// to confirm, in which case abandoning it would destroy the state needed to
+// recover the funds.
+type ErrAbandonNonDefaultChannel struct {
+	// ChanPoint is the channel point of the channel that was attempted
+	// to be abandoned.
+	ChanPoint wire.OutPoint
+
+	// Status is the status of the channel at the time of the attempt.
+	Status ChannelStatus
+}
+
+// Error returns a human readable description of the error.
+func (e ErrAbandonNonDefaultChannel) Error() string {
+	return fmt.Sprintf("refusing to abandon channel %v with status %v",
+		e.ChanPoint, e.Status)
+}
+
+// MigrationError is returned by Open when one of the pending migrations fails
+// to be applied. All of the migrations are rolled back, leaving the database
+// at its prior version.
+type MigrationError struct {
+	// Results are the results of the migrations that were attempted, up
+	// to and including the failed one, in the order they were applied.
+	Results []MigrationResult
+
+	// Err is the error the failed migration returned, or
+	// ErrMigrationTimeout if the migrations didn't complete in time.
+	Err error
+}
+
+// Error returns a human readable description of the error.
+func (e MigrationError) Error() string {
+	return fmt.Sprintf("unable to apply migrations: %v", e.Err)
+}
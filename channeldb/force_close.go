@@ -0,0 +1,109 @@
+package channeldb
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+// ForceCloseContext consolidates all state retained for a force closed
+// channel, as needed to reconstruct the on-chain resolution of the channel,
+// e.g. by a manual recovery tool.
+type ForceCloseContext struct {
+	// Summary is the close summary of the channel.
+	Summary *ChannelCloseSummary
+
+	// UnresolvedOutputs are the outputs of the channel that are still
+	// pending a sweep, see AddUnresolvedOutputs.
+	UnresolvedOutputs []wire.OutPoint
+
+	// Archived is the trimmed snapshot of the final state of the channel.
+	// It's only set if the channel was closed while archival of closed
+	// channels was enabled.
+	Archived *ArchivedChannel
+
+	// Channel is the complete state of the channel at the time it was
+	// closed. It's only set if the channel was closed while historical
+	// channels were retained.
+	//
+	// NOTE: The channel is detached from the set of open channels, so it
+	// must only be used to read its state.
+	Channel *OpenChannel
+
+	// CommitTx is the commitment transaction we broadcast to force close
+	// the channel. It's only set if we force closed the channel while
+	// historical channels were retained.
+	CommitTx *wire.MsgTx
+}
+
+// FetchForceCloseContext gathers the close summary of the force closed channel
+// identified by chanPoint, along with any other state retained for it, within
+// a single read-only transaction. If the channel was closed by other means,
+// then ErrNotForceClosed is returned.
+func (d *DB) FetchForceCloseContext(chanPoint wire.OutPoint) (
+	*ForceCloseContext, error) {
+
+	var closeCtx *ForceCloseContext
+	err := d.View(func(tx *bbolt.Tx) error {
+		summary, err := FetchClosedChannelTx(tx, &chanPoint)
+		if err != nil {
+			return err
+		}
+
+		switch summary.CloseType {
+		case LocalForceClose, RemoteForceClose, BreachClose:
+		default:
+			return ErrNotForceClosed
+		}
+
+		closeCtx = &ForceCloseContext{
+			Summary: summary,
+		}
+
+		closeCtx.UnresolvedOutputs, err = fetchUnresolvedOutputs(
+			tx, &chanPoint,
+		)
+		if err != nil {
+			return err
+		}
+
+		archived, err := fetchArchivedChannel(tx, &chanPoint)
+		switch {
+		case err == nil:
+			closeCtx.Archived = archived
+
+		case err != ErrArchivedChannelNotFound:
+			return err
+		}
+
+		chanBucket, err := fetchHistoricalChanBucket(tx, &chanPoint)
+		switch {
+		case err == ErrChannelNotFound:
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		closeCtx.Channel, err = fetchOpenChannel(chanBucket, &chanPoint)
+		if err != nil {
+			return err
+		}
+		closeCtx.Channel.Db = d
+
+		closeTxBytes := chanBucket.Get(closingTxKey)
+		if closeTxBytes == nil {
+			return nil
+		}
+
+		return ReadElement(
+			bytes.NewReader(closeTxBytes), &closeCtx.CommitTx,
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return closeCtx, nil
+}
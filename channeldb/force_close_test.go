@@ -0,0 +1,130 @@
+package channeldb
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// TestFetchForceCloseContext asserts that the force close context of a channel
+// consolidates all of the state retained for it, and that it's only available
+// for force closed channels.
+func TestFetchForceCloseContext(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+
+	// closeChannel creates a new channel, which is closed once the passed
+	// closure has been applied to it.
+	closeChannel := func(closeType ClosureType,
+		f func(*OpenChannel)) *OpenChannel {
+
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+		f(channel)
+
+		err = channel.CloseChannel(&ChannelCloseSummary{
+			ChanPoint: channel.FundingOutpoint,
+			RemotePub: channel.IdentityPub,
+			CloseType: closeType,
+			IsPending: true,
+		})
+		if err != nil {
+			t.Fatalf("unable to close channel: %v", err)
+		}
+
+		return channel
+	}
+
+	_, err = cdb.FetchForceCloseContext(wire.OutPoint{Index: 1})
+	if err != ErrClosedChannelNotFound {
+		t.Fatalf("expected ErrClosedChannelNotFound, got: %v", err)
+	}
+
+	coopClosed := closeChannel(CooperativeClose, func(*OpenChannel) {})
+	_, err = cdb.FetchForceCloseContext(coopClosed.FundingOutpoint)
+	if err != ErrNotForceClosed {
+		t.Fatalf("expected ErrNotForceClosed, got: %v", err)
+	}
+
+	// Without any state retained, only the summary should be found.
+	remoteClosed := closeChannel(RemoteForceClose, func(*OpenChannel) {})
+	closeCtx, err := cdb.FetchForceCloseContext(
+		remoteClosed.FundingOutpoint,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch force close context: %v", err)
+	}
+	if closeCtx.Summary.CloseType != RemoteForceClose {
+		t.Fatalf("expected remote force close, got %v",
+			closeCtx.Summary.CloseType)
+	}
+	if closeCtx.UnresolvedOutputs != nil || closeCtx.Archived != nil ||
+		closeCtx.Channel != nil || closeCtx.CommitTx != nil {
+
+		t.Fatalf("expected only the summary, got %v", closeCtx)
+	}
+
+	// Once all state is retained, it should be part of the context.
+	cdb.archiveClosedChannels = true
+	cdb.keepHistoricalChannels = true
+
+	output := wire.OutPoint{Index: 2}
+	localClosed := closeChannel(LocalForceClose, func(c *OpenChannel) {
+		if err := c.MarkCommitmentBroadcasted(testTx); err != nil {
+			t.Fatalf("unable to mark commitment broadcast: %v",
+				err)
+		}
+	})
+	err = cdb.AddUnresolvedOutputs(localClosed.FundingOutpoint, output)
+	if err != nil {
+		t.Fatalf("unable to add unresolved outputs: %v", err)
+	}
+
+	closeCtx, err = cdb.FetchForceCloseContext(localClosed.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to fetch force close context: %v", err)
+	}
+	if closeCtx.Summary.CloseType != LocalForceClose {
+		t.Fatalf("expected local force close, got %v",
+			closeCtx.Summary.CloseType)
+	}
+	if !reflect.DeepEqual(closeCtx.UnresolvedOutputs, []wire.OutPoint{
+		output,
+	}) {
+		t.Fatalf("unexpected unresolved outputs: %v",
+			closeCtx.UnresolvedOutputs)
+	}
+	chanPoint := localClosed.FundingOutpoint
+	if closeCtx.Archived == nil ||
+		closeCtx.Archived.ChanPoint != chanPoint {
+
+		t.Fatalf("unexpected archived channel: %v", closeCtx.Archived)
+	}
+	if closeCtx.Channel == nil ||
+		closeCtx.Channel.FundingOutpoint != chanPoint {
+
+		t.Fatalf("unexpected historical channel: %v", closeCtx.Channel)
+	}
+	if closeCtx.CommitTx == nil ||
+		closeCtx.CommitTx.TxHash() != testTx.TxHash() {
+
+		t.Fatalf("unexpected commitment tx: %v", closeCtx.CommitTx)
+	}
+}
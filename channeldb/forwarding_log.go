@@ -36,6 +36,10 @@ const (
 	// full forwarding event (including the timestamp) is 40 bytes, we can
 	// safely return 50k entries in a single response.
 	MaxResponseEvents = 50000
+
+	// avgBlockInterval is the average time between two blocks, used to
+	// convert a window of time into an approximate number of blocks.
+	avgBlockInterval = 10 * time.Minute
 )
 
 // ForwardingLog returns an instance of the ForwardingLog object backed by the
@@ -272,3 +276,175 @@ func (f *ForwardingLog) Query(q ForwardingEventQuery) (ForwardingLogTimeSlice, e
 
 	return resp, nil
 }
+
+// PruneForwardingLog deletes all forwarding events with a timestamp strictly
+// before the passed cutoff from the forwarding log, returning the number of
+// events that were removed. As the deletion is carried out within a single
+// write transaction, it's serialized with any concurrent additions to the
+// log, ensuring that no events are removed while being written.
+func (d *DB) PruneForwardingLog(before time.Time) (uint64, error) {
+	// The log is keyed by the unsigned nano second timestamp of each
+	// event, so there can't be any events prior to the unix epoch.
+	if before.UnixNano() <= 0 {
+		return 0, nil
+	}
+
+	var cutoff [8]byte
+	byteOrder.PutUint64(cutoff[:], uint64(before.UnixNano()))
+
+	var numPruned uint64
+	err := d.Update(func(tx *bbolt.Tx) error {
+		numPruned = 0
+
+		logBucket := tx.Bucket(forwardingLogBucket)
+		if logBucket == nil {
+			return nil
+		}
+
+		// We'll first collect the keys of all entries prior to the
+		// cutoff, as deleting entries while moving the cursor forward
+		// may cause entries to be skipped.
+		var staleKeys [][]byte
+		logCursor := logBucket.Cursor()
+		timestamp, events := logCursor.First()
+		for timestamp != nil && bytes.Compare(timestamp, cutoff[:]) < 0 {
+			staleKeys = append(staleKeys, copySlice(timestamp))
+			numPruned += uint64(len(events) / forwardingEventSize)
+
+			timestamp, events = logCursor.Next()
+		}
+
+		for _, key := range staleKeys {
+			if err := logBucket.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numPruned, nil
+}
+
+// FetchIdleChannels returns all open channels, as returned by
+// FetchAllOpenChannels, that haven't been used as either the incoming or
+// outgoing channel of any forwarding event within the passed window leading up
+// to the current time. Such channels are candidates to be closed in favor of
+// channels that are more likely to be used. The forwarding log and the open
+// channels are read within a single transaction, so no event can be missed.
+//
+// Channels that confirmed within the window haven't had the chance to forward
+// for all of it, so they're skipped. As only the height a channel confirmed at
+// is known, the window is converted to a number of blocks below the passed
+// current height, assuming the average block interval.
+func (d *DB) FetchIdleChannels(since time.Duration,
+	currentHeight uint32) ([]*OpenChannel, error) {
+
+	start := d.now().Add(-since)
+
+	// Any channel that confirmed above the height the window started at
+	// is too young to be considered idle.
+	var startHeight uint32
+	if windowBlocks := uint32(since / avgBlockInterval); windowBlocks <
+		currentHeight {
+
+		startHeight = currentHeight - windowBlocks
+	}
+
+	var channels []*OpenChannel
+	err := d.View(func(tx *bbolt.Tx) error {
+		channels = nil
+
+		// We'll first gather the set of channels used by at least one
+		// forwarding event within the window.
+		used, err := fetchForwardingChannels(tx, start)
+		if err != nil {
+			return err
+		}
+
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket == nil {
+			return nil
+		}
+
+		// With the set of used channels known, we'll only decode the
+		// open channels that aren't a part of it.
+		return forEachChanBucket(openChanBucket, func(_, _ []byte,
+			chanBucket *bbolt.Bucket) error {
+
+			header, err := fetchChanInfoHeader(chanBucket)
+			if err != nil {
+				return err
+			}
+			if header.isPending ||
+				header.chanStatus != ChanStatusDefault {
+
+				return nil
+			}
+			if header.shortChannelID.BlockHeight > startHeight {
+				return nil
+			}
+			if _, ok := used[header.shortChannelID]; ok {
+				return nil
+			}
+
+			channel, err := fetchOpenChannel(
+				chanBucket, &header.fundingOutpoint,
+			)
+			if err != nil {
+				return err
+			}
+			channel.Db = d
+
+			channels = append(channels, channel)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+// fetchForwardingChannels returns the set of channels used as either the
+// incoming or outgoing channel of a forwarding event at or after the passed
+// start time.
+func fetchForwardingChannels(tx *bbolt.Tx,
+	start time.Time) (map[lnwire.ShortChannelID]struct{}, error) {
+
+	used := make(map[lnwire.ShortChannelID]struct{})
+	logBucket := tx.Bucket(forwardingLogBucket)
+	if logBucket == nil {
+		return used, nil
+	}
+
+	// The log is keyed by the unsigned nano second timestamp of each
+	// event, so if the start time precedes the unix epoch, then we'll
+	// start at the first event instead.
+	var startTime [8]byte
+	if start.UnixNano() > 0 {
+		byteOrder.PutUint64(startTime[:], uint64(start.UnixNano()))
+	}
+
+	logCursor := logBucket.Cursor()
+	timestamp, events := logCursor.Seek(startTime[:])
+	for ; timestamp != nil; timestamp, events = logCursor.Next() {
+		readBuf := bytes.NewReader(events)
+		for readBuf.Len() != 0 {
+			var event ForwardingEvent
+			err := decodeForwardingEvent(readBuf, &event)
+			if err != nil {
+				return nil, err
+			}
+
+			used[event.IncomingChanID] = struct{}{}
+			used[event.OutgoingChanID] = struct{}{}
+		}
+	}
+
+	return used, nil
+}
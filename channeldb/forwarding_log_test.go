@@ -2,7 +2,9 @@ package channeldb
 
 import (
 	"math/rand"
+	"net"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
@@ -263,3 +265,178 @@ func TestForwardingLogQueryLimit(t *testing.T) {
 			timeSlice.LastIndexOffset)
 	}
 }
+
+// TestForwardingLogPrune tests that pruning the forwarding log removes all
+// events prior to the cutoff, while leaving the remaining events intact.
+func TestForwardingLogPrune(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+	log := db.ForwardingLog()
+
+	// Pruning an empty log should be a noop.
+	numPruned, err := db.PruneForwardingLog(time.Now())
+	if err != nil {
+		t.Fatalf("unable to prune forwarding log: %v", err)
+	}
+	if numPruned != 0 {
+		t.Fatalf("expected no events to be pruned, got %v", numPruned)
+	}
+
+	initialTime := time.Unix(1234, 0)
+	timestamp := initialTime
+
+	// We'll create 100 events, each spaced 10 minutes after the prior
+	// one.
+	numEvents := 100
+	events := make([]ForwardingEvent, numEvents)
+	for i := 0; i < numEvents; i++ {
+		events[i] = ForwardingEvent{
+			Timestamp:      timestamp,
+			IncomingChanID: lnwire.NewShortChanIDFromInt(uint64(rand.Int63())),
+			OutgoingChanID: lnwire.NewShortChanIDFromInt(uint64(rand.Int63())),
+			AmtIn:          lnwire.MilliSatoshi(rand.Int63()),
+			AmtOut:         lnwire.MilliSatoshi(rand.Int63()),
+		}
+
+		timestamp = timestamp.Add(time.Minute * 10)
+	}
+	if err := log.AddForwardingEvents(events); err != nil {
+		t.Fatalf("unable to add events: %v", err)
+	}
+
+	// We'll now prune all events up to, but not including, the 40th
+	// event.
+	numStale := 40
+	numPruned, err = db.PruneForwardingLog(events[numStale].Timestamp)
+	if err != nil {
+		t.Fatalf("unable to prune forwarding log: %v", err)
+	}
+	if numPruned != uint64(numStale) {
+		t.Fatalf("expected %v events to be pruned, got %v", numStale,
+			numPruned)
+	}
+
+	// Only the events from the cutoff onwards should remain.
+	timeSlice, err := log.Query(ForwardingEventQuery{
+		StartTime:    initialTime,
+		EndTime:      timestamp,
+		NumMaxEvents: 1000,
+	})
+	if err != nil {
+		t.Fatalf("unable to query for events: %v", err)
+	}
+	if !reflect.DeepEqual(events[numStale:], timeSlice.ForwardingEvents) {
+		t.Fatalf("event mismatch: expected %v vs %v",
+			spew.Sdump(events[numStale:]),
+			spew.Sdump(timeSlice.ForwardingEvents))
+	}
+
+	// Pruning with the same cutoff again should remove nothing.
+	numPruned, err = db.PruneForwardingLog(events[numStale].Timestamp)
+	if err != nil {
+		t.Fatalf("unable to prune forwarding log: %v", err)
+	}
+	if numPruned != 0 {
+		t.Fatalf("expected no events to be pruned, got %v", numPruned)
+	}
+}
+
+// TestFetchIdleChannels asserts that only the open channels without any
+// forwarding events within the queried window are returned.
+func TestFetchIdleChannels(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	now := time.Unix(1000000, 0)
+	db.now = func() time.Time {
+		return now
+	}
+
+	state, err := createTestChannelState(db)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	// We'll create four open channels, the last of which confirmed only
+	// 100 blocks ago, along with one that's still pending, which should
+	// never be returned.
+	const currentHeight = 1000
+	openHeights := []uint32{100, 200, 300, currentHeight - 100}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18556,
+	}
+	for i := 0; i <= len(openHeights); i++ {
+		state.FundingOutpoint.Index = uint32(i)
+		if err := state.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to save and serialize channel "+
+				"state: %v", err)
+		}
+	}
+	scids := make([]lnwire.ShortChannelID, len(openHeights))
+	for i, height := range openHeights {
+		state.FundingOutpoint.Index = uint32(i)
+		scids[i] = lnwire.ShortChannelID{BlockHeight: height}
+		if err := state.MarkAsOpen(scids[i]); err != nil {
+			t.Fatalf("unable to mark channel open: %v", err)
+		}
+	}
+
+	// The first channel forwarded an hour ago, while the second one did
+	// so two days ago.
+	events := []ForwardingEvent{
+		{
+			Timestamp:      now.Add(-time.Hour),
+			IncomingChanID: scids[0],
+			OutgoingChanID: lnwire.NewShortChanIDFromInt(100),
+		},
+		{
+			Timestamp:      now.Add(-48 * time.Hour),
+			IncomingChanID: lnwire.NewShortChanIDFromInt(100),
+			OutgoingChanID: scids[1],
+		},
+	}
+	if err := db.ForwardingLog().AddForwardingEvents(events); err != nil {
+		t.Fatalf("unable to add events: %v", err)
+	}
+
+	tests := []struct {
+		since    time.Duration
+		expected []uint32
+	}{
+		{since: 0, expected: []uint32{0, 1, 2, 3}},
+		{since: 10 * time.Hour, expected: []uint32{1, 2, 3}},
+		{since: 24 * time.Hour, expected: []uint32{1, 2}},
+		{since: 72 * time.Hour, expected: []uint32{2}},
+	}
+	for _, test := range tests {
+		channels, err := db.FetchIdleChannels(
+			test.since, currentHeight,
+		)
+		if err != nil {
+			t.Fatalf("unable to fetch idle channels: %v", err)
+		}
+
+		indexes := make([]uint32, 0, len(channels))
+		for _, channel := range channels {
+			indexes = append(indexes, channel.FundingOutpoint.Index)
+		}
+		sort.Slice(indexes, func(i, j int) bool {
+			return indexes[i] < indexes[j]
+		})
+		if !reflect.DeepEqual(indexes, test.expected) {
+			t.Fatalf("expected idle channels %v within %v, got %v",
+				test.expected, test.since, indexes)
+		}
+	}
+}
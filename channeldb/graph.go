@@ -11,6 +11,7 @@ import (
 	"math"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
@@ -188,11 +189,178 @@ func newChannelGraph(db *DB, rejectCacheSize, chanCacheSize int) *ChannelGraph {
 	}
 }
 
+// GraphCacheStats describes the current capacity and utilization of the
+// in-memory caches of the channel graph.
+type GraphCacheStats struct {
+	// RejectCacheSize is the maximum number of entries the reject cache
+	// can hold.
+	RejectCacheSize int
+
+	// RejectCacheEntries is the number of entries currently held by the
+	// reject cache.
+	RejectCacheEntries int
+
+	// RejectCacheHits is the number of edge lookups served by the reject
+	// cache.
+	RejectCacheHits uint64
+
+	// RejectCacheMisses is the number of edge lookups that missed the
+	// reject cache, and had to be served from disk.
+	RejectCacheMisses uint64
+
+	// ChannelCacheSize is the maximum number of channels the channel
+	// cache can hold.
+	ChannelCacheSize int
+
+	// ChannelCacheEntries is the number of channels currently held by the
+	// channel cache.
+	ChannelCacheEntries int
+
+	// ChannelCacheHits is the number of channel lookups served by the
+	// channel cache.
+	ChannelCacheHits uint64
+
+	// ChannelCacheMisses is the number of channel lookups that missed the
+	// channel cache, and had to be served from disk.
+	ChannelCacheMisses uint64
+}
+
+// RejectCacheHitRate returns the fraction of lookups served by the reject
+// cache, or zero if no lookups have been made.
+func (s *GraphCacheStats) RejectCacheHitRate() float64 {
+	return hitRate(s.RejectCacheHits, s.RejectCacheMisses)
+}
+
+// ChannelCacheHitRate returns the fraction of lookups served by the channel
+// cache, or zero if no lookups have been made.
+func (s *GraphCacheStats) ChannelCacheHitRate() float64 {
+	return hitRate(s.ChannelCacheHits, s.ChannelCacheMisses)
+}
+
+// hitRate returns the fraction of the total lookups that were hits.
+func hitRate(hits, misses uint64) float64 {
+	if hits+misses == 0 {
+		return 0
+	}
+
+	return float64(hits) / float64(hits+misses)
+}
+
+// cacheStats returns the current capacity and utilization of the graph's
+// caches.
+func (c *ChannelGraph) cacheStats() *GraphCacheStats {
+	c.cacheMu.RLock()
+	defer c.cacheMu.RUnlock()
+
+	return &GraphCacheStats{
+		RejectCacheSize:     c.rejectCache.n,
+		RejectCacheEntries:  len(c.rejectCache.edges),
+		RejectCacheHits:     atomic.LoadUint64(&c.rejectCache.hits),
+		RejectCacheMisses:   atomic.LoadUint64(&c.rejectCache.misses),
+		ChannelCacheSize:    c.chanCache.n,
+		ChannelCacheEntries: len(c.chanCache.channels),
+		ChannelCacheHits:    atomic.LoadUint64(&c.chanCache.hits),
+		ChannelCacheMisses:  atomic.LoadUint64(&c.chanCache.misses),
+	}
+}
+
+// resizeCaches changes the capacity of the graph's caches, evicting entries
+// at random from any cache that holds more entries than its new capacity.
+func (c *ChannelGraph) resizeCaches(rejectCacheSize, chanCacheSize int) {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	c.rejectCache.resize(rejectCacheSize)
+	c.chanCache.resize(chanCacheSize)
+}
+
 // Database returns a pointer to the underlying database.
 func (c *ChannelGraph) Database() *DB {
 	return c.db
 }
 
+// warmCaches populates the reject and channel caches by scanning the edge
+// index once, such that the first queries after startup don't all need to hit
+// the disk. The scan stops once both caches are at capacity.
+func (c *ChannelGraph) warmCaches() error {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		edges := tx.Bucket(edgeBucket)
+		if edges == nil {
+			return ErrGraphNoEdgesFound
+		}
+		edgeIndex := edges.Bucket(edgeIndexBucket)
+		if edgeIndex == nil {
+			return ErrGraphNoEdgesFound
+		}
+		nodes := tx.Bucket(nodeBucket)
+		if nodes == nil {
+			return ErrGraphNodesNotFound
+		}
+
+		cursor := edgeIndex.Cursor()
+		for chanID, _ := cursor.First(); chanID != nil; chanID, _ = cursor.Next() {
+			rejectFull := len(c.rejectCache.edges) >= c.rejectCache.n
+			chanFull := len(c.chanCache.channels) >= c.chanCache.n
+			if rejectFull && chanFull {
+				return nil
+			}
+
+			edgeInfo, err := fetchChanEdgeInfo(edgeIndex, chanID)
+			if err != nil {
+				return fmt.Errorf("unable to fetch info for "+
+					"edge with chan_id=%v: %v",
+					byteOrder.Uint64(chanID), err)
+			}
+			edgeInfo.db = c.db
+
+			edge1, edge2, err := fetchChanEdgePolicies(
+				edgeIndex, edges, nodes, chanID, c.db,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to fetch policies "+
+					"for edge with chan_id=%v: %v",
+					byteOrder.Uint64(chanID), err)
+			}
+
+			chanIDInt := byteOrder.Uint64(chanID)
+			if !rejectFull {
+				var upd1Time, upd2Time int64
+				if edge1 != nil {
+					upd1Time = edge1.LastUpdate.Unix()
+				}
+				if edge2 != nil {
+					upd2Time = edge2.LastUpdate.Unix()
+				}
+
+				c.rejectCache.insert(chanIDInt, rejectCacheEntry{
+					upd1Time: upd1Time,
+					upd2Time: upd2Time,
+					flags:    packRejectFlags(true, false),
+				})
+			}
+			if !chanFull {
+				c.chanCache.insert(chanIDInt, ChannelEdge{
+					Info:    &edgeInfo,
+					Policy1: edge1,
+					Policy2: edge2,
+				})
+			}
+		}
+
+		return nil
+	})
+	switch {
+	case err == ErrGraphNoEdgesFound || err == ErrGraphNodesNotFound:
+		return nil
+
+	default:
+		return err
+	}
+}
+
 // ForEachChannel iterates through all the channel edges stored within the
 // graph and invokes the passed callback for each edge. The callback takes two
 // edges as since this is a directed graph, both the in/out edges are visited.
@@ -711,6 +879,7 @@ func (c *ChannelGraph) HasChannelEdge(
 	// readers to access values in the cache concurrently if they exist.
 	c.cacheMu.RLock()
 	if entry, ok := c.rejectCache.get(chanID); ok {
+		c.rejectCache.recordLookup(true)
 		c.cacheMu.RUnlock()
 		upd1Time = time.Unix(entry.upd1Time, 0)
 		upd2Time = time.Unix(entry.upd2Time, 0)
@@ -726,11 +895,13 @@ func (c *ChannelGraph) HasChannelEdge(
 	// exclusive lock and check the cache again in case another method added
 	// the entry to the cache while no lock was held.
 	if entry, ok := c.rejectCache.get(chanID); ok {
+		c.rejectCache.recordLookup(true)
 		upd1Time = time.Unix(entry.upd1Time, 0)
 		upd2Time = time.Unix(entry.upd2Time, 0)
 		exists, isZombie = entry.flags.unpack()
 		return upd1Time, upd2Time, exists, isZombie, nil
 	}
+	c.rejectCache.recordLookup(false)
 
 	if err := c.db.View(func(tx *bbolt.Tx) error {
 		edges := tx.Bucket(edgeBucket)
@@ -984,6 +1155,155 @@ func (c *ChannelGraph) PruneGraphNodes() error {
 	})
 }
 
+// trimGraph removes all edges from the channel graph that aren't within the
+// given number of hops of the source node, along with any nodes that are left
+// unconnected as a result. The passed peers are treated as direct neighbours
+// of the source node, even if the channels with them haven't been announced.
+// Trimmed edges aren't marked as zombies, allowing them to be re-added should
+// they come within range once again.
+func (c *ChannelGraph) trimGraph(peers [][33]byte, hops int) error {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	var chansTrimmed []uint64
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		chansTrimmed = nil
+
+		nodes := tx.Bucket(nodeBucket)
+		if nodes == nil {
+			return ErrGraphNodesNotFound
+		}
+		sourceNode, err := c.sourceNode(nodes)
+		if err != nil {
+			return err
+		}
+
+		// If no edges have been added yet, then there's nothing to
+		// trim.
+		edges := tx.Bucket(edgeBucket)
+		if edges == nil {
+			return nil
+		}
+		edgeIndex := edges.Bucket(edgeIndexBucket)
+		if edgeIndex == nil {
+			return nil
+		}
+		chanIndex := edges.Bucket(channelPointBucket)
+		if chanIndex == nil {
+			return nil
+		}
+		zombieIndex, err := edges.CreateBucketIfNotExists(zombieBucket)
+		if err != nil {
+			return err
+		}
+
+		// We'll start by building an adjacency list of the graph from
+		// the edge index. The first 66 bytes of each edge info contain
+		// the pubkeys of the nodes that the edge connects.
+		neighbours := make(map[[33]byte][][33]byte)
+		err = edgeIndex.ForEach(func(_, edgeInfoBytes []byte) error {
+			var node1, node2 [33]byte
+			copy(node1[:], edgeInfoBytes[:33])
+			copy(node2[:], edgeInfoBytes[33:])
+
+			neighbours[node1] = append(neighbours[node1], node2)
+			neighbours[node2] = append(neighbours[node2], node1)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// With the adjacency list built, we'll perform a breadth first
+		// search from the source node to find all nodes that are less
+		// than the given number of hops away. Any edge that touches
+		// one of these nodes is within range.
+		distances := map[[33]byte]int{
+			sourceNode.PubKeyBytes: 0,
+		}
+		queue := [][33]byte{sourceNode.PubKeyBytes}
+		for _, peer := range peers {
+			if _, ok := distances[peer]; ok || hops <= 1 {
+				continue
+			}
+			distances[peer] = 1
+			queue = append(queue, peer)
+		}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+
+			if distances[node]+1 >= hops {
+				continue
+			}
+
+			for _, neighbour := range neighbours[node] {
+				if _, ok := distances[neighbour]; ok {
+					continue
+				}
+				distances[neighbour] = distances[node] + 1
+				queue = append(queue, neighbour)
+			}
+		}
+
+		// We'll now collect all edges that are out of range. We can't
+		// delete them while iterating over the edge index, so we'll
+		// do so afterwards.
+		var staleChanIDs [][]byte
+		err = edgeIndex.ForEach(func(chanID, edgeInfoBytes []byte) error {
+			var node1, node2 [33]byte
+			copy(node1[:], edgeInfoBytes[:33])
+			copy(node2[:], edgeInfoBytes[33:])
+
+			_, ok1 := distances[node1]
+			_, ok2 := distances[node2]
+			if ok1 || ok2 {
+				return nil
+			}
+
+			staleChanIDs = append(staleChanIDs, copySlice(chanID))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, chanID := range staleChanIDs {
+			err := delChannelEdge(
+				edges, edgeIndex, chanIndex, zombieIndex, nodes,
+				chanID, false,
+			)
+			if err != nil {
+				return err
+			}
+
+			chansTrimmed = append(
+				chansTrimmed, byteOrder.Uint64(chanID),
+			)
+		}
+
+		// Finally, with the out of range edges removed, we'll prune
+		// any nodes that are no longer connected to the graph.
+		return c.pruneGraphNodes(nodes, edgeIndex)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, chanID := range chansTrimmed {
+		c.rejectCache.remove(chanID)
+		c.chanCache.remove(chanID)
+	}
+
+	if len(chansTrimmed) > 0 {
+		log.Infof("Trimmed %v channels more than %v hops away from "+
+			"the channel graph", len(chansTrimmed), hops)
+	}
+
+	return nil
+}
+
 // pruneGraphNodes attempts to remove any nodes from the graph who have had a
 // channel closed within the current block. If the node still has existing
 // channels in the graph, this will act as a no-op.
@@ -1479,11 +1799,13 @@ func (c *ChannelGraph) ChanUpdatesInHorizon(startTime, endTime time.Time) ([]Cha
 			}
 
 			if channel, ok := c.chanCache.get(chanIDInt); ok {
+				c.chanCache.recordLookup(true)
 				hits++
 				edgesSeen[chanIDInt] = struct{}{}
 				edgesInHorizon = append(edgesInHorizon, channel)
 				continue
 			}
+			c.chanCache.recordLookup(false)
 
 			// First, we'll fetch the static edge information.
 			edgeInfo, err := fetchChanEdgeInfo(edgeIndex, chanID)
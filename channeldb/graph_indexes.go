@@ -0,0 +1,348 @@
+package channeldb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+// IndexRepair details the number of entries of a single secondary index that
+// had drifted from the source bucket the index is derived from.
+type IndexRepair struct {
+	// Missing is the number of entries that were absent from the index,
+	// and have since been added.
+	Missing uint64
+
+	// Stale is the number of entries within the index that didn't match
+	// the source bucket, and have since been removed or corrected.
+	Stale uint64
+}
+
+// IndexReport details the repairs made to each of the secondary indexes of the
+// channel graph by VerifyAndRepairIndexes.
+type IndexReport struct {
+	// NodeUpdateIndex details the repairs made to the index of node
+	// update times.
+	NodeUpdateIndex IndexRepair
+
+	// EdgeUpdateIndex details the repairs made to the index of edge
+	// policy update times.
+	EdgeUpdateIndex IndexRepair
+
+	// ChannelPointIndex details the repairs made to the index mapping
+	// channel points to their short channel IDs.
+	ChannelPointIndex IndexRepair
+}
+
+// Repaired returns true if any of the indexes had drifted from their source
+// buckets, and were therefore repaired.
+func (r *IndexReport) Repaired() bool {
+	var noRepair IndexRepair
+	return r.NodeUpdateIndex != noRepair ||
+		r.EdgeUpdateIndex != noRepair ||
+		r.ChannelPointIndex != noRepair
+}
+
+// VerifyAndRepairIndexes rebuilds the secondary indexes of the channel graph,
+// namely the node update index, the edge update index and the channel point
+// index, from the authoritative buckets they're derived from. Entries that are
+// missing from an index are added, and stale entries are removed, all within a
+// single transaction. The returned report details how many entries of each
+// index had drifted. This serves as a far less drastic recovery step than
+// wiping the graph after a crash mid-write or a faulty migration.
+func (d *DB) VerifyAndRepairIndexes() (*IndexReport, error) {
+	var report *IndexReport
+	err := d.Update(func(tx *bbolt.Tx) error {
+		report = &IndexReport{}
+
+		nodes := tx.Bucket(nodeBucket)
+		if nodes == nil {
+			return ErrGraphNotFound
+		}
+		nodeUpdateIndex, err := nodes.CreateBucketIfNotExists(
+			nodeUpdateIndexBucket,
+		)
+		if err != nil {
+			return err
+		}
+		expected, err := expectedNodeUpdateIndex(nodes)
+		if err != nil {
+			return err
+		}
+		report.NodeUpdateIndex, err = repairIndex(
+			nodeUpdateIndex, expected,
+		)
+		if err != nil {
+			return err
+		}
+
+		// If no edge has been added yet, then there are no further
+		// indexes to repair.
+		edges := tx.Bucket(edgeBucket)
+		if edges == nil {
+			return nil
+		}
+
+		edgeUpdateIndex, err := edges.CreateBucketIfNotExists(
+			edgeUpdateIndexBucket,
+		)
+		if err != nil {
+			return err
+		}
+		expected, err = expectedEdgeUpdateIndex(edges)
+		if err != nil {
+			return err
+		}
+		report.EdgeUpdateIndex, err = repairIndex(
+			edgeUpdateIndex, expected,
+		)
+		if err != nil {
+			return err
+		}
+
+		chanIndex, err := edges.CreateBucketIfNotExists(
+			channelPointBucket,
+		)
+		if err != nil {
+			return err
+		}
+		edgeIndex := edges.Bucket(edgeIndexBucket)
+		if edgeIndex == nil {
+			expected = nil
+		} else {
+			expected, err = expectedChannelPointIndex(edgeIndex)
+			if err != nil {
+				return err
+			}
+		}
+		report.ChannelPointIndex, err = repairIndex(chanIndex, expected)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// ReindexChannelPoints rebuilds the channel point index of the channel graph,
+// which maps the channel point of each edge to its channel ID, from scratch
+// using the edge index alone. Unlike VerifyAndRepairIndexes, the existing
+// index is discarded entirely rather than compared against, so it can be used
+// to recover from an index that drifted in ways that prevent edges from being
+// looked up by their channel point. The index is rebuilt within a single
+// transaction, and the number of entries it holds afterwards is returned.
+func (d *DB) ReindexChannelPoints() (uint64, error) {
+	var numEntries uint64
+	err := d.Update(func(tx *bbolt.Tx) error {
+		numEntries = 0
+
+		// If no edge has been added yet, then there's no index to
+		// rebuild.
+		edges := tx.Bucket(edgeBucket)
+		if edges == nil {
+			return nil
+		}
+
+		var (
+			expected  map[string][]byte
+			edgeIndex = edges.Bucket(edgeIndexBucket)
+			err       error
+		)
+		if edgeIndex != nil {
+			expected, err = expectedChannelPointIndex(edgeIndex)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = edges.DeleteBucket(channelPointBucket)
+		if err != nil && err != bbolt.ErrBucketNotFound {
+			return err
+		}
+		chanIndex, err := edges.CreateBucket(channelPointBucket)
+		if err != nil {
+			return err
+		}
+
+		for chanPoint, chanID := range expected {
+			err := chanIndex.Put([]byte(chanPoint), chanID)
+			if err != nil {
+				return err
+			}
+			numEntries++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	log.Infof("Rebuilt channel point index with %v entries", numEntries)
+
+	return numEntries, nil
+}
+
+// expectedNodeUpdateIndex returns the entries of the node update index as
+// derived from the nodes stored within the passed node bucket. Each announced
+// node maps to a key of its last update time followed by its public key. Shell
+// nodes, which we only know of through a channel, are never indexed.
+func expectedNodeUpdateIndex(nodes *bbolt.Bucket) (map[string][]byte, error) {
+	expected := make(map[string][]byte)
+	err := nodes.ForEach(func(nodePub, nodeBytes []byte) error {
+		// Only the keys of nodes are the size of a public key, all
+		// other keys are either sub-buckets or the source key.
+		if len(nodePub) != 33 || nodeBytes == nil {
+			return nil
+		}
+		if len(nodeBytes) < 8+33+2 {
+			return fmt.Errorf("invalid node %x", nodePub)
+		}
+
+		// The announcement flag follows the update time and public
+		// key, a value of zero marks a shell node.
+		if byteOrder.Uint16(nodeBytes[8+33:8+33+2]) == 0 {
+			return nil
+		}
+
+		var indexKey [8 + 33]byte
+		copy(indexKey[:8], nodeBytes[:8])
+		copy(indexKey[8:], nodePub)
+		expected[string(indexKey[:])] = nil
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return expected, nil
+}
+
+// expectedEdgeUpdateIndex returns the entries of the edge update index as
+// derived from the known edge policies stored within the passed edge bucket.
+// Each policy maps to a key of its last update time followed by its channel
+// ID.
+func expectedEdgeUpdateIndex(edges *bbolt.Bucket) (map[string][]byte, error) {
+	expected := make(map[string][]byte)
+	err := edges.ForEach(func(edgeKey, edgeBytes []byte) error {
+		// Only the keys of edge policies are the size of a public key
+		// followed by a channel ID. Policies that are still unknown
+		// have no update time, so they aren't indexed.
+		if len(edgeKey) != 33+8 || edgeBytes == nil ||
+			bytes.Equal(edgeBytes, unknownPolicy) {
+
+			return nil
+		}
+
+		r := bytes.NewReader(edgeBytes)
+		if _, err := wire.ReadVarBytes(r, 0, 80, "sig"); err != nil {
+			return err
+		}
+		var indexKey [8 + 8]byte
+		if _, err := io.ReadFull(r, indexKey[8:]); err != nil {
+			return err
+		}
+		if _, err := io.ReadFull(r, indexKey[:8]); err != nil {
+			return err
+		}
+		expected[string(indexKey[:])] = nil
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return expected, nil
+}
+
+// expectedChannelPointIndex returns the entries of the channel point index as
+// derived from the edges stored within the passed edge index. Each edge maps
+// its channel point to its channel ID.
+func expectedChannelPointIndex(
+	edgeIndex *bbolt.Bucket) (map[string][]byte, error) {
+
+	expected := make(map[string][]byte)
+	err := edgeIndex.ForEach(func(chanID, edgeInfoBytes []byte) error {
+		edgeInfo, err := deserializeChanEdgeInfo(
+			bytes.NewReader(edgeInfoBytes),
+		)
+		if err != nil {
+			return err
+		}
+
+		var chanPoint bytes.Buffer
+		err = writeOutpoint(&chanPoint, &edgeInfo.ChannelPoint)
+		if err != nil {
+			return err
+		}
+		expected[chanPoint.String()] = copySlice(chanID)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return expected, nil
+}
+
+// repairIndex brings the passed index in line with the expected set of
+// entries. Entries absent from the index are added, while entries that aren't
+// expected, or whose value differs, are removed or corrected.
+func repairIndex(index *bbolt.Bucket,
+	expected map[string][]byte) (IndexRepair, error) {
+
+	var (
+		repair    IndexRepair
+		staleKeys [][]byte
+		found     = make(map[string]struct{})
+	)
+	err := index.ForEach(func(k, v []byte) error {
+		expectedValue, ok := expected[string(k)]
+		if !ok {
+			staleKeys = append(staleKeys, copySlice(k))
+			return nil
+		}
+
+		found[string(k)] = struct{}{}
+		if !bytes.Equal(v, expectedValue) {
+			repair.Stale++
+			return nil
+		}
+
+		// The entry is up to date, so it doesn't need to be written
+		// again below.
+		delete(expected, string(k))
+		return nil
+	})
+	if err != nil {
+		return repair, err
+	}
+
+	for _, k := range staleKeys {
+		if err := index.Delete(k); err != nil {
+			return repair, err
+		}
+		repair.Stale++
+	}
+
+	// Any remaining expected entries are either missing from the index, or
+	// have a stale value which is overwritten.
+	for k, v := range expected {
+		if _, ok := found[k]; !ok {
+			repair.Missing++
+		}
+
+		if err := index.Put([]byte(k), v); err != nil {
+			return repair, err
+		}
+	}
+
+	return repair, nil
+}
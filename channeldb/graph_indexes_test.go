@@ -0,0 +1,366 @@
+package channeldb
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// fetchIndexEntries returns all entries of the graph index stored within the
+// passed sub-bucket of the given top-level bucket.
+func fetchIndexEntries(t *testing.T, db *DB, topBucket,
+	indexBucket []byte) map[string]string {
+
+	t.Helper()
+
+	entries := make(map[string]string)
+	err := db.View(func(tx *bbolt.Tx) error {
+		index := tx.Bucket(topBucket).Bucket(indexBucket)
+		return index.ForEach(func(k, v []byte) error {
+			entries[string(k)] = string(v)
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("unable to fetch index entries: %v", err)
+	}
+
+	return entries
+}
+
+// TestVerifyAndRepairIndexes asserts that the secondary indexes of the graph
+// are left untouched when they're consistent, and are rebuilt from their
+// source buckets once they drift.
+func TestVerifyAndRepairIndexes(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	graph := db.ChannelGraph()
+
+	// We'll start by populating the graph with two nodes, and a channel
+	// between them with both of its policies known.
+	var nodes []*LightningNode
+	for i := 0; i < 2; i++ {
+		priv, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatalf("unable to generate private key: %v", err)
+		}
+		node, err := createLightningNode(db, priv)
+		if err != nil {
+			t.Fatalf("unable to create node: %v", err)
+		}
+		if err := graph.AddLightningNode(node); err != nil {
+			t.Fatalf("unable to add node: %v", err)
+		}
+
+		nodes = append(nodes, node)
+	}
+	edgeInfo, edge1, edge2 := createChannelEdge(db, nodes[0], nodes[1])
+	edge2.LastUpdate = edge1.LastUpdate.Add(time.Second)
+	if err := graph.AddChannelEdge(edgeInfo); err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+	if err := graph.UpdateEdgePolicy(edge1); err != nil {
+		t.Fatalf("unable to update edge: %v", err)
+	}
+	if err := graph.UpdateEdgePolicy(edge2); err != nil {
+		t.Fatalf("unable to update edge: %v", err)
+	}
+
+	// As the indexes are consistent, nothing should be repaired.
+	report, err := db.VerifyAndRepairIndexes()
+	if err != nil {
+		t.Fatalf("unable to verify indexes: %v", err)
+	}
+	if report.Repaired() {
+		t.Fatalf("expected no repairs, got %v", spew.Sdump(report))
+	}
+
+	nodeUpdates := fetchIndexEntries(
+		t, db, nodeBucket, nodeUpdateIndexBucket,
+	)
+	edgeUpdates := fetchIndexEntries(
+		t, db, edgeBucket, edgeUpdateIndexBucket,
+	)
+	chanPoints := fetchIndexEntries(t, db, edgeBucket, channelPointBucket)
+
+	// Now, we'll corrupt each of the indexes. We'll replace the entry of
+	// a node within the node update index with a bogus one, remove all
+	// entries of the edge update index, and point the channel point of
+	// the edge to another channel, while adding a channel point that
+	// isn't known.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		nodeUpdateIndex := tx.Bucket(nodeBucket).Bucket(
+			nodeUpdateIndexBucket,
+		)
+		var indexKey [8 + 33]byte
+		byteOrder.PutUint64(
+			indexKey[:8], uint64(nodes[0].LastUpdate.Unix()),
+		)
+		copy(indexKey[8:], nodes[0].PubKeyBytes[:])
+		if err := nodeUpdateIndex.Delete(indexKey[:]); err != nil {
+			return err
+		}
+		byteOrder.PutUint64(indexKey[:8], 1)
+		if err := nodeUpdateIndex.Put(indexKey[:], nil); err != nil {
+			return err
+		}
+
+		edges := tx.Bucket(edgeBucket)
+		if err := edges.DeleteBucket(edgeUpdateIndexBucket); err != nil {
+			return err
+		}
+
+		chanIndex := edges.Bucket(channelPointBucket)
+		var chanPoint bytes.Buffer
+		err := writeOutpoint(&chanPoint, &edgeInfo.ChannelPoint)
+		if err != nil {
+			return err
+		}
+		var chanID [8]byte
+		byteOrder.PutUint64(chanID[:], edgeInfo.ChannelID+1)
+		if err := chanIndex.Put(chanPoint.Bytes(), chanID[:]); err != nil {
+			return err
+		}
+
+		chanPoint.Reset()
+		err = writeOutpoint(&chanPoint, &wire.OutPoint{Index: 1})
+		if err != nil {
+			return err
+		}
+		return chanIndex.Put(chanPoint.Bytes(), chanID[:])
+	})
+	if err != nil {
+		t.Fatalf("unable to corrupt indexes: %v", err)
+	}
+
+	report, err = db.VerifyAndRepairIndexes()
+	if err != nil {
+		t.Fatalf("unable to repair indexes: %v", err)
+	}
+	expectedReport := &IndexReport{
+		NodeUpdateIndex:   IndexRepair{Missing: 1, Stale: 1},
+		EdgeUpdateIndex:   IndexRepair{Missing: 2},
+		ChannelPointIndex: IndexRepair{Stale: 2},
+	}
+	if !reflect.DeepEqual(report, expectedReport) {
+		t.Fatalf("expected report %v, got %v",
+			spew.Sdump(expectedReport), spew.Sdump(report))
+	}
+
+	// The indexes should now match their state prior to being corrupted.
+	repairedNodeUpdates := fetchIndexEntries(
+		t, db, nodeBucket, nodeUpdateIndexBucket,
+	)
+	if !reflect.DeepEqual(repairedNodeUpdates, nodeUpdates) {
+		t.Fatalf("node update index not repaired")
+	}
+	repairedEdgeUpdates := fetchIndexEntries(
+		t, db, edgeBucket, edgeUpdateIndexBucket,
+	)
+	if !reflect.DeepEqual(repairedEdgeUpdates, edgeUpdates) {
+		t.Fatalf("edge update index not repaired")
+	}
+	repairedChanPoints := fetchIndexEntries(
+		t, db, edgeBucket, channelPointBucket,
+	)
+	if !reflect.DeepEqual(repairedChanPoints, chanPoints) {
+		t.Fatalf("channel point index not repaired")
+	}
+
+	// A subsequent verification should find nothing left to repair.
+	report, err = db.VerifyAndRepairIndexes()
+	if err != nil {
+		t.Fatalf("unable to verify indexes: %v", err)
+	}
+	if report.Repaired() {
+		t.Fatalf("expected no repairs, got %v", spew.Sdump(report))
+	}
+}
+
+// TestVerifyAndRepairIndexesShellNode asserts that shell nodes, which are
+// never added to the node update index, aren't reported as missing from it.
+func TestVerifyAndRepairIndexesShellNode(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	graph := db.ChannelGraph()
+
+	// We'll only add the first node to the graph, the second one will be
+	// inserted as a shell node once we add a channel between them.
+	var nodes []*LightningNode
+	for i := 0; i < 2; i++ {
+		priv, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatalf("unable to generate private key: %v", err)
+		}
+		node, err := createLightningNode(db, priv)
+		if err != nil {
+			t.Fatalf("unable to create node: %v", err)
+		}
+
+		nodes = append(nodes, node)
+	}
+	if err := graph.AddLightningNode(nodes[0]); err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+	edgeInfo, _, _ := createChannelEdge(db, nodes[0], nodes[1])
+	if err := graph.AddChannelEdge(edgeInfo); err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+
+	shellPub, err := nodes[1].PubKey()
+	if err != nil {
+		t.Fatalf("unable to parse pubkey: %v", err)
+	}
+	shellNode, err := graph.FetchLightningNode(shellPub)
+	if err != nil {
+		t.Fatalf("unable to fetch shell node: %v", err)
+	}
+	if shellNode.HaveNodeAnnouncement {
+		t.Fatalf("expected shell node")
+	}
+
+	// The shell node has no entry within the node update index, which is
+	// consistent, so nothing should be repaired.
+	nodeUpdates := fetchIndexEntries(
+		t, db, nodeBucket, nodeUpdateIndexBucket,
+	)
+	report, err := db.VerifyAndRepairIndexes()
+	if err != nil {
+		t.Fatalf("unable to verify indexes: %v", err)
+	}
+	if report.Repaired() {
+		t.Fatalf("expected no repairs, got %v", spew.Sdump(report))
+	}
+	if !reflect.DeepEqual(fetchIndexEntries(
+		t, db, nodeBucket, nodeUpdateIndexBucket,
+	), nodeUpdates) {
+		t.Fatalf("node update index modified")
+	}
+}
+
+// TestReindexChannelPoints asserts that the channel point index is rebuilt
+// from the edge index alone, discarding any of its prior entries.
+func TestReindexChannelPoints(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// Without any edges, there's nothing to rebuild.
+	numEntries, err := db.ReindexChannelPoints()
+	if err != nil {
+		t.Fatalf("unable to reindex channel points: %v", err)
+	}
+	if numEntries != 0 {
+		t.Fatalf("expected no entries, got %v", numEntries)
+	}
+
+	graph := db.ChannelGraph()
+
+	// We'll populate the graph with two channels between the same pair
+	// of nodes.
+	var nodes []*LightningNode
+	for i := 0; i < 2; i++ {
+		priv, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatalf("unable to generate private key: %v", err)
+		}
+		node, err := createLightningNode(db, priv)
+		if err != nil {
+			t.Fatalf("unable to create node: %v", err)
+		}
+		if err := graph.AddLightningNode(node); err != nil {
+			t.Fatalf("unable to add node: %v", err)
+		}
+
+		nodes = append(nodes, node)
+	}
+	var edgeInfos []*ChannelEdgeInfo
+	for i := 0; i < 2; i++ {
+		edgeInfo, _, _ := createChannelEdge(db, nodes[0], nodes[1])
+		edgeInfo.ChannelPoint.Index = uint32(i)
+		if err := graph.AddChannelEdge(edgeInfo); err != nil {
+			t.Fatalf("unable to add edge: %v", err)
+		}
+
+		edgeInfos = append(edgeInfos, edgeInfo)
+	}
+	chanPoints := fetchIndexEntries(t, db, edgeBucket, channelPointBucket)
+
+	// We'll now corrupt the index by removing the entry of the first
+	// channel, and adding one for a channel point that isn't known.
+	err = db.Update(func(tx *bbolt.Tx) error {
+		chanIndex := tx.Bucket(edgeBucket).Bucket(channelPointBucket)
+
+		var chanPoint bytes.Buffer
+		err := writeOutpoint(&chanPoint, &edgeInfos[0].ChannelPoint)
+		if err != nil {
+			return err
+		}
+		if err := chanIndex.Delete(chanPoint.Bytes()); err != nil {
+			return err
+		}
+
+		chanPoint.Reset()
+		err = writeOutpoint(&chanPoint, &wire.OutPoint{Index: 100})
+		if err != nil {
+			return err
+		}
+		var chanID [8]byte
+		byteOrder.PutUint64(chanID[:], edgeInfos[1].ChannelID)
+		return chanIndex.Put(chanPoint.Bytes(), chanID[:])
+	})
+	if err != nil {
+		t.Fatalf("unable to corrupt index: %v", err)
+	}
+
+	_, err = graph.ChannelID(&edgeInfos[0].ChannelPoint)
+	if err != ErrEdgeNotFound {
+		t.Fatalf("expected ErrEdgeNotFound, got: %v", err)
+	}
+
+	// Rebuilding the index should restore both of its entries, while
+	// discarding the unknown one.
+	numEntries, err = db.ReindexChannelPoints()
+	if err != nil {
+		t.Fatalf("unable to reindex channel points: %v", err)
+	}
+	if numEntries != 2 {
+		t.Fatalf("expected 2 entries, got %v", numEntries)
+	}
+
+	reindexed := fetchIndexEntries(t, db, edgeBucket, channelPointBucket)
+	if !reflect.DeepEqual(reindexed, chanPoints) {
+		t.Fatalf("channel point index not rebuilt")
+	}
+
+	chanID, err := graph.ChannelID(&edgeInfos[0].ChannelPoint)
+	if err != nil {
+		t.Fatalf("unable to look up channel: %v", err)
+	}
+	if chanID != edgeInfos[0].ChannelID {
+		t.Fatalf("expected channel ID %v, got %v",
+			edgeInfos[0].ChannelID, chanID)
+	}
+}
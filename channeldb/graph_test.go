@@ -5,10 +5,12 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"image/color"
+	"io/ioutil"
 	"math"
 	"math/big"
 	prand "math/rand"
 	"net"
+	"os"
 	"reflect"
 	"runtime"
 	"testing"
@@ -3195,3 +3197,426 @@ func TestComputeFee(t *testing.T) {
 		t.Fatalf("expected fee %v, but got %v", fee, fwdFee)
 	}
 }
+
+// TestGraphCacheWarming asserts that the graph caches are populated from disk
+// when the database is opened with cache warming enabled.
+func TestGraphCacheWarming(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	db, err := Open(tempDirName)
+	if err != nil {
+		t.Fatalf("unable to open database: %v", err)
+	}
+	graph := db.ChannelGraph()
+
+	// We'll start by populating the graph with a single edge, along with
+	// both of its policies.
+	node1, err := createTestVertex(db)
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+	if err := graph.AddLightningNode(node1); err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+	node2, err := createTestVertex(db)
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+	if err := graph.AddLightningNode(node2); err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+
+	edgeInfo, edge1, edge2 := createChannelEdge(db, node1, node2)
+	if err := graph.AddChannelEdge(edgeInfo); err != nil {
+		t.Fatalf("unable to create channel edge: %v", err)
+	}
+	if err := graph.UpdateEdgePolicy(edge1); err != nil {
+		t.Fatalf("unable to update edge: %v", err)
+	}
+	if err := graph.UpdateEdgePolicy(edge2); err != nil {
+		t.Fatalf("unable to update edge: %v", err)
+	}
+	db.Close()
+
+	// We'll now re-open the database with cache warming enabled, the
+	// edge should be found within both caches without first querying
+	// for it.
+	db, err = Open(tempDirName, OptionSetGraphCacheWarming(true))
+	if err != nil {
+		t.Fatalf("unable to open database: %v", err)
+	}
+	defer db.Close()
+	graph = db.ChannelGraph()
+
+	entry, ok := graph.rejectCache.get(edgeInfo.ChannelID)
+	if !ok {
+		t.Fatalf("edge not found in reject cache")
+	}
+	exists, isZombie := entry.flags.unpack()
+	if !exists || isZombie {
+		t.Fatalf("unexpected reject cache flags: exists=%v, "+
+			"zombie=%v", exists, isZombie)
+	}
+	if entry.upd1Time != edge1.LastUpdate.Unix() ||
+		entry.upd2Time != edge2.LastUpdate.Unix() {
+
+		t.Fatalf("unexpected update times in reject cache")
+	}
+
+	channel, ok := graph.chanCache.get(edgeInfo.ChannelID)
+	if !ok {
+		t.Fatalf("edge not found in channel cache")
+	}
+	if channel.Info.ChannelID != edgeInfo.ChannelID {
+		t.Fatalf("wrong channel in cache: expected %v, got %v",
+			edgeInfo.ChannelID, channel.Info.ChannelID)
+	}
+	if channel.Policy1 == nil || channel.Policy2 == nil {
+		t.Fatalf("expected both policies to be cached")
+	}
+}
+
+// TestForEachGraphEdge tests that the graph edges can be streamed from the
+// top-level DB, and that an error returned by the callback halts the
+// iteration.
+func TestForEachGraphEdge(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	graph := db.ChannelGraph()
+
+	node1, err := createTestVertex(db)
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+	if err := graph.AddLightningNode(node1); err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+	node2, err := createTestVertex(db)
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+	if err := graph.AddLightningNode(node2); err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+
+	// We'll add two edges, only the first of which has its policies
+	// populated.
+	edgeInfo1, edge1, edge2 := createChannelEdge(db, node1, node2)
+	if err := graph.AddChannelEdge(edgeInfo1); err != nil {
+		t.Fatalf("unable to create channel edge: %v", err)
+	}
+	if err := graph.UpdateEdgePolicy(edge1); err != nil {
+		t.Fatalf("unable to update edge: %v", err)
+	}
+	if err := graph.UpdateEdgePolicy(edge2); err != nil {
+		t.Fatalf("unable to update edge: %v", err)
+	}
+	edgeInfo2, _, _ := createChannelEdge(db, node1, node2)
+	if err := graph.AddChannelEdge(edgeInfo2); err != nil {
+		t.Fatalf("unable to create channel edge: %v", err)
+	}
+
+	numPolicies := make(map[uint64]int)
+	err = db.ForEachGraphEdge(func(info *ChannelEdgeInfo,
+		p1, p2 *ChannelEdgePolicy) error {
+
+		numPolicies[info.ChannelID] = 0
+		for _, p := range []*ChannelEdgePolicy{p1, p2} {
+			if p != nil {
+				numPolicies[info.ChannelID]++
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to iterate graph edges: %v", err)
+	}
+
+	expected := map[uint64]int{
+		edgeInfo1.ChannelID: 2,
+		edgeInfo2.ChannelID: 0,
+	}
+	if !reflect.DeepEqual(expected, numPolicies) {
+		t.Fatalf("expected policies %v, got %v", expected, numPolicies)
+	}
+
+	// An error returned from the callback should stop the iteration after
+	// the first edge.
+	errStop := fmt.Errorf("stop")
+	var numVisited int
+	err = db.ForEachGraphEdge(func(*ChannelEdgeInfo, *ChannelEdgePolicy,
+		*ChannelEdgePolicy) error {
+
+		numVisited++
+		return errStop
+	})
+	if err != errStop {
+		t.Fatalf("expected %v, got %v", errStop, err)
+	}
+	if numVisited != 1 {
+		t.Fatalf("expected 1 edge visited, got %v", numVisited)
+	}
+}
+
+// TestTrimGraphToChannels tests that trimming the graph only retains the edges
+// within the given number of hops of our node and our channel peers, and that
+// any nodes left unconnected are pruned.
+func TestTrimGraphToChannels(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	graph := db.ChannelGraph()
+
+	// Trimming requires at least a single hop.
+	if err := db.TrimGraphToChannels(0); err == nil {
+		t.Fatalf("expected trimming to zero hops to fail")
+	}
+
+	// We'll create the following graph, where S is our source node, and
+	// P is a peer we have an unannounced channel with:
+	//
+	//   S -- A -- B -- C      P -- D
+	newNode := func() *LightningNode {
+		node, err := createTestVertex(db)
+		if err != nil {
+			t.Fatalf("unable to create test node: %v", err)
+		}
+		if err := graph.AddLightningNode(node); err != nil {
+			t.Fatalf("unable to add node: %v", err)
+		}
+		return node
+	}
+	source := newNode()
+	if err := graph.SetSourceNode(source); err != nil {
+		t.Fatalf("unable to set source node: %v", err)
+	}
+	nodeA, nodeB, nodeC, nodeD := newNode(), newNode(), newNode(), newNode()
+
+	peer, err := createLightningNode(db, privKey)
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+	if err := graph.AddLightningNode(peer); err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+
+	newEdge := func(node1, node2 *LightningNode) uint64 {
+		edgeInfo, _, _ := createChannelEdge(db, node1, node2)
+		if err := graph.AddChannelEdge(edgeInfo); err != nil {
+			t.Fatalf("unable to create channel edge: %v", err)
+		}
+		return edgeInfo.ChannelID
+	}
+	chanSA := newEdge(source, nodeA)
+	chanAB := newEdge(nodeA, nodeB)
+	chanBC := newEdge(nodeB, nodeC)
+	chanPD := newEdge(peer, nodeD)
+
+	// The channel with our peer is only known to us, so we'll add it to
+	// the set of our open channels.
+	channelState, err := createTestChannelState(db)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channelState.SyncPending(addr, 9); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+	err = channelState.MarkAsOpen(lnwire.NewShortChanIDFromInt(99))
+	if err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+
+	assertGraph := func(expectedChans []uint64,
+		expectedNodes, prunedNodes []*LightningNode) {
+
+		t.Helper()
+
+		chans := make(map[uint64]struct{})
+		err := graph.ForEachChannel(func(info *ChannelEdgeInfo,
+			_, _ *ChannelEdgePolicy) error {
+
+			chans[info.ChannelID] = struct{}{}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unable to iterate channels: %v", err)
+		}
+		if len(chans) != len(expectedChans) {
+			t.Fatalf("expected %v channels, found %v",
+				len(expectedChans), len(chans))
+		}
+		for _, chanID := range expectedChans {
+			if _, ok := chans[chanID]; !ok {
+				t.Fatalf("channel %v not found", chanID)
+			}
+		}
+
+		for _, node := range expectedNodes {
+			_, exists, err := graph.HasLightningNode(node.PubKeyBytes)
+			if err != nil {
+				t.Fatalf("unable to query for node: %v", err)
+			}
+			if !exists {
+				t.Fatalf("node %x not found", node.PubKeyBytes)
+			}
+		}
+		for _, node := range prunedNodes {
+			_, exists, err := graph.HasLightningNode(node.PubKeyBytes)
+			if err != nil {
+				t.Fatalf("unable to query for node: %v", err)
+			}
+			if exists {
+				t.Fatalf("node %x not pruned", node.PubKeyBytes)
+			}
+		}
+	}
+
+	// Trimming the graph to more hops than its diameter should leave it
+	// untouched.
+	if err := db.TrimGraphToChannels(4); err != nil {
+		t.Fatalf("unable to trim graph: %v", err)
+	}
+	assertGraph(
+		[]uint64{chanSA, chanAB, chanBC, chanPD},
+		[]*LightningNode{source, nodeA, nodeB, nodeC, peer, nodeD},
+		nil,
+	)
+
+	// With two hops, the edge between B and C should be trimmed, along
+	// with C itself. As P is our peer, its edge should be retained.
+	for i := 0; i < 2; i++ {
+		if err := db.TrimGraphToChannels(2); err != nil {
+			t.Fatalf("unable to trim graph: %v", err)
+		}
+		assertGraph(
+			[]uint64{chanSA, chanAB, chanPD},
+			[]*LightningNode{source, nodeA, nodeB, peer, nodeD},
+			[]*LightningNode{nodeC},
+		)
+	}
+
+	// Trimmed edges shouldn't be marked as zombies, allowing them to be
+	// re-added.
+	if isZombie, _, _ := graph.IsZombieEdge(chanBC); isZombie {
+		t.Fatalf("trimmed edge marked as zombie")
+	}
+
+	// Finally, with a single hop, only our own channels should remain.
+	if err := db.TrimGraphToChannels(1); err != nil {
+		t.Fatalf("unable to trim graph: %v", err)
+	}
+	assertGraph(
+		[]uint64{chanSA},
+		[]*LightningNode{source, nodeA},
+		[]*LightningNode{nodeB, nodeC, peer, nodeD},
+	)
+}
+
+// TestGraphCacheStatsAndResize tests that lookups are reflected within the
+// graph cache stats, and that the caches can be resized at runtime.
+func TestGraphCacheStatsAndResize(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	graph := db.ChannelGraph()
+
+	stats := db.GraphCacheStats()
+	if stats.RejectCacheSize != DefaultRejectCacheSize ||
+		stats.ChannelCacheSize != DefaultChannelCacheSize {
+
+		t.Fatalf("unexpected cache sizes: %v", spew.Sdump(stats))
+	}
+	if stats.RejectCacheHitRate() != 0 || stats.ChannelCacheHitRate() != 0 {
+		t.Fatalf("expected zero hit rates, got %v", spew.Sdump(stats))
+	}
+
+	// We'll add a few edges to the graph, and look each of them up twice.
+	// The first lookup should miss the reject cache, while the second
+	// should be served by it.
+	const numEdges = 3
+	for i := 0; i < numEdges; i++ {
+		node1, err := createTestVertex(db)
+		if err != nil {
+			t.Fatalf("unable to create test node: %v", err)
+		}
+		node2, err := createTestVertex(db)
+		if err != nil {
+			t.Fatalf("unable to create test node: %v", err)
+		}
+		edgeInfo, _, _ := createChannelEdge(db, node1, node2)
+		edgeInfo.ChannelPoint.Index = uint32(i)
+		if err := graph.AddChannelEdge(edgeInfo); err != nil {
+			t.Fatalf("unable to create channel edge: %v", err)
+		}
+
+		for j := 0; j < 2; j++ {
+			_, _, _, _, err := graph.HasChannelEdge(
+				edgeInfo.ChannelID,
+			)
+			if err != nil {
+				t.Fatalf("unable to query for edge: %v", err)
+			}
+		}
+	}
+
+	stats = db.GraphCacheStats()
+	if stats.RejectCacheHits != numEdges ||
+		stats.RejectCacheMisses != numEdges {
+
+		t.Fatalf("expected %v hits and misses, got %v", numEdges,
+			spew.Sdump(stats))
+	}
+	if stats.RejectCacheHitRate() != 0.5 {
+		t.Fatalf("expected hit rate of 0.5, got %v",
+			stats.RejectCacheHitRate())
+	}
+	if stats.RejectCacheEntries != numEdges {
+		t.Fatalf("expected %v reject cache entries, got %v", numEdges,
+			stats.RejectCacheEntries)
+	}
+
+	// Invalid sizes should be rejected.
+	if err := db.ResizeGraphCaches(0, 1); err == nil {
+		t.Fatalf("expected resize to zero entries to fail")
+	}
+
+	// Shrinking the caches should evict entries as needed.
+	if err := db.ResizeGraphCaches(1, 1); err != nil {
+		t.Fatalf("unable to resize caches: %v", err)
+	}
+	stats = db.GraphCacheStats()
+	if stats.RejectCacheSize != 1 || stats.ChannelCacheSize != 1 {
+		t.Fatalf("unexpected cache sizes: %v", spew.Sdump(stats))
+	}
+	if stats.RejectCacheEntries != 1 {
+		t.Fatalf("expected 1 reject cache entry, got %v",
+			stats.RejectCacheEntries)
+	}
+}
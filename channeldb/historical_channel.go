@@ -0,0 +1,118 @@
+package channeldb
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// historicalChannelBucket is a top-level bucket which retains the
+	// complete state of each closed channel, including its revocation
+	// log, within a sub-bucket keyed by the channel's outpoint. The
+	// sub-buckets mirror the layout of the channel buckets within the
+	// openChannelBucket. It's only populated if the database was opened
+	// with historical channels enabled, and is created on demand.
+	historicalChannelBucket = []byte("historical-chan-bucket")
+)
+
+// FetchHistoricalChannel returns the complete state of a closed channel as it
+// was at the time the channel was closed. If the state of the channel wasn't
+// retained, for instance because historical channels weren't enabled at the
+// time it was closed, then ErrChannelNotFound is returned.
+//
+// NOTE: The returned channel is detached from the set of open channels, so it
+// must only be used to read its state.
+func (d *DB) FetchHistoricalChannel(chanPoint wire.OutPoint) (*OpenChannel,
+	error) {
+
+	var channel *OpenChannel
+	err := d.View(func(tx *bbolt.Tx) error {
+		chanBucket, err := fetchHistoricalChanBucket(tx, &chanPoint)
+		if err != nil {
+			return err
+		}
+
+		channel, err = fetchOpenChannel(chanBucket, &chanPoint)
+		if err != nil {
+			return err
+		}
+		channel.Db = d
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+// fetchHistoricalChanBucket returns the bucket retaining the complete state of
+// the closed channel identified by chanPoint. If the state of the channel
+// wasn't retained, then ErrChannelNotFound is returned.
+func fetchHistoricalChanBucket(tx *bbolt.Tx,
+	chanPoint *wire.OutPoint) (*bbolt.Bucket, error) {
+
+	histBucket := tx.Bucket(historicalChannelBucket)
+	if histBucket == nil {
+		return nil, ErrChannelNotFound
+	}
+
+	var k bytes.Buffer
+	if err := writeOutpoint(&k, chanPoint); err != nil {
+		return nil, err
+	}
+
+	chanBucket := histBucket.Bucket(k.Bytes())
+	if chanBucket == nil {
+		return nil, ErrChannelNotFound
+	}
+
+	return chanBucket, nil
+}
+
+// putHistoricalChannel retains a complete copy of the passed channel bucket,
+// keyed by the passed channel point, within the passed transaction. Any state
+// previously retained for the channel point is replaced.
+func putHistoricalChannel(tx *bbolt.Tx, chanPoint []byte,
+	chanBucket *bbolt.Bucket) error {
+
+	histBucket, err := tx.CreateBucketIfNotExists(historicalChannelBucket)
+	if err != nil {
+		return err
+	}
+
+	if histBucket.Bucket(chanPoint) != nil {
+		if err := histBucket.DeleteBucket(chanPoint); err != nil {
+			return err
+		}
+	}
+
+	histChanBucket, err := histBucket.CreateBucket(chanPoint)
+	if err != nil {
+		return err
+	}
+
+	return copyBucket(histChanBucket, chanBucket)
+}
+
+// copyBucket recursively copies all keys and nested buckets of the source
+// bucket into the destination bucket.
+func copyBucket(dst, src *bbolt.Bucket) error {
+	return src.ForEach(func(k, v []byte) error {
+		// A nil value indicates a nested bucket, which we'll copy
+		// recursively.
+		if v == nil {
+			nestedDst, err := dst.CreateBucketIfNotExists(k)
+			if err != nil {
+				return err
+			}
+
+			return copyBucket(nestedDst, src.Bucket(k))
+		}
+
+		return dst.Put(k, copySlice(v))
+	})
+}
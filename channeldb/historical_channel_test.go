@@ -0,0 +1,121 @@
+package channeldb
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/coreos/bbolt"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestFetchHistoricalChannel asserts that the complete state of a channel is
+// retained when it's closed only if historical channels are enabled.
+func TestFetchHistoricalChannel(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+
+	// We'll close two channels, only the second of which is closed once
+	// historical channels have been enabled.
+	var channels []*OpenChannel
+	for i := 0; i < 2; i++ {
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+
+		// We'll also add a dummy entry to the revocation log of the
+		// channel, to ensure nested buckets are retained as well.
+		err = cdb.Update(func(tx *bbolt.Tx) error {
+			chanBucket, err := fetchChanBucket(
+				tx, channel.IdentityPub,
+				&channel.FundingOutpoint, channel.ChainHash,
+			)
+			if err != nil {
+				return err
+			}
+			logBucket, err := chanBucket.CreateBucketIfNotExists(
+				revocationLogBucket,
+			)
+			if err != nil {
+				return err
+			}
+			return logBucket.Put([]byte("key"), []byte("value"))
+		})
+		if err != nil {
+			t.Fatalf("unable to write revocation log: %v", err)
+		}
+
+		cdb.keepHistoricalChannels = i == 1
+		err = channel.CloseChannel(&ChannelCloseSummary{
+			ChanPoint: channel.FundingOutpoint,
+			RemotePub: channel.IdentityPub,
+		})
+		if err != nil {
+			t.Fatalf("unable to close channel: %v", err)
+		}
+
+		channels = append(channels, channel)
+	}
+
+	_, err = cdb.FetchHistoricalChannel(channels[0].FundingOutpoint)
+	if err != ErrChannelNotFound {
+		t.Fatalf("expected ErrChannelNotFound, got: %v", err)
+	}
+
+	histChannel, err := cdb.FetchHistoricalChannel(
+		channels[1].FundingOutpoint,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch historical channel: %v", err)
+	}
+
+	// The channel's state should match the state it had when closed. We
+	// clear the packagers, as they're recreated when fetching a channel.
+	expected := channels[1]
+	expected.Packager = nil
+	histChannel.Packager = nil
+	if !reflect.DeepEqual(expected, histChannel) {
+		t.Fatalf("historical channel mismatch: expected %v, got %v",
+			spew.Sdump(expected), spew.Sdump(histChannel))
+	}
+
+	// Finally, the revocation log of the channel should've been retained.
+	err = cdb.View(func(tx *bbolt.Tx) error {
+		var k bytes.Buffer
+		err := writeOutpoint(&k, &channels[1].FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		chanBucket := tx.Bucket(historicalChannelBucket).Bucket(
+			k.Bytes(),
+		)
+		logBucket := chanBucket.Bucket(revocationLogBucket)
+		if logBucket == nil {
+			t.Fatalf("revocation log not retained")
+		}
+		if !bytes.Equal(logBucket.Get([]byte("key")), []byte("value")) {
+			t.Fatalf("revocation log entry not retained")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read historical channel: %v", err)
+	}
+}
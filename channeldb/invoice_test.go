@@ -2,11 +2,14 @@ package channeldb
 
 import (
 	"crypto/rand"
+	"fmt"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -692,3 +695,374 @@ func getUpdateInvoice(amt lnwire.MilliSatoshi) InvoiceUpdateCallback {
 		return update, nil
 	}
 }
+
+// getUpdateInvoiceState returns an invoice update callback that, when called,
+// moves the invoice to the given state without adding any htlcs.
+func getUpdateInvoiceState(state ContractState) InvoiceUpdateCallback {
+	return func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+		return &InvoiceUpdateDesc{
+			Preimage: invoice.Terms.PaymentPreimage,
+			State:    state,
+		}, nil
+	}
+}
+
+// TestDeleteInvoice tests that an invoice can be deleted along with its index
+// entries, unless it has been accepted.
+func TestDeleteInvoice(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	payHash := func(i *Invoice) lntypes.Hash {
+		return i.Terms.PaymentPreimage.Hash()
+	}
+
+	// Deleting an invoice before any have been created should fail.
+	var unknownHash lntypes.Hash
+	if err := db.DeleteInvoice(unknownHash); err != ErrNoInvoicesCreated {
+		t.Fatalf("expected ErrNoInvoicesCreated, got %v", err)
+	}
+
+	settled, err := randInvoice(1000)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	if _, err := db.AddInvoice(settled, payHash(settled)); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+	_, err = db.UpdateInvoice(payHash(settled), getUpdateInvoice(1000))
+	if err != nil {
+		t.Fatalf("unable to settle invoice: %v", err)
+	}
+
+	accepted, err := randInvoice(1000)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	if _, err := db.AddInvoice(accepted, payHash(accepted)); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+	_, err = db.UpdateInvoice(
+		payHash(accepted), getUpdateInvoiceState(ContractAccepted),
+	)
+	if err != nil {
+		t.Fatalf("unable to accept invoice: %v", err)
+	}
+
+	// An unknown invoice can't be deleted.
+	if err := db.DeleteInvoice(unknownHash); err != ErrInvoiceNotFound {
+		t.Fatalf("expected ErrInvoiceNotFound, got %v", err)
+	}
+
+	// Neither can an accepted one.
+	err = db.DeleteInvoice(payHash(accepted))
+	if err != ErrInvoiceAlreadyAccepted {
+		t.Fatalf("expected ErrInvoiceAlreadyAccepted, got %v", err)
+	}
+
+	// The settled invoice can be deleted, after which it should no longer
+	// be found within the database or any of the time series indexes.
+	if err := db.DeleteInvoice(payHash(settled)); err != nil {
+		t.Fatalf("unable to delete invoice: %v", err)
+	}
+	_, err = db.LookupInvoice(payHash(settled))
+	if err != ErrInvoiceNotFound {
+		t.Fatalf("expected ErrInvoiceNotFound, got %v", err)
+	}
+	if _, err := db.LookupInvoice(payHash(accepted)); err != nil {
+		t.Fatalf("unable to lookup invoice: %v", err)
+	}
+
+	assertInvoiceIndexLen(t, db, addIndexBucket, 1)
+	assertInvoiceIndexLen(t, db, settleIndexBucket, 0)
+}
+
+// TestFetchInvoiceByAddIndex tests that invoices can be looked up by their add
+// index, and that unassigned and deleted add indexes aren't found.
+func TestFetchInvoiceByAddIndex(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	_, err = db.FetchInvoiceByAddIndex(1)
+	if err != ErrNoInvoicesCreated {
+		t.Fatalf("expected ErrNoInvoicesCreated, got %v", err)
+	}
+
+	var invoices []*Invoice
+	for i := 0; i < 3; i++ {
+		invoice, err := randInvoice(lnwire.MilliSatoshi(1000 + i))
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+		payHash := invoice.Terms.PaymentPreimage.Hash()
+		if _, err := db.AddInvoice(invoice, payHash); err != nil {
+			t.Fatalf("unable to add invoice: %v", err)
+		}
+		invoices = append(invoices, invoice)
+	}
+
+	// Delete the second invoice, leaving a gap within the add index.
+	err = db.DeleteInvoice(invoices[1].Terms.PaymentPreimage.Hash())
+	if err != nil {
+		t.Fatalf("unable to delete invoice: %v", err)
+	}
+
+	for _, addIndex := range []uint64{1, 3} {
+		dbInvoice, err := db.FetchInvoiceByAddIndex(addIndex)
+		if err != nil {
+			t.Fatalf("unable to fetch invoice with add index "+
+				"%v: %v", addIndex, err)
+		}
+		want := invoices[addIndex-1]
+		if dbInvoice.AddIndex != addIndex ||
+			dbInvoice.Terms.PaymentPreimage !=
+				want.Terms.PaymentPreimage {
+
+			t.Fatalf("wrong invoice for add index %v", addIndex)
+		}
+	}
+
+	for _, addIndex := range []uint64{0, 2, 4} {
+		_, err := db.FetchInvoiceByAddIndex(addIndex)
+		if err != ErrInvoiceAddIndexNotFound {
+			t.Fatalf("expected ErrInvoiceAddIndexNotFound for add "+
+				"index %v, got %v", addIndex, err)
+		}
+	}
+}
+
+// TestDeleteExpiredInvoices tests that only expired invoices that are either
+// settled or canceled are removed by DeleteExpiredInvoices.
+func TestDeleteExpiredInvoices(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// Without any invoices, there's nothing to delete.
+	numDeleted, err := db.DeleteExpiredInvoices(time.Now())
+	if err != nil {
+		t.Fatalf("unable to delete expired invoices: %v", err)
+	}
+	if numDeleted != 0 {
+		t.Fatalf("expected no invoices to be deleted, got %v",
+			numDeleted)
+	}
+
+	testCases := []struct {
+		state   ContractState
+		expired bool
+		deleted bool
+	}{
+		{state: ContractOpen, expired: true, deleted: false},
+		{state: ContractAccepted, expired: true, deleted: false},
+		{state: ContractSettled, expired: true, deleted: true},
+		{state: ContractCanceled, expired: true, deleted: true},
+		{state: ContractSettled, expired: false, deleted: false},
+		{state: ContractCanceled, expired: false, deleted: false},
+	}
+
+	var expectedDeleted uint64
+	for i, testCase := range testCases {
+		invoice, err := randInvoice(1000)
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+		invoice.Expiry = time.Hour
+		if testCase.expired {
+			invoice.CreationDate = time.Unix(1000, 0)
+		}
+
+		payHash := invoice.Terms.PaymentPreimage.Hash()
+		if _, err := db.AddInvoice(invoice, payHash); err != nil {
+			t.Fatalf("unable to add invoice: %v", err)
+		}
+
+		switch testCase.state {
+		case ContractOpen:
+		case ContractSettled:
+			_, err = db.UpdateInvoice(
+				payHash, getUpdateInvoice(1000),
+			)
+		default:
+			_, err = db.UpdateInvoice(
+				payHash, getUpdateInvoiceState(testCase.state),
+			)
+		}
+		if err != nil {
+			t.Fatalf("test #%d: unable to update invoice: %v", i,
+				err)
+		}
+
+		if testCase.deleted {
+			expectedDeleted++
+		}
+	}
+
+	numDeleted, err = db.DeleteExpiredInvoices(time.Now())
+	if err != nil {
+		t.Fatalf("unable to delete expired invoices: %v", err)
+	}
+	if numDeleted != expectedDeleted {
+		t.Fatalf("expected %v invoices to be deleted, got %v",
+			expectedDeleted, numDeleted)
+	}
+
+	// The remaining invoices should be found in the add index, while only
+	// the unexpired settled invoice should remain in the settle index.
+	numRemaining := len(testCases) - int(expectedDeleted)
+	assertInvoiceIndexLen(t, db, addIndexBucket, numRemaining)
+	assertInvoiceIndexLen(t, db, settleIndexBucket, 1)
+
+	invoices, err := db.FetchAllInvoices(false)
+	if err != nil {
+		t.Fatalf("unable to fetch invoices: %v", err)
+	}
+	if len(invoices) != numRemaining {
+		t.Fatalf("expected %v invoices, got %v", numRemaining,
+			len(invoices))
+	}
+	for _, invoice := range invoices {
+		if !invoice.CreationDate.Equal(time.Unix(1000, 0)) {
+			continue
+		}
+
+		switch invoice.Terms.State {
+		case ContractSettled, ContractCanceled:
+			t.Fatalf("expired invoice not deleted: %v",
+				spew.Sdump(invoice))
+		}
+	}
+
+	// A second pass shouldn't find anything else to delete.
+	numDeleted, err = db.DeleteExpiredInvoices(time.Now())
+	if err != nil {
+		t.Fatalf("unable to delete expired invoices: %v", err)
+	}
+	if numDeleted != 0 {
+		t.Fatalf("expected no invoices to be deleted, got %v",
+			numDeleted)
+	}
+}
+
+// assertInvoiceIndexLen asserts that the invoice index stored under the passed
+// key holds the expected number of entries.
+func assertInvoiceIndexLen(t *testing.T, db *DB, indexKey []byte,
+	expected int) {
+
+	t.Helper()
+
+	var numEntries int
+	err := db.View(func(tx *bbolt.Tx) error {
+		invoices := tx.Bucket(invoiceBucket)
+		if invoices == nil {
+			return ErrNoInvoicesCreated
+		}
+		index := invoices.Bucket(indexKey)
+		if index == nil {
+			return nil
+		}
+
+		return index.ForEach(func(_, _ []byte) error {
+			numEntries++
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("unable to read index %s: %v", indexKey, err)
+	}
+
+	if numEntries != expected {
+		t.Fatalf("expected %v entries within index %s, got %v",
+			expected, indexKey, numEntries)
+	}
+}
+
+// TestForEachInvoice asserts that ForEachInvoice visits every invoice within
+// the database, and halts once the closure returns an error.
+func TestForEachInvoice(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	const numInvoices = 5
+	invoices := make(map[lntypes.Hash]*Invoice)
+	for i := 0; i < numInvoices; i++ {
+		invoice, err := randInvoice(lnwire.MilliSatoshi(i + 1))
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+		payHash := invoice.Terms.PaymentPreimage.Hash()
+		if _, err := db.AddInvoice(invoice, payHash); err != nil {
+			t.Fatalf("unable to add invoice: %v", err)
+		}
+
+		// We'll settle the first invoice, such that it carries an htlc.
+		if i == 0 {
+			_, err := db.UpdateInvoice(payHash, getUpdateInvoice(1))
+			if err != nil {
+				t.Fatalf("unable to settle invoice: %v", err)
+			}
+		}
+
+		dbInvoice, err := db.LookupInvoice(payHash)
+		if err != nil {
+			t.Fatalf("unable to lookup invoice: %v", err)
+		}
+		invoices[payHash] = &dbInvoice
+	}
+
+	seen := make(map[lntypes.Hash]struct{})
+	err = db.ForEachInvoice(func(invoice Invoice) error {
+		payHash := invoice.Terms.PaymentPreimage.Hash()
+		expected, ok := invoices[payHash]
+		if !ok {
+			t.Fatalf("unknown invoice %v", payHash)
+		}
+		if !reflect.DeepEqual(*expected, invoice) {
+			t.Fatalf("invoice mismatch: expected %v, got %v",
+				spew.Sdump(expected), spew.Sdump(invoice))
+		}
+		seen[payHash] = struct{}{}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to iterate invoices: %v", err)
+	}
+	if len(seen) != numInvoices {
+		t.Fatalf("expected %v invoices, got %v", numInvoices, len(seen))
+	}
+
+	// Returning an error from the closure should halt the iteration.
+	errStop := fmt.Errorf("stop")
+	var numSeen int
+	err = db.ForEachInvoice(func(Invoice) error {
+		numSeen++
+		return errStop
+	})
+	if err != errStop {
+		t.Fatalf("expected errStop, got %v", err)
+	}
+	if numSeen != 1 {
+		t.Fatalf("expected iteration to halt after 1 invoice, got %v",
+			numSeen)
+	}
+}
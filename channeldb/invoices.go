@@ -77,6 +77,11 @@ var (
 
 	// ErrInvoiceStillOpen is returned when the invoice is still open.
 	ErrInvoiceStillOpen = errors.New("invoice still open")
+
+	// ErrInvoiceAddIndexNotFound is returned when no invoice is stored
+	// under the targeted add index, either because it's yet to be assigned
+	// or because the invoice it was assigned to has since been deleted.
+	ErrInvoiceAddIndexNotFound = errors.New("no invoice with add index")
 )
 
 const (
@@ -416,7 +421,7 @@ func (d *DB) InvoicesAddedSince(sinceAddIndex uint64) ([]Invoice, error) {
 	var startIndex [8]byte
 	byteOrder.PutUint64(startIndex[:], sinceAddIndex)
 
-	err := d.DB.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx *bbolt.Tx) error {
 		invoices := tx.Bucket(invoiceBucket)
 		if invoices == nil {
 			return ErrNoInvoicesCreated
@@ -505,13 +510,74 @@ func (d *DB) LookupInvoice(paymentHash [32]byte) (Invoice, error) {
 	return invoice, nil
 }
 
+// FetchInvoiceByAddIndex looks up the invoice that was assigned the passed add
+// index through a direct seek into the add index, allowing callers tracking
+// the add index, such as streaming consumers resuming from a checkpoint, to
+// fetch a single invoice without scanning. If no invoice is found under the
+// add index, then ErrInvoiceAddIndexNotFound is returned.
+func (d *DB) FetchInvoiceByAddIndex(addIndex uint64) (Invoice, error) {
+	var invoice Invoice
+	err := d.View(func(tx *bbolt.Tx) error {
+		invoices := tx.Bucket(invoiceBucket)
+		if invoices == nil {
+			return ErrNoInvoicesCreated
+		}
+		addIndexes := invoices.Bucket(addIndexBucket)
+		if addIndexes == nil {
+			return ErrNoInvoicesCreated
+		}
+
+		var addIndexKey [8]byte
+		byteOrder.PutUint64(addIndexKey[:], addIndex)
+		invoiceNum := addIndexes.Get(addIndexKey[:])
+		if invoiceNum == nil {
+			return ErrInvoiceAddIndexNotFound
+		}
+
+		i, err := fetchInvoice(invoiceNum, invoices)
+		if err != nil {
+			return err
+		}
+		invoice = i
+
+		return nil
+	})
+	if err != nil {
+		return invoice, err
+	}
+
+	return invoice, nil
+}
+
 // FetchAllInvoices returns all invoices currently stored within the database.
 // If the pendingOnly param is true, then only unsettled invoices will be
 // returned, skipping all invoices that are fully settled.
 func (d *DB) FetchAllInvoices(pendingOnly bool) ([]Invoice, error) {
 	var invoices []Invoice
+	err := d.ForEachInvoice(func(invoice Invoice) error {
+		if pendingOnly && invoice.Terms.State == ContractSettled {
+			return nil
+		}
 
-	err := d.View(func(tx *bbolt.Tx) error {
+		invoices = append(invoices, invoice)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return invoices, nil
+}
+
+// ForEachInvoice calls the passed closure for each invoice stored within the
+// database. Invoices are decoded one at a time within a single read-only
+// transaction, so even a very large invoice history doesn't need to be held
+// in memory at once. If the closure returns an error, then the iteration is
+// halted and the error is returned to the caller. If no invoices have been
+// created yet, then ErrNoInvoicesCreated is returned.
+func (d *DB) ForEachInvoice(cb func(Invoice) error) error {
+	return d.View(func(tx *bbolt.Tx) error {
 		invoiceB := tx.Bucket(invoiceBucket)
 		if invoiceB == nil {
 			return ErrNoInvoicesCreated
@@ -531,22 +597,9 @@ func (d *DB) FetchAllInvoices(pendingOnly bool) ([]Invoice, error) {
 				return err
 			}
 
-			if pendingOnly &&
-				invoice.Terms.State == ContractSettled {
-
-				return nil
-			}
-
-			invoices = append(invoices, invoice)
-
-			return nil
+			return cb(invoice)
 		})
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	return invoices, nil
 }
 
 // InvoiceQuery represents a query to the invoice database. The query allows a
@@ -767,6 +820,160 @@ func (d *DB) UpdateInvoice(paymentHash lntypes.Hash,
 	return updatedInvoice, err
 }
 
+// DeleteInvoice removes the invoice with the passed payment hash from the
+// database, along with its entries within the payment hash, add and settle
+// indexes. Invoices that have been accepted can't be deleted, as their HTLCs
+// are yet to be resolved, in which case ErrInvoiceAlreadyAccepted is returned.
+func (d *DB) DeleteInvoice(paymentHash lntypes.Hash) error {
+	return d.Update(func(tx *bbolt.Tx) error {
+		invoices := tx.Bucket(invoiceBucket)
+		if invoices == nil {
+			return ErrNoInvoicesCreated
+		}
+		invoiceIndex := invoices.Bucket(invoiceIndexBucket)
+		if invoiceIndex == nil {
+			return ErrNoInvoicesCreated
+		}
+
+		invoiceNum := invoiceIndex.Get(paymentHash[:])
+		if invoiceNum == nil {
+			return ErrInvoiceNotFound
+		}
+
+		invoice, err := fetchInvoice(invoiceNum, invoices)
+		if err != nil {
+			return err
+		}
+
+		if invoice.Terms.State == ContractAccepted {
+			return ErrInvoiceAlreadyAccepted
+		}
+
+		return deleteInvoice(
+			invoices, invoiceIndex, paymentHash[:],
+			copySlice(invoiceNum), &invoice,
+		)
+	})
+}
+
+// DeleteExpiredInvoices removes all settled and canceled invoices that expired
+// before the passed time from the database, along with their entries within
+// the payment hash, add and settle indexes. Open and accepted invoices are
+// never removed. The number of deleted invoices is returned.
+func (d *DB) DeleteExpiredInvoices(before time.Time) (uint64, error) {
+	var numDeleted uint64
+	err := d.Update(func(tx *bbolt.Tx) error {
+		numDeleted = 0
+
+		invoices := tx.Bucket(invoiceBucket)
+		if invoices == nil {
+			return nil
+		}
+		invoiceIndex := invoices.Bucket(invoiceIndexBucket)
+		if invoiceIndex == nil {
+			return nil
+		}
+
+		type expiredInvoice struct {
+			payHash    []byte
+			invoiceNum []byte
+			invoice    Invoice
+		}
+
+		// We'll first collect the expired invoices by scanning the
+		// payment hash index, as it's required to remove their
+		// entries from it, and we can't modify it while iterating
+		// over it.
+		var expired []expiredInvoice
+		err := invoiceIndex.ForEach(func(payHash,
+			invoiceNum []byte) error {
+
+			// Skip the invoice counter, which is stored within
+			// the index as well.
+			if len(payHash) != lntypes.HashSize {
+				return nil
+			}
+
+			invoice, err := fetchInvoice(invoiceNum, invoices)
+			if err != nil {
+				return err
+			}
+
+			switch invoice.Terms.State {
+			case ContractSettled, ContractCanceled:
+			default:
+				return nil
+			}
+
+			expiry := invoice.CreationDate.Add(invoice.Expiry)
+			if !expiry.Before(before) {
+				return nil
+			}
+
+			expired = append(expired, expiredInvoice{
+				payHash:    copySlice(payHash),
+				invoiceNum: copySlice(invoiceNum),
+				invoice:    invoice,
+			})
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, e := range expired {
+			err := deleteInvoice(
+				invoices, invoiceIndex, e.payHash,
+				e.invoiceNum, &e.invoice,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		numDeleted = uint64(len(expired))
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numDeleted, nil
+}
+
+// deleteInvoice removes the passed invoice, stored under the passed invoice
+// number and payment hash, along with its entries within the invoice indexes.
+//
+// NOTE: The payment hash and invoice number must not point into the database,
+// as they may be invalidated by the deletion of the entries they point to.
+func deleteInvoice(invoices, invoiceIndex *bbolt.Bucket, payHash,
+	invoiceNum []byte, invoice *Invoice) error {
+
+	if addIndex := invoices.Bucket(addIndexBucket); addIndex != nil {
+		var addIndexKey [8]byte
+		byteOrder.PutUint64(addIndexKey[:], invoice.AddIndex)
+		if err := addIndex.Delete(addIndexKey[:]); err != nil {
+			return err
+		}
+	}
+
+	settleIndex := invoices.Bucket(settleIndexBucket)
+	if settleIndex != nil && invoice.SettleIndex != 0 {
+		var settleIndexKey [8]byte
+		byteOrder.PutUint64(settleIndexKey[:], invoice.SettleIndex)
+		if err := settleIndex.Delete(settleIndexKey[:]); err != nil {
+			return err
+		}
+	}
+
+	if err := invoiceIndex.Delete(payHash); err != nil {
+		return err
+	}
+
+	return invoices.Delete(invoiceNum)
+}
+
 // InvoicesSettledSince can be used by callers to catch up any settled invoices
 // they missed within the settled invoice time series. We'll return all known
 // settled invoice that have a settle index higher than the passed
@@ -786,7 +993,7 @@ func (d *DB) InvoicesSettledSince(sinceSettleIndex uint64) ([]Invoice, error) {
 	var startIndex [8]byte
 	byteOrder.PutUint64(startIndex[:], sinceSettleIndex)
 
-	err := d.DB.View(func(tx *bbolt.Tx) error {
+	err := d.View(func(tx *bbolt.Tx) error {
 		invoices := tx.Bucket(invoiceBucket)
 		if invoices == nil {
 			return ErrNoInvoicesCreated
@@ -0,0 +1,564 @@
+package channeldb
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/coreos/bbolt"
+)
+
+// jsonExportVersion is the version of the JSON schema produced by ExportJSON.
+// It should be bumped whenever the schema changes in a way that older
+// versions of ImportJSON can't handle.
+const jsonExportVersion = 1
+
+// jsonExport is the top-level document produced by ExportJSON. Every record is
+// accompanied by its canonical channeldb encoding, which is what's restored
+// on import, such that an export is lossless. The remaining fields describe
+// the record in a human readable form and are ignored on import.
+type jsonExport struct {
+	// Version is the version of the JSON schema of the export.
+	Version uint32 `json:"version"`
+
+	// DBVersion is the version of the database the export was taken from.
+	// An export can only be imported into a database of the same version.
+	DBVersion uint32 `json:"db_version"`
+
+	// OpenChannels are the open and pending channels within the database.
+	OpenChannels []jsonOpenChannel `json:"open_channels"`
+
+	// ClosedChannels are the close summaries within the database.
+	ClosedChannels []jsonClosedChannel `json:"closed_channels"`
+
+	// LinkNodes are the link nodes within the database.
+	LinkNodes []jsonLinkNode `json:"link_nodes"`
+
+	// Invoices is the raw contents of the invoice bucket. As the bucket
+	// also houses the invoice indexes, it's exported as is in order to
+	// preserve the add and settle indexes of each invoice.
+	Invoices *jsonBucket `json:"invoices,omitempty"`
+
+	// ForwardingPackages is the raw contents of the forwarding package
+	// bucket, which houses the forwarding packages of the open channels.
+	ForwardingPackages *jsonBucket `json:"forwarding_packages,omitempty"`
+}
+
+// jsonOpenChannel describes an open channel within an export.
+type jsonOpenChannel struct {
+	NodePub     string `json:"node_pub"`
+	ChainHash   string `json:"chain_hash"`
+	ChanPoint   string `json:"chan_point"`
+	ShortChanID uint64 `json:"short_chan_id"`
+	Capacity    int64  `json:"capacity"`
+	IsPending   bool   `json:"is_pending"`
+	ChanStatus  string `json:"chan_status"`
+
+	// State is the raw contents of the bucket of the channel, including
+	// its revocation log.
+	State *jsonBucket `json:"state"`
+}
+
+// jsonClosedChannel describes a channel close summary within an export.
+type jsonClosedChannel struct {
+	ChanPoint      string `json:"chan_point"`
+	ShortChanID    uint64 `json:"short_chan_id"`
+	ClosingTXID    string `json:"closing_txid"`
+	CloseHeight    uint32 `json:"close_height"`
+	RemotePub      string `json:"remote_pub"`
+	Capacity       int64  `json:"capacity"`
+	SettledBalance int64  `json:"settled_balance"`
+	CloseType      uint8  `json:"close_type"`
+	IsPending      bool   `json:"is_pending"`
+
+	// Summary is the hex encoded serialized close summary.
+	Summary string `json:"summary"`
+}
+
+// jsonLinkNode describes a link node within an export.
+type jsonLinkNode struct {
+	IdentityPub string   `json:"identity_pub"`
+	Network     string   `json:"network"`
+	LastSeen    int64    `json:"last_seen"`
+	Addresses   []string `json:"addresses"`
+
+	// Node is the hex encoded serialized link node.
+	Node string `json:"node"`
+}
+
+// jsonBucket holds the raw contents of a bucket, with all keys and values hex
+// encoded.
+type jsonBucket struct {
+	Key      string        `json:"key"`
+	Sequence uint64        `json:"sequence,omitempty"`
+	Records  []jsonRecord  `json:"records,omitempty"`
+	Buckets  []*jsonBucket `json:"buckets,omitempty"`
+}
+
+// jsonRecord is a single key/value pair within a jsonBucket.
+type jsonRecord struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ExportJSON writes the open channels, channel close summaries, link nodes and
+// invoices within the database to the passed writer as a JSON document, which
+// can be restored into another database using ImportJSON. The export is taken
+// within a single read transaction, so it reflects a consistent view of the
+// database.
+func (d *DB) ExportJSON(w io.Writer) error {
+	export := &jsonExport{
+		Version: jsonExportVersion,
+	}
+	err := d.View(func(tx *bbolt.Tx) error {
+		meta, err := d.FetchMeta(tx)
+		if err != nil {
+			return err
+		}
+		export.DBVersion = meta.DbVersionNumber
+
+		if err := exportOpenChannels(tx, export); err != nil {
+			return err
+		}
+		if err := exportClosedChannels(tx, export); err != nil {
+			return err
+		}
+		if err := exportLinkNodes(tx, export); err != nil {
+			return err
+		}
+
+		if invoices := tx.Bucket(invoiceBucket); invoices != nil {
+			export.Invoices, err = exportBucket(
+				invoiceBucket, invoices,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		if fwdPkgs := tx.Bucket(fwdPackagesKey); fwdPkgs != nil {
+			export.ForwardingPackages, err = exportBucket(
+				fwdPackagesKey, fwdPkgs,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(export)
+}
+
+// ImportJSON restores the contents of a JSON document produced by ExportJSON
+// into the database within a single transaction. If the database already
+// contains any open channels, close summaries, link nodes or invoices, then
+// ErrDBNotEmpty is returned unless force is set, in which case the existing
+// records are replaced by those of the export. The open height index is
+// rebuilt from the imported channels, while any channel aliases are removed.
+func (d *DB) ImportJSON(r io.Reader, force bool) error {
+	var export jsonExport
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return fmt.Errorf("unable to decode export: %v", err)
+	}
+
+	if export.Version != jsonExportVersion {
+		return fmt.Errorf("unknown export version %v",
+			export.Version)
+	}
+
+	return d.Update(func(tx *bbolt.Tx) error {
+		meta, err := d.FetchMeta(tx)
+		if err != nil {
+			return err
+		}
+		if meta.DbVersionNumber != export.DBVersion {
+			return fmt.Errorf("export of db version %v can't be "+
+				"imported into db version %v", export.DBVersion,
+				meta.DbVersionNumber)
+		}
+
+		buckets := [][]byte{
+			openChannelBucket, closedChannelBucket, nodeInfoBucket,
+			invoiceBucket, fwdPackagesKey,
+		}
+		for _, bucket := range buckets {
+			if !force && !isBucketEmpty(tx.Bucket(bucket)) {
+				return ErrDBNotEmpty
+			}
+
+			err := tx.DeleteBucket(bucket)
+			if err != nil && err != bbolt.ErrBucketNotFound {
+				return err
+			}
+			if _, err := tx.CreateBucket(bucket); err != nil {
+				return err
+			}
+		}
+
+		// The indexes of open channels are derived from the channels
+		// themselves, so we'll clear them before rebuilding the open
+		// height index from the imported channels below. Aliases
+		// aren't part of the export, so they're dropped along with the
+		// channels they were assigned to.
+		err = tx.DeleteBucket(chanOpenHeightBucket)
+		if err != nil && err != bbolt.ErrBucketNotFound {
+			return err
+		}
+		if _, err := tx.CreateBucket(chanOpenHeightBucket); err != nil {
+			return err
+		}
+		err = tx.DeleteBucket(channelAliasBucket)
+		if err != nil && err != bbolt.ErrBucketNotFound {
+			return err
+		}
+
+		if err := importOpenChannels(tx, &export); err != nil {
+			return err
+		}
+		if err := importClosedChannels(tx, &export); err != nil {
+			return err
+		}
+		if err := importLinkNodes(tx, &export); err != nil {
+			return err
+		}
+
+		if export.Invoices != nil {
+			err := importBucket(
+				tx.Bucket(invoiceBucket), export.Invoices,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		if export.ForwardingPackages != nil {
+			err := importBucket(
+				tx.Bucket(fwdPackagesKey), export.ForwardingPackages,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// exportOpenChannels adds all open channels to the passed export.
+func exportOpenChannels(tx *bbolt.Tx, export *jsonExport) error {
+	openChanBucket := tx.Bucket(openChannelBucket)
+	if openChanBucket == nil {
+		return nil
+	}
+
+	return forEachChanBucket(openChanBucket, func(nodePub,
+		chanPoint []byte, chanBucket *bbolt.Bucket) error {
+
+		var channel OpenChannel
+		if err := fetchChanInfo(chanBucket, &channel); err != nil {
+			return fmt.Errorf("unable to read channel info for "+
+				"chan_point=%x: %v", chanPoint, err)
+		}
+
+		state, err := exportBucket(chanPoint, chanBucket)
+		if err != nil {
+			return err
+		}
+
+		export.OpenChannels = append(export.OpenChannels, jsonOpenChannel{
+			NodePub:     hex.EncodeToString(nodePub),
+			ChainHash:   channel.ChainHash.String(),
+			ChanPoint:   channel.FundingOutpoint.String(),
+			ShortChanID: channel.ShortChannelID.ToUint64(),
+			Capacity:    int64(channel.Capacity),
+			IsPending:   channel.IsPending,
+			ChanStatus:  channel.chanStatus.String(),
+			State:       state,
+		})
+
+		return nil
+	})
+}
+
+// importOpenChannels restores the open channels of the passed export.
+func importOpenChannels(tx *bbolt.Tx, export *jsonExport) error {
+	openChanBucket := tx.Bucket(openChannelBucket)
+	for _, channel := range export.OpenChannels {
+		nodePub, err := hex.DecodeString(channel.NodePub)
+		if err != nil {
+			return err
+		}
+		chainHash, err := chainhash.NewHashFromStr(channel.ChainHash)
+		if err != nil {
+			return err
+		}
+		if channel.State == nil {
+			return fmt.Errorf("no state found for channel %v",
+				channel.ChanPoint)
+		}
+
+		nodeChanBucket, err := openChanBucket.CreateBucketIfNotExists(
+			nodePub,
+		)
+		if err != nil {
+			return err
+		}
+		chainBucket, err := nodeChanBucket.CreateBucketIfNotExists(
+			chainHash[:],
+		)
+		if err != nil {
+			return err
+		}
+
+		err = importNestedBucket(chainBucket, channel.State)
+		if err != nil {
+			return fmt.Errorf("unable to import channel %v: %v",
+				channel.ChanPoint, err)
+		}
+	}
+
+	// With all channels restored, we'll add those that are no longer
+	// pending to the open height index.
+	return forEachChanBucket(openChanBucket, func(_, chanPoint []byte,
+		chanBucket *bbolt.Bucket) error {
+
+		var channel OpenChannel
+		if err := fetchChanInfo(chanBucket, &channel); err != nil {
+			return fmt.Errorf("unable to read channel info for "+
+				"chan_point=%x: %v", chanPoint, err)
+		}
+		if channel.IsPending {
+			return nil
+		}
+
+		return putChanOpenHeight(tx, &channel)
+	})
+}
+
+// exportClosedChannels adds all channel close summaries to the passed export.
+func exportClosedChannels(tx *bbolt.Tx, export *jsonExport) error {
+	closedChanBucket := tx.Bucket(closedChannelBucket)
+	if closedChanBucket == nil {
+		return nil
+	}
+
+	return closedChanBucket.ForEach(func(k, v []byte) error {
+		summary, err := deserializeCloseChannelSummary(
+			bytes.NewReader(v),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to read close summary for "+
+				"chan_point=%x: %v", k, err)
+		}
+
+		export.ClosedChannels = append(
+			export.ClosedChannels, jsonClosedChannel{
+				ChanPoint:   summary.ChanPoint.String(),
+				ShortChanID: summary.ShortChanID.ToUint64(),
+				ClosingTXID: summary.ClosingTXID.String(),
+				CloseHeight: summary.CloseHeight,
+				RemotePub: hex.EncodeToString(
+					summary.RemotePub.SerializeCompressed(),
+				),
+				Capacity:       int64(summary.Capacity),
+				SettledBalance: int64(summary.SettledBalance),
+				CloseType:      uint8(summary.CloseType),
+				IsPending:      summary.IsPending,
+				Summary:        hex.EncodeToString(v),
+			},
+		)
+
+		return nil
+	})
+}
+
+// importClosedChannels restores the channel close summaries of the passed
+// export.
+func importClosedChannels(tx *bbolt.Tx, export *jsonExport) error {
+	closedChanBucket := tx.Bucket(closedChannelBucket)
+	for _, closed := range export.ClosedChannels {
+		summaryBytes, err := hex.DecodeString(closed.Summary)
+		if err != nil {
+			return err
+		}
+
+		// The summary is decoded in order to validate it, and to
+		// obtain the channel point it should be keyed by.
+		summary, err := deserializeCloseChannelSummary(
+			bytes.NewReader(summaryBytes),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode close summary "+
+				"for channel %v: %v", closed.ChanPoint, err)
+		}
+
+		var k bytes.Buffer
+		if err := writeOutpoint(&k, &summary.ChanPoint); err != nil {
+			return err
+		}
+
+		err = closedChanBucket.Put(k.Bytes(), summaryBytes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportLinkNodes adds all link nodes to the passed export.
+func exportLinkNodes(tx *bbolt.Tx, export *jsonExport) error {
+	nodeMetaBucket := tx.Bucket(nodeInfoBucket)
+	if nodeMetaBucket == nil {
+		return nil
+	}
+
+	return nodeMetaBucket.ForEach(func(k, v []byte) error {
+		if v == nil {
+			return nil
+		}
+
+		node, err := deserializeLinkNode(bytes.NewReader(v))
+		if err != nil {
+			return fmt.Errorf("unable to read link node %x: %v",
+				k, err)
+		}
+
+		addrs := make([]string, 0, len(node.Addresses))
+		for _, addr := range node.Addresses {
+			addrs = append(addrs, addr.String())
+		}
+
+		export.LinkNodes = append(export.LinkNodes, jsonLinkNode{
+			IdentityPub: hex.EncodeToString(k),
+			Network:     node.Network.String(),
+			LastSeen:    node.LastSeen.Unix(),
+			Addresses:   addrs,
+			Node:        hex.EncodeToString(v),
+		})
+
+		return nil
+	})
+}
+
+// importLinkNodes restores the link nodes of the passed export.
+func importLinkNodes(tx *bbolt.Tx, export *jsonExport) error {
+	nodeMetaBucket := tx.Bucket(nodeInfoBucket)
+	for _, linkNode := range export.LinkNodes {
+		nodeBytes, err := hex.DecodeString(linkNode.Node)
+		if err != nil {
+			return err
+		}
+
+		node, err := deserializeLinkNode(bytes.NewReader(nodeBytes))
+		if err != nil {
+			return fmt.Errorf("unable to decode link node %v: %v",
+				linkNode.IdentityPub, err)
+		}
+
+		if err := putLinkNode(nodeMetaBucket, node); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportBucket returns the raw contents of the passed bucket, which is stored
+// under the passed key, including those of all nested buckets.
+func exportBucket(key []byte, bucket *bbolt.Bucket) (*jsonBucket, error) {
+	b := &jsonBucket{
+		Key:      hex.EncodeToString(key),
+		Sequence: bucket.Sequence(),
+	}
+	err := bucket.ForEach(func(k, v []byte) error {
+		if v != nil {
+			b.Records = append(b.Records, jsonRecord{
+				Key:   hex.EncodeToString(k),
+				Value: hex.EncodeToString(v),
+			})
+			return nil
+		}
+
+		nested, err := exportBucket(k, bucket.Bucket(k))
+		if err != nil {
+			return err
+		}
+		b.Buckets = append(b.Buckets, nested)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// importNestedBucket creates the exported bucket within the passed parent
+// bucket and restores its contents.
+func importNestedBucket(parent *bbolt.Bucket, b *jsonBucket) error {
+	key, err := hex.DecodeString(b.Key)
+	if err != nil {
+		return err
+	}
+
+	bucket, err := parent.CreateBucketIfNotExists(key)
+	if err != nil {
+		return err
+	}
+
+	return importBucket(bucket, b)
+}
+
+// importBucket restores the exported contents of a bucket, including those of
+// all nested buckets, into the passed bucket.
+func importBucket(bucket *bbolt.Bucket, b *jsonBucket) error {
+	for _, record := range b.Records {
+		k, err := hex.DecodeString(record.Key)
+		if err != nil {
+			return err
+		}
+		v, err := hex.DecodeString(record.Value)
+		if err != nil {
+			return err
+		}
+
+		if err := bucket.Put(k, v); err != nil {
+			return err
+		}
+	}
+
+	for _, nested := range b.Buckets {
+		if err := importNestedBucket(bucket, nested); err != nil {
+			return err
+		}
+	}
+
+	return bucket.SetSequence(b.Sequence)
+}
+
+// isBucketEmpty returns true if the passed bucket holds no records, either
+// directly or within any of its nested buckets. A nil bucket is empty.
+func isBucketEmpty(bucket *bbolt.Bucket) bool {
+	if bucket == nil {
+		return true
+	}
+
+	cursor := bucket.Cursor()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		if v != nil || !isBucketEmpty(bucket.Bucket(k)) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,212 @@
+package channeldb
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestExportImportJSON asserts that the contents of a database exported as
+// JSON can be imported into a fresh database, and that importing into a
+// non-empty database requires the import to be forced.
+func TestExportImportJSON(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+
+	// We'll populate the database with an open channel, a pending
+	// channel, a closed channel and an invoice. The link node of the
+	// channel peer is created along the way.
+	for i := 0; i < 3; i++ {
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+
+		switch i {
+		case 0:
+			err = channel.MarkAsOpen(lnwire.NewShortChanIDFromInt(9))
+		case 2:
+			err = channel.CloseChannel(&ChannelCloseSummary{
+				ChanPoint:   channel.FundingOutpoint,
+				RemotePub:   channel.IdentityPub,
+				CloseHeight: 100,
+				Capacity:    channel.Capacity,
+			})
+		}
+		if err != nil {
+			t.Fatalf("unable to transition channel: %v", err)
+		}
+	}
+
+	invoice, err := randInvoice(1000)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	payHash := invoice.Terms.PaymentPreimage.Hash()
+	if _, err := cdb.AddInvoice(invoice, payHash); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	var export bytes.Buffer
+	if err := cdb.ExportJSON(&export); err != nil {
+		t.Fatalf("unable to export database: %v", err)
+	}
+
+	newDB, newCleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer newCleanUp()
+
+	err = newDB.ImportJSON(bytes.NewReader(export.Bytes()), false)
+	if err != nil {
+		t.Fatalf("unable to import database: %v", err)
+	}
+
+	assertEqual := func(name string, fetch func(d *DB) (interface{},
+		error)) {
+
+		t.Helper()
+
+		expected, err := fetch(cdb)
+		if err != nil {
+			t.Fatalf("unable to fetch %v: %v", name, err)
+		}
+		imported, err := fetch(newDB)
+		if err != nil {
+			t.Fatalf("unable to fetch imported %v: %v", name, err)
+		}
+		if !reflect.DeepEqual(expected, imported) {
+			t.Fatalf("%v mismatch: expected %v, got %v", name,
+				spew.Sdump(expected), spew.Sdump(imported))
+		}
+	}
+
+	assertEqual("channels", func(d *DB) (interface{}, error) {
+		channels, err := d.FetchAllChannels()
+		if err != nil {
+			return nil, err
+		}
+
+		// The channels refer to the database they were read from,
+		// which we'll strip before comparing them.
+		for _, channel := range channels {
+			channel.Db = nil
+		}
+		return channels, nil
+	})
+	assertEqual("opened channels", func(d *DB) (interface{}, error) {
+		channels, err := d.FetchChannelsOpenedInRange(0, ^uint32(0))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, channel := range channels {
+			channel.Db = nil
+		}
+		return channels, nil
+	})
+	assertEqual("closed channels", func(d *DB) (interface{}, error) {
+		return d.FetchClosedChannels(false)
+	})
+	assertEqual("link nodes", func(d *DB) (interface{}, error) {
+		nodes, err := d.FetchAllLinkNodes()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range nodes {
+			node.db = nil
+		}
+		return nodes, nil
+	})
+	assertEqual("invoices", func(d *DB) (interface{}, error) {
+		return d.FetchAllInvoices(false)
+	})
+
+	// The add index of the invoice should have been preserved, such that
+	// the next invoice is assigned the following index.
+	invoice, err = randInvoice(1000)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	payHash = invoice.Terms.PaymentPreimage.Hash()
+	addIndex, err := newDB.AddInvoice(invoice, payHash)
+	if err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+	if addIndex != 2 {
+		t.Fatalf("expected add index 2, got %v", addIndex)
+	}
+
+	// We'll also open a channel with an alias within the new database,
+	// which should be removed from the channel indexes once the import
+	// is forced.
+	channel, err := createTestChannelState(newDB)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	if err := channel.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+	err = channel.MarkAsOpen(lnwire.NewShortChanIDFromInt(10))
+	if err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+	err = newDB.SetChannelAlias(channel.FundingOutpoint, "alias")
+	if err != nil {
+		t.Fatalf("unable to set channel alias: %v", err)
+	}
+
+	// Now that the new database is no longer empty, importing into it
+	// should fail unless forced.
+	err = newDB.ImportJSON(bytes.NewReader(export.Bytes()), false)
+	if err != ErrDBNotEmpty {
+		t.Fatalf("expected ErrDBNotEmpty, got: %v", err)
+	}
+	err = newDB.ImportJSON(bytes.NewReader(export.Bytes()), true)
+	if err != nil {
+		t.Fatalf("unable to force import: %v", err)
+	}
+
+	// The forced import should have replaced the invoice added after the
+	// initial import.
+	assertEqual("invoices", func(d *DB) (interface{}, error) {
+		return d.FetchAllInvoices(false)
+	})
+
+	// The channel opened after the initial import should no longer be
+	// indexed.
+	assertEqual("opened channels", func(d *DB) (interface{}, error) {
+		channels, err := d.FetchChannelsOpenedInRange(0, ^uint32(0))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, channel := range channels {
+			channel.Db = nil
+		}
+		return channels, nil
+	})
+	_, err = newDB.FetchChannelByAlias("alias")
+	if err != ErrChannelAliasNotFound {
+		t.Fatalf("expected ErrChannelAliasNotFound, got: %v", err)
+	}
+}
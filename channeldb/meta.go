@@ -1,6 +1,13 @@
 package channeldb
 
-import "github.com/coreos/bbolt"
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/coreos/bbolt"
+)
 
 var (
 	// metaBucket stores all the meta information concerning the state of
@@ -10,6 +17,20 @@ var (
 	// dbVersionKey is a boltdb key and it's used for storing/retrieving
 	// current database version.
 	dbVersionKey = []byte("dbp")
+
+	// appliedMigrationFixesBucket is a sub-bucket of the meta bucket which
+	// records the versions whose migration override has been applied,
+	// see OptionSetMigrationOverride.
+	//
+	// appliedMigrationFixes -> version -> empty
+	appliedMigrationFixesBucket = []byte("applied-migration-fixes")
+)
+
+const (
+	// readDBVersionTimeout is the amount of time ReadDBVersion will wait
+	// to obtain the database file lock, which is held exclusively while
+	// the database is opened elsewhere for writing.
+	readDBVersionTimeout = time.Second
 )
 
 // Meta structure holds the database meta information.
@@ -52,6 +73,87 @@ func fetchMeta(meta *Meta, tx *bbolt.Tx) error {
 	return nil
 }
 
+// ReadDBVersion returns the on-disk schema version of the channel database
+// within dbPath. The database file is opened read-only, and no migrations are
+// run, nor is the channel graph instantiated. A database that predates the
+// meta bucket is reported as version 0. If the database is held open
+// elsewhere, then ErrDatabaseLocked is returned.
+func ReadDBVersion(dbPath string) (uint32, error) {
+	meta, err := readDBMeta(dbPath)
+	switch {
+	case err == ErrMetaNotFound:
+		return 0, nil
+
+	case err != nil:
+		return 0, err
+	}
+
+	return meta.DbVersionNumber, nil
+}
+
+// CheckDBVersion opens the channel database within dbPath read-only, and
+// checks its on-disk schema version against the latest version known to this
+// code. ErrMetaNotFound is returned if the database was never initialized,
+// ErrDBNeedsMigration if it's behind the latest version, and ErrDBReversion if
+// it was written by a future version. A nil error means the database can be
+// used as is.
+func CheckDBVersion(dbPath string) error {
+	meta, err := readDBMeta(dbPath)
+	if err != nil {
+		return err
+	}
+
+	return checkDBVersion(meta.DbVersionNumber)
+}
+
+// checkDBVersion maps the passed on-disk schema version to ErrDBNeedsMigration
+// or ErrDBReversion, depending on whether it's behind or ahead of the latest
+// version.
+func checkDBVersion(dbVersion uint32) error {
+	latestVersion := getLatestDBVersion(dbVersions)
+	switch {
+	case dbVersion < latestVersion:
+		return ErrDBNeedsMigration
+
+	case dbVersion > latestVersion:
+		return ErrDBReversion
+	}
+
+	return nil
+}
+
+// readDBMeta reads the metadata of the channel database within dbPath, which
+// is opened read-only for the duration of the read.
+func readDBMeta(dbPath string) (*Meta, error) {
+	path := filepath.Join(dbPath, dbName)
+	if !fileExists(path) {
+		return nil, ErrNoChanDBExists
+	}
+
+	bdb, err := bbolt.Open(path, dbFilePermission, &bbolt.Options{
+		ReadOnly: true,
+		Timeout:  readDBVersionTimeout,
+	})
+	switch {
+	case err == bbolt.ErrTimeout:
+		return nil, ErrDatabaseLocked
+
+	case err != nil:
+		return nil, err
+	}
+	defer bdb.Close()
+
+	meta := &Meta{}
+	err = bdb.View(func(tx *bbolt.Tx) error {
+		return fetchMeta(meta, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return meta, nil
+}
+
 // PutMeta writes the passed instance of the database met-data struct to disk.
 func (d *DB) PutMeta(meta *Meta) error {
 	return d.Update(func(tx *bbolt.Tx) error {
@@ -59,6 +161,38 @@ func (d *DB) PutMeta(meta *Meta) error {
 	})
 }
 
+// SetDBVersion overwrites the version number of the database, without applying
+// or reverting any migrations. This allows operators recovering from a
+// manually repaired or half-applied migration to realign the version number
+// with the actual state of the database. As setting the wrong version can
+// cause migrations to be skipped or re-applied, this is refused unless force
+// is set. The version must be one known to this code.
+func (d *DB) SetDBVersion(version uint32, force bool) error {
+	if !force {
+		return ErrSetDBVersionUnforced
+	}
+
+	latestVersion := getLatestDBVersion(dbVersions)
+	if version > latestVersion {
+		return fmt.Errorf("unknown db version %v, latest version is "+
+			"%v", version, latestVersion)
+	}
+
+	return d.Update(func(tx *bbolt.Tx) error {
+		meta := &Meta{}
+		err := fetchMeta(meta, tx)
+		if err != nil && err != ErrMetaNotFound {
+			return err
+		}
+
+		log.Warnf("Forcibly setting db_version=%v, was db_version=%v",
+			version, meta.DbVersionNumber)
+
+		meta.DbVersionNumber = version
+		return putMeta(meta, tx)
+	})
+}
+
 // putMeta is an internal helper function used in order to allow callers to
 // re-use a database transaction. See the publicly exported PutMeta method for
 // more information.
@@ -76,3 +210,63 @@ func putDbVersion(metaBucket *bbolt.Bucket, meta *Meta) error {
 	byteOrder.PutUint32(scratch, meta.DbVersionNumber)
 	return metaBucket.Put(dbVersionKey, scratch)
 }
+
+// isReservedMetaField returns true if the given key is reserved for use by the
+// database itself within the meta bucket, and therefore can't be accessed as
+// an arbitrary metadata field.
+func isReservedMetaField(key []byte) bool {
+	return len(key) == 0 || bytes.Equal(key, dbVersionKey) ||
+		bytes.Equal(key, appliedMigrationFixesBucket)
+}
+
+// PutMetaField stores the given value under the target key within the meta
+// bucket, overwriting any prior value. This allows subsystems to persist small
+// pieces of node-level metadata without each defining their own bucket. Keys
+// reserved by the database, such as the one storing the database version, are
+// rejected with ErrMetaFieldReserved.
+func (d *DB) PutMetaField(key string, value []byte) error {
+	fieldKey := []byte(key)
+	if isReservedMetaField(fieldKey) {
+		return ErrMetaFieldReserved
+	}
+
+	return d.Update(func(tx *bbolt.Tx) error {
+		metaBucket, err := tx.CreateBucketIfNotExists(metaBucket)
+		if err != nil {
+			return err
+		}
+
+		return metaBucket.Put(fieldKey, value)
+	})
+}
+
+// FetchMetaField returns the value stored under the target key within the
+// meta bucket by PutMetaField. If no value has been stored under the key, then
+// ErrMetaFieldNotFound is returned.
+func (d *DB) FetchMetaField(key string) ([]byte, error) {
+	fieldKey := []byte(key)
+	if isReservedMetaField(fieldKey) {
+		return nil, ErrMetaFieldReserved
+	}
+
+	var value []byte
+	err := d.View(func(tx *bbolt.Tx) error {
+		metaBucket := tx.Bucket(metaBucket)
+		if metaBucket == nil {
+			return ErrMetaNotFound
+		}
+
+		fieldValue := metaBucket.Get(fieldKey)
+		if fieldValue == nil {
+			return ErrMetaFieldNotFound
+		}
+
+		value = copySlice(fieldValue)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
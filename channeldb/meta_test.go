@@ -2,11 +2,28 @@ package channeldb
 
 import (
 	"bytes"
+	"context"
+	"fmt"
+	"io"
 	"io/ioutil"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/coreos/bbolt"
+	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
+	"github.com/lightningnetwork/lnd/channeldb/migration12"
+	"github.com/lightningnetwork/lnd/channeldb/migration13"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
 )
 
 // applyMigration is a helper test function that encapsulates the general steps
@@ -113,19 +130,76 @@ func TestVersionFetchPut(t *testing.T) {
 	}
 }
 
+// TestMetaFieldFetchPut asserts that arbitrary metadata fields can be stored
+// and retrieved from the meta bucket, and that the database version can't be
+// overwritten through them.
+func TestMetaFieldFetchPut(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const fieldKey = "last-compaction"
+	_, err = db.FetchMetaField(fieldKey)
+	if err != ErrMetaFieldNotFound {
+		t.Fatalf("expected ErrMetaFieldNotFound, got: %v", err)
+	}
+
+	// Write the field twice, the second value should overwrite the first.
+	for _, value := range [][]byte{{1, 2, 3}, {4, 5}} {
+		if err := db.PutMetaField(fieldKey, value); err != nil {
+			t.Fatalf("unable to put meta field: %v", err)
+		}
+
+		fetched, err := db.FetchMetaField(fieldKey)
+		if err != nil {
+			t.Fatalf("unable to fetch meta field: %v", err)
+		}
+		if !bytes.Equal(fetched, value) {
+			t.Fatalf("expected meta field %x, got %x", value,
+				fetched)
+		}
+	}
+
+	// The version key and an empty key are reserved, so neither can be
+	// accessed as a meta field.
+	for _, key := range []string{string(dbVersionKey), ""} {
+		err := db.PutMetaField(key, []byte{0xff, 0xff, 0xff, 0xff})
+		if err != ErrMetaFieldReserved {
+			t.Fatalf("expected ErrMetaFieldReserved, got: %v", err)
+		}
+		if _, err := db.FetchMetaField(key); err != ErrMetaFieldReserved {
+			t.Fatalf("expected ErrMetaFieldReserved, got: %v", err)
+		}
+	}
+
+	// The database version should be left untouched by the above.
+	meta, err := db.FetchMeta(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.DbVersionNumber != getLatestDBVersion(dbVersions) {
+		t.Fatalf("expected db version %v, got %v",
+			getLatestDBVersion(dbVersions), meta.DbVersionNumber)
+	}
+}
+
 // TestOrderOfMigrations checks that migrations are applied in proper order.
 func TestOrderOfMigrations(t *testing.T) {
 	t.Parallel()
 
 	appliedMigration := -1
 	versions := []version{
-		{0, nil},
-		{1, nil},
-		{2, func(tx *bbolt.Tx) error {
+		{number: 0},
+		{number: 1},
+		{number: 2, migration: func(tx *bbolt.Tx) error {
 			appliedMigration = 2
 			return nil
 		}},
-		{3, func(tx *bbolt.Tx) error {
+		{number: 3, migration: func(tx *bbolt.Tx) error {
 			appliedMigration = 3
 			return nil
 		}},
@@ -440,3 +514,1244 @@ func TestMigrationReversion(t *testing.T) {
 			"want: %v, got: %v", ErrDBReversion, err)
 	}
 }
+
+// TestMigrationPostCheckFailure asserts that if the post check of a migration
+// fails, then the migration is rolled back and the database left unaltered.
+func TestMigrationPostCheckFailure(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta := &Meta{DbVersionNumber: 0}
+	if err := cdb.PutMeta(meta); err != nil {
+		t.Fatalf("unable to store meta data: %v", err)
+	}
+
+	bucketPrefix := []byte("somebucket")
+	keyPrefix := []byte("someprefix")
+	afterMigration := []byte("aftermigration")
+
+	versions := []version{
+		{number: 0},
+		{
+			number: 1,
+			migration: func(tx *bbolt.Tx) error {
+				bucket, err := tx.CreateBucketIfNotExists(
+					bucketPrefix,
+				)
+				if err != nil {
+					return err
+				}
+
+				return bucket.Put(keyPrefix, afterMigration)
+			},
+			postCheck: func(tx *bbolt.Tx) error {
+				// The migration did write the key, so the
+				// check is able to observe it within the same
+				// transaction before failing.
+				bucket := tx.Bucket(bucketPrefix)
+				if bucket == nil ||
+					bucket.Get(keyPrefix) == nil {
+
+					return errors.New("migration not " +
+						"visible to post check")
+				}
+
+				return errors.New("invariant violated")
+			},
+		},
+	}
+
+	if err := cdb.syncVersions(versions); err == nil {
+		t.Fatal("expected post check failure")
+	}
+
+	// The version shouldn't have been bumped, and the data written by the
+	// migration should have been rolled back.
+	meta, err = cdb.FetchMeta(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.DbVersionNumber != 0 {
+		t.Fatal("post check failed but version is changed")
+	}
+
+	err = cdb.View(func(tx *bbolt.Tx) error {
+		if tx.Bucket(bucketPrefix) != nil {
+			return errors.New("post check failed but data is " +
+				"changed")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestDowngrade asserts that a database can be reverted to a prior version
+// using reverse migrations, and that a downgrade is refused without modifying
+// the database if any of the intermediate versions can't be reverted.
+func TestDowngrade(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Downgrades must be explicitly enabled.
+	if err := cdb.Downgrade(0); err != ErrDowngradeNotAllowed {
+		t.Fatalf("expected ErrDowngradeNotAllowed, got: %v", err)
+	}
+
+	meta := &Meta{DbVersionNumber: 0}
+	if err := cdb.PutMeta(meta); err != nil {
+		t.Fatalf("unable to store meta data: %v", err)
+	}
+
+	bucket1 := []byte("bucket1")
+	bucket2 := []byte("bucket2")
+	createBucket := func(name []byte) migration {
+		return func(tx *bbolt.Tx) error {
+			_, err := tx.CreateBucket(name)
+			return err
+		}
+	}
+	deleteBucket := func(name []byte) migration {
+		return func(tx *bbolt.Tx) error {
+			return tx.DeleteBucket(name)
+		}
+	}
+
+	versions := []version{
+		{number: 0},
+		{number: 1, migration: createBucket(bucket1)},
+		{
+			number:    2,
+			migration: createBucket(bucket2),
+			reverse:   deleteBucket(bucket2),
+		},
+	}
+	if err := cdb.syncVersions(versions); err != nil {
+		t.Fatalf("unable to apply migrations: %v", err)
+	}
+
+	assertState := func(version uint32, b1, b2 bool) {
+		t.Helper()
+
+		meta, err := cdb.FetchMeta(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if meta.DbVersionNumber != version {
+			t.Fatalf("expected version %v, got %v", version,
+				meta.DbVersionNumber)
+		}
+
+		err = cdb.View(func(tx *bbolt.Tx) error {
+			if (tx.Bucket(bucket1) != nil) != b1 {
+				return errors.New("bucket1 state mismatch")
+			}
+			if (tx.Bucket(bucket2) != nil) != b2 {
+				return errors.New("bucket2 state mismatch")
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	assertState(2, true, true)
+
+	// Version 1 has no reverse migration, so downgrading past it must fail
+	// and leave the database untouched.
+	if err := cdb.downgrade(versions, 0); err == nil {
+		t.Fatal("expected downgrade without reverse migration to fail")
+	}
+	assertState(2, true, true)
+
+	// Downgrading a single version is possible however.
+	if err := cdb.downgrade(versions, 1); err != nil {
+		t.Fatalf("unable to downgrade: %v", err)
+	}
+	assertState(1, true, false)
+
+	// Once we add the missing reverse migration, we can go all the way
+	// back to the base version.
+	versions[1].reverse = deleteBucket(bucket1)
+	if err := cdb.downgrade(versions, 0); err != nil {
+		t.Fatalf("unable to downgrade: %v", err)
+	}
+	assertState(0, false, false)
+}
+
+// TestDowngradeRoundTrip asserts that a database at the latest version can be
+// downgraded to version 11 through the reverse migrations of the current
+// versions, and then be migrated back without losing any of its data.
+func TestDowngradeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cdb.allowDowngrade = true
+
+	// We'll populate the database with an open channel, which is added to
+	// the open height index, and has an event logged once it's marked
+	// borked.
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channel.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+	openHeight := lnwire.ShortChannelID{BlockHeight: 100}
+	if err := channel.MarkAsOpen(openHeight); err != nil {
+		t.Fatalf("unable to mark channel open: %v", err)
+	}
+	if err := channel.MarkBorked(); err != nil {
+		t.Fatalf("unable to mark channel borked: %v", err)
+	}
+
+	// We'll also add two close summaries, one with all optional fields
+	// populated, and one without any.
+	_, remotePub := btcec.PrivKeyFromBytes(btcec.S256(), key[:])
+	_, revPub := btcec.PrivKeyFromBytes(btcec.S256(), rev[:])
+	var chanPoint1, chanPoint2 wire.OutPoint
+	chanPoint1.Hash[0] = 1
+	chanPoint2.Hash[0] = 2
+	summaries := []*ChannelCloseSummary{
+		{
+			ChanPoint:               chanPoint1,
+			CloseHeight:             100,
+			RemotePub:               remotePub,
+			CloseType:               RemoteForceClose,
+			RemoteCurrentRevocation: revPub,
+			RemoteNextRevocation:    remotePub,
+			LocalChanConfig: ChannelConfig{
+				ChannelConstraints: ChannelConstraints{
+					CsvDelay: 144,
+				},
+				MultiSigKey: keychain.KeyDescriptor{
+					PubKey: remotePub,
+				},
+			},
+			LastChanSyncMsg: &lnwire.ChannelReestablish{
+				NextLocalCommitHeight:     4,
+				LocalUnrevokedCommitPoint: revPub,
+			},
+		},
+		{
+			ChanPoint:   chanPoint2,
+			CloseHeight: 200,
+			RemotePub:   remotePub,
+			CloseType:   CooperativeClose,
+		},
+	}
+	err = cdb.Update(func(tx *bbolt.Tx) error {
+		closedChanBucket := tx.Bucket(closedChannelBucket)
+		for _, summary := range summaries {
+			var k, v bytes.Buffer
+			err := writeOutpoint(&k, &summary.ChanPoint)
+			if err != nil {
+				return err
+			}
+			err = serializeChannelCloseSummary(&v, summary)
+			if err != nil {
+				return err
+			}
+			err = closedChanBucket.Put(k.Bytes(), v.Bytes())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to store close summaries: %v", err)
+	}
+
+	// assertSummaries asserts that the close summaries are stored with the
+	// optional fields encoded behind the passed markers, and that they
+	// decode to their original values.
+	assertSummaries := func(markers ...byte) {
+		t.Helper()
+
+		err := cdb.View(func(tx *bbolt.Tx) error {
+			closedChanBucket := tx.Bucket(closedChannelBucket)
+			for i, summary := range summaries {
+				var k bytes.Buffer
+				err := writeOutpoint(&k, &summary.ChanPoint)
+				if err != nil {
+					return err
+				}
+				v := closedChanBucket.Get(k.Bytes())
+				if v[171] != markers[i] {
+					return fmt.Errorf("expected marker "+
+						"%v, got %v", markers[i], v[171])
+				}
+
+				dbSummary, err := deserializeCloseChannelSummary(
+					bytes.NewReader(v),
+				)
+				if err != nil {
+					return err
+				}
+				if !reflect.DeepEqual(summary, dbSummary) {
+					return fmt.Errorf("summary mismatch: "+
+						"expected %v, got %v",
+						spew.Sdump(summary),
+						spew.Sdump(dbSummary))
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// assertState asserts that the database is at the passed version, and
+	// whether the event log and open height index are present.
+	assertState := func(version uint32, present bool) {
+		t.Helper()
+
+		meta, err := cdb.FetchMeta(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if meta.DbVersionNumber != version {
+			t.Fatalf("expected version %v, got %v", version,
+				meta.DbVersionNumber)
+		}
+
+		err = cdb.View(func(tx *bbolt.Tx) error {
+			buckets := [][]byte{
+				channelEventLogBucket, chanOpenHeightBucket,
+			}
+			for _, bucket := range buckets {
+				if (tx.Bucket(bucket) != nil) != present {
+					return fmt.Errorf("bucket %s state "+
+						"mismatch", bucket)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	latestVersion := getLatestDBVersion(dbVersions)
+	assertState(latestVersion, true)
+	assertSummaries(closeSummaryTLVMarker, closeSummaryTLVMarker)
+
+	// Downgrading to version 11 should remove the event log and open
+	// height index, and revert the summaries to the legacy encoding.
+	if err := cdb.Downgrade(11); err != nil {
+		t.Fatalf("unable to downgrade: %v", err)
+	}
+	assertState(11, false)
+	assertSummaries(1, 0)
+
+	// Migrating back to the latest version should restore all of them,
+	// with the open height index backfilled from the open channel.
+	if err := cdb.syncVersions(dbVersions); err != nil {
+		t.Fatalf("unable to apply migrations: %v", err)
+	}
+	assertState(latestVersion, true)
+	assertSummaries(closeSummaryTLVMarker, closeSummaryTLVMarker)
+
+	channels, err := cdb.FetchChannelsOpenedInRange(0, 1000)
+	if err != nil {
+		t.Fatalf("unable to fetch opened channels: %v", err)
+	}
+	if len(channels) != 1 ||
+		channels[0].FundingOutpoint != channel.FundingOutpoint {
+
+		t.Fatalf("expected channel %v to be indexed, got %v",
+			channel.FundingOutpoint, spew.Sdump(channels))
+	}
+}
+
+// TestMigrationReport asserts that the result of each applied migration is
+// reported, including those that were attempted before a migration failed.
+func TestMigrationReport(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A freshly created database is initialized at the latest version, so
+	// no migrations should've been applied when opening it.
+	if len(cdb.MigrationReport()) != 0 {
+		t.Fatalf("expected no migration results, got %v",
+			cdb.MigrationReport())
+	}
+
+	const migrationDelay = 10 * time.Millisecond
+	writeRecords := func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucket([]byte("records"))
+		if err != nil {
+			return err
+		}
+		for i := 0; i < 100; i++ {
+			err := bucket.Put([]byte(fmt.Sprintf("%03d", i)), nil)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	slowMigration := func(tx *bbolt.Tx) error {
+		time.Sleep(migrationDelay)
+		return nil
+	}
+	failMigration := func(tx *bbolt.Tx) error {
+		return errors.New("migration failed")
+	}
+
+	versions := []version{
+		{number: 0},
+		{number: 1, migration: writeRecords},
+		{number: 2, migration: slowMigration},
+		{number: 3, migration: failMigration},
+	}
+
+	assertResults := func(results []MigrationResult, numResults int) {
+		t.Helper()
+
+		if len(results) != numResults {
+			t.Fatalf("expected %d migration results, got %d",
+				numResults, len(results))
+		}
+		for i, result := range results {
+			if result.Version != uint32(i+1) {
+				t.Fatalf("expected result for version %d, "+
+					"got %d", i+1, result.Version)
+			}
+		}
+		if results[0].NodesAllocated == 0 {
+			t.Fatalf("expected nodes to be allocated by first " +
+				"migration")
+		}
+		if results[1].Duration < migrationDelay {
+			t.Fatalf("expected second migration to take at least "+
+				"%v, took %v", migrationDelay,
+				results[1].Duration)
+		}
+	}
+
+	// The failing migration should roll back all of them, though the
+	// results of each migration attempted should still be returned.
+	meta := &Meta{DbVersionNumber: 0}
+	if err := cdb.PutMeta(meta); err != nil {
+		t.Fatalf("unable to store meta data: %v", err)
+	}
+	results, err := cdb.syncVersionsWithReport(
+		context.Background(), versions,
+	)
+	if err == nil {
+		t.Fatal("expected migration to fail")
+	}
+	assertResults(results, 3)
+
+	// Without the failing migration, the remaining ones should be applied
+	// and reported.
+	versions = versions[:3]
+	results, err = cdb.syncVersionsWithReport(
+		context.Background(), versions,
+	)
+	if err != nil {
+		t.Fatalf("unable to apply migrations: %v", err)
+	}
+	assertResults(results, 2)
+
+	// Now that the database is at the latest version, there should be
+	// nothing left to report.
+	results, err = cdb.syncVersionsWithReport(
+		context.Background(), versions,
+	)
+	if err != nil {
+		t.Fatalf("unable to sync versions: %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("expected no migration results, got %v", results)
+	}
+}
+
+// TestReadDBVersion tests that we're able to read the version of a database
+// on disk without opening it for migration.
+func TestReadDBVersion(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	// Before the database has been created, we should get an error.
+	if _, err := ReadDBVersion(tempDirName); err != ErrNoChanDBExists {
+		t.Fatalf("expected ErrNoChanDBExists, got: %v", err)
+	}
+
+	// We'll now create the database and roll back its version, closing it
+	// afterwards so the file lock is released.
+	cdb, err := Open(tempDirName)
+	if err != nil {
+		t.Fatalf("unable to open database: %v", err)
+	}
+	if err := cdb.PutMeta(&Meta{DbVersionNumber: 7}); err != nil {
+		t.Fatalf("unable to put meta: %v", err)
+	}
+	cdb.Close()
+
+	version, err := ReadDBVersion(tempDirName)
+	if err != nil {
+		t.Fatalf("unable to read db version: %v", err)
+	}
+	if version != 7 {
+		t.Fatalf("expected version 7, got %v", version)
+	}
+}
+
+// TestCheckDBVersion asserts that CheckDBVersion distinguishes between a
+// database that is current, needs a migration, or is of a future version.
+func TestCheckDBVersion(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	if err := CheckDBVersion(tempDirName); err != ErrNoChanDBExists {
+		t.Fatalf("expected ErrNoChanDBExists, got: %v", err)
+	}
+
+	cdb, err := Open(tempDirName)
+	if err != nil {
+		t.Fatalf("unable to open database: %v", err)
+	}
+	cdb.Close()
+
+	if err := CheckDBVersion(tempDirName); err != nil {
+		t.Fatalf("expected current database, got: %v", err)
+	}
+
+	latestVersion := getLatestDBVersion(dbVersions)
+	tests := []struct {
+		version uint32
+		err     error
+	}{
+		{version: latestVersion - 1, err: ErrDBNeedsMigration},
+		{version: latestVersion + 1, err: ErrDBReversion},
+	}
+	for _, test := range tests {
+		// We write the version directly, as the database can't be
+		// opened once it's ahead of the latest version.
+		bdb, err := bbolt.Open(
+			filepath.Join(tempDirName, dbName), dbFilePermission, nil,
+		)
+		if err != nil {
+			t.Fatalf("unable to open bolt db: %v", err)
+		}
+		err = bdb.Update(func(tx *bbolt.Tx) error {
+			return putMeta(&Meta{DbVersionNumber: test.version}, tx)
+		})
+		bdb.Close()
+		if err != nil {
+			t.Fatalf("unable to put meta: %v", err)
+		}
+
+		err = CheckDBVersion(tempDirName)
+		if err != test.err {
+			t.Fatalf("version %v: expected %v, got: %v",
+				test.version, test.err, err)
+		}
+	}
+}
+
+// TestSetDBVersion asserts that the version of the database can only be
+// overwritten when forced, and only with a known version.
+func TestSetDBVersion(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertVersion := func(expected uint32) {
+		t.Helper()
+
+		meta, err := cdb.FetchMeta(nil)
+		if err != nil {
+			t.Fatalf("unable to fetch meta: %v", err)
+		}
+		if meta.DbVersionNumber != expected {
+			t.Fatalf("expected db version %v, got %v", expected,
+				meta.DbVersionNumber)
+		}
+	}
+
+	latestVersion := getLatestDBVersion(dbVersions)
+	if err := cdb.SetDBVersion(1, false); err != ErrSetDBVersionUnforced {
+		t.Fatalf("expected ErrSetDBVersionUnforced, got: %v", err)
+	}
+	if err := cdb.SetDBVersion(latestVersion+1, true); err == nil {
+		t.Fatal("expected unknown version to be rejected")
+	}
+	assertVersion(latestVersion)
+
+	if err := cdb.SetDBVersion(1, true); err != nil {
+		t.Fatalf("unable to set db version: %v", err)
+	}
+	assertVersion(1)
+}
+
+// serializeLegacyCloseSummary serializes the passed close summary using the
+// encoding prior to migration 12, which used boolean flags to indicate the
+// presence of the optional fields.
+func serializeLegacyCloseSummary(w io.Writer, cs *ChannelCloseSummary) error {
+	err := WriteElements(w,
+		cs.ChanPoint, cs.ShortChanID, cs.ChainHash, cs.ClosingTXID,
+		cs.CloseHeight, cs.RemotePub, cs.Capacity, cs.SettledBalance,
+		cs.TimeLockedBalance, cs.CloseType, cs.IsPending,
+	)
+	if err != nil {
+		return err
+	}
+
+	if cs.RemoteCurrentRevocation == nil {
+		return WriteElements(w, false)
+	}
+
+	err = WriteElements(w, true, cs.RemoteCurrentRevocation)
+	if err != nil {
+		return err
+	}
+	if err := writeChanConfig(w, &cs.LocalChanConfig); err != nil {
+		return err
+	}
+
+	err = WriteElements(w, cs.RemoteNextRevocation != nil)
+	if err != nil {
+		return err
+	}
+	if cs.RemoteNextRevocation != nil {
+		err := WriteElements(w, cs.RemoteNextRevocation)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := WriteElements(w, cs.LastChanSyncMsg != nil); err != nil {
+		return err
+	}
+	if cs.LastChanSyncMsg != nil {
+		return WriteElements(w, cs.LastChanSyncMsg)
+	}
+
+	return nil
+}
+
+// TestMigrateCloseSummaryTLV asserts that close summaries written using the
+// legacy encoding can be read both before and after they're migrated to the
+// TLV encoding, and that the migration re-encodes them.
+func TestMigrateCloseSummaryTLV(t *testing.T) {
+	t.Parallel()
+
+	_, remotePub := btcec.PrivKeyFromBytes(btcec.S256(), key[:])
+	_, revPub := btcec.PrivKeyFromBytes(btcec.S256(), rev[:])
+
+	// We'll create two summaries, one with all optional fields populated,
+	// and one without any.
+	var chanPoint1, chanPoint2 wire.OutPoint
+	chanPoint1.Hash[0] = 1
+	chanPoint2.Hash[0] = 2
+	fullSummary := &ChannelCloseSummary{
+		ChanPoint:               chanPoint1,
+		ShortChanID:             lnwire.NewShortChanIDFromInt(99),
+		CloseHeight:             100,
+		RemotePub:               remotePub,
+		Capacity:                10000,
+		SettledBalance:          5000,
+		CloseType:               RemoteForceClose,
+		IsPending:               true,
+		RemoteCurrentRevocation: revPub,
+		RemoteNextRevocation:    remotePub,
+		LocalChanConfig: ChannelConfig{
+			ChannelConstraints: ChannelConstraints{
+				DustLimit: 573,
+				CsvDelay:  144,
+			},
+			MultiSigKey: keychain.KeyDescriptor{
+				PubKey: remotePub,
+			},
+			RevocationBasePoint: keychain.KeyDescriptor{
+				KeyLocator: keychain.KeyLocator{
+					Family: 3,
+					Index:  7,
+				},
+			},
+		},
+		LastChanSyncMsg: &lnwire.ChannelReestablish{
+			NextLocalCommitHeight:     4,
+			RemoteCommitTailHeight:    3,
+			LocalUnrevokedCommitPoint: revPub,
+		},
+	}
+	emptySummary := &ChannelCloseSummary{
+		ChanPoint:   chanPoint2,
+		CloseHeight: 200,
+		RemotePub:   remotePub,
+		CloseType:   CooperativeClose,
+	}
+	summaries := []*ChannelCloseSummary{fullSummary, emptySummary}
+
+	assertSummaries := func(d *DB, expMarker byte) {
+		t.Helper()
+
+		err := d.View(func(tx *bbolt.Tx) error {
+			closedChanBucket := tx.Bucket(closedChannelBucket)
+			for _, summary := range summaries {
+				var b bytes.Buffer
+				err := writeOutpoint(&b, &summary.ChanPoint)
+				if err != nil {
+					return err
+				}
+
+				summaryBytes := closedChanBucket.Get(b.Bytes())
+				if summaryBytes[171] != expMarker &&
+					summary == fullSummary {
+
+					return fmt.Errorf("expected marker "+
+						"%v, got %v", expMarker,
+						summaryBytes[171])
+				}
+
+				dbSummary, err := deserializeCloseChannelSummary(
+					bytes.NewReader(summaryBytes),
+				)
+				if err != nil {
+					return err
+				}
+
+				if !reflect.DeepEqual(summary, dbSummary) {
+					return fmt.Errorf("summary mismatch: "+
+						"expected %v, got %v",
+						spew.Sdump(summary),
+						spew.Sdump(dbSummary))
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	beforeMigration := func(d *DB) {
+		err := d.Update(func(tx *bbolt.Tx) error {
+			closedChanBucket := tx.Bucket(closedChannelBucket)
+			for _, summary := range summaries {
+				var k, v bytes.Buffer
+				err := writeOutpoint(&k, &summary.ChanPoint)
+				if err != nil {
+					return err
+				}
+				err = serializeLegacyCloseSummary(&v, summary)
+				if err != nil {
+					return err
+				}
+
+				err = closedChanBucket.Put(k.Bytes(), v.Bytes())
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unable to write legacy summaries: %v", err)
+		}
+
+		// The legacy summaries should be readable prior to the
+		// migration.
+		assertSummaries(d, 1)
+	}
+
+	afterMigration := func(d *DB) {
+		assertSummaries(d, closeSummaryTLVMarker)
+	}
+
+	applyMigration(t,
+		beforeMigration,
+		afterMigration,
+		migration12.MigrateCloseSummaryTLV,
+		false)
+}
+
+// TestMigrateChannelEventLog asserts that the migration creating the channel
+// event log bucket succeeds on a database lacking it.
+func TestMigrateChannelEventLog(t *testing.T) {
+	t.Parallel()
+
+	beforeMigration := func(d *DB) {
+		err := d.Update(func(tx *bbolt.Tx) error {
+			return tx.DeleteBucket(channelEventLogBucket)
+		})
+		if err != nil {
+			t.Fatalf("unable to delete event log bucket: %v", err)
+		}
+	}
+
+	afterMigration := func(d *DB) {
+		err := d.View(func(tx *bbolt.Tx) error {
+			if tx.Bucket(channelEventLogBucket) == nil {
+				return fmt.Errorf("channel event log not " +
+					"found")
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	applyMigration(t,
+		beforeMigration,
+		afterMigration,
+		migration13.CreateChannelEventLog,
+		false)
+}
+
+// TestMigrationTimeout asserts that migrations which exceed the deadline of
+// the passed context are rolled back, leaving the database at its prior
+// version.
+func TestMigrationTimeout(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta := &Meta{DbVersionNumber: 0}
+	if err := cdb.PutMeta(meta); err != nil {
+		t.Fatalf("unable to store meta data: %v", err)
+	}
+
+	bucketName := []byte("bucket")
+	versions := []version{
+		{number: 0},
+		{
+			number: 1,
+			migration: func(tx *bbolt.Tx) error {
+				_, err := tx.CreateBucket(bucketName)
+				return err
+			},
+		},
+		{
+			// This migration runs until its context is done.
+			number: 2,
+			ctxMigration: func(ctx context.Context,
+				tx *bbolt.Tx) error {
+
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 10*time.Millisecond,
+	)
+	defer cancel()
+
+	err = cdb.syncVersionsContext(ctx, versions)
+	if err != ErrMigrationTimeout {
+		t.Fatalf("expected ErrMigrationTimeout, got: %v", err)
+	}
+
+	meta, err = cdb.FetchMeta(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.DbVersionNumber != 0 {
+		t.Fatalf("expected version 0, got %v", meta.DbVersionNumber)
+	}
+
+	err = cdb.View(func(tx *bbolt.Tx) error {
+		if tx.Bucket(bucketName) != nil {
+			return errors.New("migration timed out but data is " +
+				"changed")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A migration that fails on its own accord once the deadline has
+	// passed should have its error returned, rather than have it be
+	// reported as a timeout.
+	errMigration := errors.New("migration failed")
+	versions[2].ctxMigration = func(ctx context.Context,
+		tx *bbolt.Tx) error {
+
+		<-ctx.Done()
+		return errMigration
+	}
+
+	ctx, cancel = context.WithTimeout(
+		context.Background(), 10*time.Millisecond,
+	)
+	defer cancel()
+
+	err = cdb.syncVersionsContext(ctx, versions)
+	if err != errMigration {
+		t.Fatalf("expected migration error, got: %v", err)
+	}
+}
+
+// TestOpenMigrationError asserts that Open returns the results of the
+// migrations it attempted along with the error of the one that failed.
+func TestOpenMigrationError(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	// We'll roll the database back by a single version, such that the
+	// latest migration is pending once it's reopened.
+	latestVersion := getLatestDBVersion(dbVersions)
+	cdb, err := Open(tempDirName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = cdb.PutMeta(&Meta{DbVersionNumber: latestVersion - 1})
+	if err != nil {
+		t.Fatalf("unable to store meta data: %v", err)
+	}
+	cdb.Close()
+
+	// Replacing the latest migration with one that fails should cause
+	// Open to fail with the result of the failed migration.
+	errMigration := errors.New("migration failed")
+	fail := func(tx *bbolt.Tx) error {
+		return errMigration
+	}
+	_, err = Open(
+		tempDirName, OptionSetMigrationOverride(latestVersion, fail),
+	)
+	migrationErr, ok := err.(MigrationError)
+	if !ok {
+		t.Fatalf("expected MigrationError, got: %v", err)
+	}
+	if migrationErr.Err != errMigration {
+		t.Fatalf("expected migration error, got: %v", migrationErr.Err)
+	}
+	if len(migrationErr.Results) != 1 ||
+		migrationErr.Results[0].Version != latestVersion {
+
+		t.Fatalf("expected result of migration #%v, got %v",
+			latestVersion, spew.Sdump(migrationErr.Results))
+	}
+}
+
+// TestMigrationOverride asserts that the override of a migration the database
+// has already been migrated past is applied exactly once, that an override of
+// a pending migration is applied in its place, and that overrides aren't
+// applied to a freshly created database.
+func TestMigrationOverride(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	cdb, err := Open(tempDirName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cdb.Close()
+
+	// Reopening the database with an override of the latest migration,
+	// which has already been applied, should apply the fix once, no
+	// matter how many times the database is opened.
+	var numApplied int
+	fix := func(tx *bbolt.Tx) error {
+		numApplied++
+		return nil
+	}
+	latestVersion := getLatestDBVersion(dbVersions)
+	for i := 0; i < 2; i++ {
+		cdb, err := Open(
+			tempDirName,
+			OptionSetMigrationOverride(latestVersion, fix),
+		)
+		if err != nil {
+			t.Fatalf("unable to open db: %v", err)
+		}
+		cdb.Close()
+	}
+	if numApplied != 1 {
+		t.Fatalf("expected fix to be applied once, got %v", numApplied)
+	}
+
+	// A fresh database is created at the latest version, so the fix
+	// shouldn't be applied to it, neither when it's created nor once it's
+	// reopened.
+	freshDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(freshDirName)
+
+	numApplied = 0
+	for i := 0; i < 2; i++ {
+		cdb, err := Open(
+			freshDirName,
+			OptionSetMigrationOverride(latestVersion, fix),
+		)
+		if err != nil {
+			t.Fatalf("unable to open db: %v", err)
+		}
+		cdb.Close()
+	}
+	if numApplied != 0 {
+		t.Fatalf("expected fix not to be applied, got %v", numApplied)
+	}
+
+	// Overrides of unknown migrations should be rejected.
+	_, err = Open(
+		tempDirName, OptionSetMigrationOverride(latestVersion+1, fix),
+	)
+	if err == nil {
+		t.Fatal("expected override of unknown migration to fail")
+	}
+
+	// An override of a migration that is yet to be applied should replace
+	// it, and not be applied again as a fix.
+	cdb, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cdb.PutMeta(&Meta{DbVersionNumber: 0}); err != nil {
+		t.Fatalf("unable to store meta data: %v", err)
+	}
+
+	bucketName := []byte("bucket")
+	versions := []version{
+		{number: 0},
+		{
+			number: 1,
+			migration: func(tx *bbolt.Tx) error {
+				return errors.New("buggy migration applied")
+			},
+		},
+	}
+	overrides := map[uint32]func(*bbolt.Tx) error{
+		1: func(tx *bbolt.Tx) error {
+			_, err := tx.CreateBucket(bucketName)
+			return err
+		},
+	}
+	versions, err = withMigrationOverrides(versions, overrides)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cdb.syncVersions(versions); err != nil {
+		t.Fatalf("unable to apply migrations: %v", err)
+	}
+	if err := cdb.applyMigrationFixes(overrides); err != nil {
+		t.Fatalf("unable to apply fixes: %v", err)
+	}
+
+	err = cdb.View(func(tx *bbolt.Tx) error {
+		if tx.Bucket(bucketName) == nil {
+			return errors.New("override not applied")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Writing a meta field under the key of the applied fixes should be
+	// rejected.
+	err = cdb.PutMetaField(
+		string(appliedMigrationFixesBucket), []byte("fix"),
+	)
+	if err != ErrMetaFieldReserved {
+		t.Fatalf("expected ErrMetaFieldReserved, got: %v", err)
+	}
+}
+
+// TestPreMigrationBackup asserts that a copy of the database is taken before
+// pending migrations are applied, and that it's retained even if the
+// migrations fail.
+func TestPreMigrationBackup(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backupDir, err := ioutil.TempDir("", "channeldb-backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(backupDir)
+	cdb.preMigrationBackupDir = backupDir
+
+	if err := cdb.PutMeta(&Meta{DbVersionNumber: 1}); err != nil {
+		t.Fatalf("unable to store meta data: %v", err)
+	}
+
+	// Without any pending migrations, no backup should be taken.
+	versions := []version{{number: 0}, {number: 1}}
+	if err := cdb.syncVersions(versions); err != nil {
+		t.Fatalf("unable to sync versions: %v", err)
+	}
+	backups, err := ioutil.ReadDir(backupDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 0 {
+		t.Fatalf("expected no backups, got %v", len(backups))
+	}
+
+	// A failing migration should leave a backup behind at the version the
+	// database was at before the migration.
+	errMigration := errors.New("migration failed")
+	versions = append(versions, version{
+		number: 2,
+		migration: func(tx *bbolt.Tx) error {
+			return errMigration
+		},
+	})
+	err = cdb.syncVersions(versions)
+	if err != errMigration {
+		t.Fatalf("expected migration to fail, got: %v", err)
+	}
+
+	backups, err = ioutil.ReadDir(backupDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 1 {
+		t.Fatalf("expected one backup, got %v", len(backups))
+	}
+	if !strings.HasPrefix(backups[0].Name(), dbName+".v1.") {
+		t.Fatalf("unexpected backup name: %v", backups[0].Name())
+	}
+
+	bdb, err := bbolt.Open(
+		filepath.Join(backupDir, backups[0].Name()), dbFilePermission,
+		&bbolt.Options{ReadOnly: true},
+	)
+	if err != nil {
+		t.Fatalf("unable to open backup: %v", err)
+	}
+	defer bdb.Close()
+
+	err = bdb.View(func(tx *bbolt.Tx) error {
+		meta := &Meta{}
+		if err := fetchMeta(meta, tx); err != nil {
+			return err
+		}
+		if meta.DbVersionNumber != 1 {
+			return fmt.Errorf("expected backup at version 1, got "+
+				"%v", meta.DbVersionNumber)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestMigrationDiskCheck asserts that migrations are refused if there isn't
+// enough free space on disk, and applied otherwise.
+func TestMigrationDiskCheck(t *testing.T) {
+	t.Parallel()
+
+	if _, err := freeDiskSpace(os.TempDir()); err != nil {
+		t.Skipf("unable to determine free disk space: %v", err)
+	}
+
+	cdb, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cdb.PutMeta(&Meta{DbVersionNumber: 1}); err != nil {
+		t.Fatalf("unable to store meta data: %v", err)
+	}
+
+	var applied bool
+	versions := []version{
+		{number: 0},
+		{number: 1},
+		{
+			number: 2,
+			migration: func(tx *bbolt.Tx) error {
+				applied = true
+				return nil
+			},
+		},
+	}
+
+	// No filesystem has this many bytes free, so the migration shouldn't
+	// be attempted.
+	cdb.migrationMinFreeBytes = math.MaxUint64
+	err = cdb.syncVersions(versions)
+	if err != ErrInsufficientDiskSpace {
+		t.Fatalf("expected ErrInsufficientDiskSpace, got: %v", err)
+	}
+	if applied {
+		t.Fatal("expected migration not to be applied")
+	}
+
+	// With a requirement that can be met, the migration should proceed.
+	cdb.migrationMinFreeBytes = 1
+	if err := cdb.syncVersions(versions); err != nil {
+		t.Fatalf("unable to sync versions: %v", err)
+	}
+	if !applied {
+		t.Fatal("expected migration to be applied")
+	}
+}
@@ -0,0 +1,198 @@
+package channeldb
+
+import (
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// DBMetrics is a set of counts describing the contents of the database. It's
+// intended to be exported as gauges by a monitoring system.
+type DBMetrics struct {
+	// NumOpenChannels is the number of channels whose funding transaction
+	// has been confirmed.
+	NumOpenChannels uint64
+
+	// NumPendingChannels is the number of channels whose funding
+	// transaction has yet to be confirmed.
+	NumPendingChannels uint64
+
+	// NumClosedChannels is the number of channel close summaries,
+	// including those of channels that are not yet fully resolved.
+	NumClosedChannels uint64
+
+	// NumInvoices is the number of invoices stored within the database.
+	NumInvoices uint64
+
+	// NumGraphNodes is the number of nodes within the channel graph.
+	NumGraphNodes uint64
+
+	// NumGraphEdges is the number of channels within the channel graph.
+	NumGraphEdges uint64
+
+	// NumForwardingEvents is the number of events within the forwarding
+	// log.
+	NumForwardingEvents uint64
+}
+
+// CollectMetrics gathers a set of counts over the contents of the database
+// within a single read transaction. Records are counted by their keys only,
+// with the exception of open channels for which the small fixed size header
+// of the channel info is read in order to tell pending and open channels
+// apart. As a result, this is cheap enough to be called on every scrape of a
+// monitoring system: on a database with tens of thousands of channels and a
+// full mainnet graph, a collection is expected to complete within a few tens
+// of milliseconds.
+func (d *DB) CollectMetrics() (*DBMetrics, error) {
+	metrics := &DBMetrics{}
+	err := d.View(func(tx *bbolt.Tx) error {
+		// Open channels are counted according to the pending flag
+		// found within their static channel info.
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket != nil {
+			err := forEachChanBucket(openChanBucket, func(_,
+				_ []byte, chanBucket *bbolt.Bucket) error {
+
+				header, err := fetchChanInfoHeader(chanBucket)
+				if err != nil {
+					return err
+				}
+
+				if header.isPending {
+					metrics.NumPendingChannels++
+				} else {
+					metrics.NumOpenChannels++
+				}
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		metrics.NumClosedChannels = countKeys(
+			tx.Bucket(closedChannelBucket), nil,
+		)
+
+		// The invoice bucket also houses the invoice indexes as
+		// nested buckets, which are skipped as their values are nil.
+		metrics.NumInvoices = countKeys(
+			tx.Bucket(invoiceBucket), func(k, v []byte) bool {
+				return v != nil
+			},
+		)
+
+		// Within the node bucket, only the keys of the size of a
+		// compressed public key are actual nodes, the others being
+		// the source key and the nested index buckets.
+		nodes := tx.Bucket(nodeBucket)
+		metrics.NumGraphNodes = countKeys(nodes, isGraphNodeKey)
+
+		edges := tx.Bucket(edgeBucket)
+		if edges != nil {
+			metrics.NumGraphEdges = countKeys(
+				edges.Bucket(edgeIndexBucket), nil,
+			)
+		}
+
+		metrics.NumForwardingEvents = countKeys(
+			tx.Bucket(forwardingLogBucket), nil,
+		)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// PeerSummary aggregates the open channels we share with a single peer.
+type PeerSummary struct {
+	// NumChannels is the number of open channels we have with the peer.
+	NumChannels uint32
+
+	// Capacity is the summed capacity of all open channels we have with
+	// the peer.
+	Capacity btcutil.Amount
+}
+
+// PeerChannelSummary returns a summary of the open channels we share with each
+// of our peers, keyed by the public key of the peer. Only the static channel
+// info of each channel is read, within a single pass over the open channel
+// bucket. Similar to FetchAllOpenChannels, channels that are pending or
+// waiting to be closed aren't included, so peers for which that holds for
+// all their channels won't be present in the returned map.
+func (d *DB) PeerChannelSummary() (map[route.Vertex]*PeerSummary, error) {
+	summaries := make(map[route.Vertex]*PeerSummary)
+	err := d.View(func(tx *bbolt.Tx) error {
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket == nil {
+			return ErrNoActiveChannels
+		}
+
+		return forEachChanBucket(openChanBucket, func(nodePub,
+			_ []byte, chanBucket *bbolt.Bucket) error {
+
+			var channel OpenChannel
+			if err := fetchChanInfo(chanBucket, &channel); err != nil {
+				return err
+			}
+
+			if channel.IsPending ||
+				channel.ChanStatus() != ChanStatusDefault {
+
+				return nil
+			}
+
+			peer, err := route.NewVertexFromBytes(nodePub)
+			if err != nil {
+				return err
+			}
+
+			summary, ok := summaries[peer]
+			if !ok {
+				summary = &PeerSummary{}
+				summaries[peer] = summary
+			}
+			summary.NumChannels++
+			summary.Capacity += channel.Capacity
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
+// countKeys counts the keys within the passed bucket for which the filter
+// returns true, without decoding any of the values. If the filter is nil, then
+// all keys are counted. A nil bucket has no keys.
+func countKeys(bucket *bbolt.Bucket, filter func(k, v []byte) bool) uint64 {
+	if bucket == nil {
+		return 0
+	}
+
+	var count uint64
+	cursor := bucket.Cursor()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		if filter != nil && !filter(k, v) {
+			continue
+		}
+
+		count++
+	}
+
+	return count
+}
+
+// isGraphNodeKey returns true if the passed key/value pair within the node
+// bucket represents a node within the graph.
+func isGraphNodeKey(k, v []byte) bool {
+	return len(k) == 33 && v != nil
+}
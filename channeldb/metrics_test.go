@@ -0,0 +1,189 @@
+package channeldb
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// TestCollectMetrics asserts that the counts returned by CollectMetrics match
+// the contents of the database.
+func TestCollectMetrics(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// An empty database should report zero for all counts.
+	metrics, err := cdb.CollectMetrics()
+	if err != nil {
+		t.Fatalf("unable to collect metrics: %v", err)
+	}
+	if *metrics != (DBMetrics{}) {
+		t.Fatalf("expected empty metrics, got: %v", metrics)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+
+	// We'll add two pending channels, one open channel, and a closed
+	// channel.
+	for i := 0; i < 4; i++ {
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+
+		switch i {
+		case 2:
+			err = channel.MarkAsOpen(lnwire.NewShortChanIDFromInt(9))
+		case 3:
+			err = channel.CloseChannel(&ChannelCloseSummary{
+				ChanPoint: channel.FundingOutpoint,
+				RemotePub: channel.IdentityPub,
+			})
+		}
+		if err != nil {
+			t.Fatalf("unable to transition channel: %v", err)
+		}
+	}
+
+	// Add a single invoice, a graph node, and a pair of forwarding events.
+	invoice, err := randInvoice(1000)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	payHash := invoice.Terms.PaymentPreimage.Hash()
+	if _, err := cdb.AddInvoice(invoice, payHash); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	node, err := createTestVertex(cdb)
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+	if err := cdb.ChannelGraph().AddLightningNode(node); err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+
+	events := []ForwardingEvent{
+		{Timestamp: time.Unix(1000, 0)},
+		{Timestamp: time.Unix(2000, 0)},
+	}
+	if err := cdb.ForwardingLog().AddForwardingEvents(events); err != nil {
+		t.Fatalf("unable to add forwarding events: %v", err)
+	}
+
+	metrics, err = cdb.CollectMetrics()
+	if err != nil {
+		t.Fatalf("unable to collect metrics: %v", err)
+	}
+
+	expected := DBMetrics{
+		NumOpenChannels:     1,
+		NumPendingChannels:  2,
+		NumClosedChannels:   1,
+		NumInvoices:         1,
+		NumGraphNodes:       1,
+		NumForwardingEvents: 2,
+	}
+	if *metrics != expected {
+		t.Fatalf("metrics mismatch: expected %v, got %v", expected,
+			*metrics)
+	}
+}
+
+// TestPeerChannelSummary asserts that PeerChannelSummary aggregates the open
+// channels of each peer, skipping those that are pending or waiting to close.
+func TestPeerChannelSummary(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+
+	_, otherPub := btcec.PrivKeyFromBytes(btcec.S256(), rev[:])
+
+	// We'll add two open channels and a pending channel with the first
+	// peer, and an open channel along with a channel waiting to be closed
+	// with the second.
+	var peer1, peer2 route.Vertex
+	for i := 0; i < 5; i++ {
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		channel.Capacity = btcutil.Amount(1000 * (i + 1))
+		if i >= 3 {
+			channel.IdentityPub = otherPub
+			peer2 = route.NewVertex(otherPub)
+		} else {
+			peer1 = route.NewVertex(channel.IdentityPub)
+		}
+
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+		if i == 2 {
+			continue
+		}
+
+		err = channel.MarkAsOpen(lnwire.NewShortChanIDFromInt(
+			uint64(i + 1),
+		))
+		if err != nil {
+			t.Fatalf("unable to mark channel open: %v", err)
+		}
+		if i == 4 {
+			err := channel.MarkCommitmentBroadcasted(
+				channel.FundingTxn,
+			)
+			if err != nil {
+				t.Fatalf("unable to mark commitment "+
+					"broadcast: %v", err)
+			}
+		}
+	}
+
+	summaries, err := cdb.PeerChannelSummary()
+	if err != nil {
+		t.Fatalf("unable to fetch peer summaries: %v", err)
+	}
+
+	expected := map[route.Vertex]*PeerSummary{
+		peer1: {
+			NumChannels: 2,
+			Capacity:    3000,
+		},
+		peer2: {
+			NumChannels: 1,
+			Capacity:    4000,
+		},
+	}
+	if !reflect.DeepEqual(expected, summaries) {
+		t.Fatalf("expected summaries %v, got %v",
+			spew.Sdump(expected), spew.Sdump(summaries))
+	}
+}
@@ -0,0 +1,350 @@
+package migration12
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// closedChannelBucket stores summarization information concerning previously
+// open, but now closed channels.
+var closedChannelBucket = []byte("closed-chan-bucket")
+
+const (
+	// closeSummaryPrefixLen is the length of the fixed size fields that
+	// precede the optional fields of a serialized close summary: the
+	// channel point (36), short channel ID (8), chain hash (32), closing
+	// txid (32), close height (4), remote pubkey (33), capacity (8),
+	// settled balance (8), time locked balance (8), close type (1) and
+	// pending flag (1).
+	closeSummaryPrefixLen = 171
+
+	// pubKeyLen is the length of a serialized compressed public key.
+	pubKeyLen = 33
+
+	// chanConstraintsLen is the length of the channel constraints that
+	// precede the key descriptors of a serialized channel config: the
+	// dust limit, max pending amount, channel reserve and min htlc (8
+	// each), followed by the max accepted htlcs and csv delay (2 each).
+	chanConstraintsLen = 36
+
+	// numChanConfigKeys is the number of key descriptors within a
+	// serialized channel config.
+	numChanConfigKeys = 5
+
+	// closeSummaryTLVMarker is written in place of the boolean that
+	// indicates the presence of the optional fields within the legacy
+	// close summary encoding. It signals that the optional fields follow
+	// as a TLV stream instead.
+	closeSummaryTLVMarker byte = 2
+
+	// A set of tlv type definitions used to serialize the optional fields
+	// of a channel close summary.
+	remoteCurrentRevocationType tlv.Type = 1
+	localChanConfigType         tlv.Type = 3
+	remoteNextRevocationType    tlv.Type = 5
+	lastChanSyncMsgType         tlv.Type = 7
+)
+
+// errShortSummary is returned when a serialized close summary ends before all
+// of its fields could be read.
+var errShortSummary = errors.New("close summary too short")
+
+// MigrateCloseSummaryTLV re-encodes the optional fields of all channel close
+// summaries, which were previously indicated using boolean flags, as a TLV
+// stream. This allows further fields to be added to the summary without
+// requiring a new migration.
+func MigrateCloseSummaryTLV(tx *bbolt.Tx) error {
+	return MigrateCloseSummaryTLVContext(context.Background(), tx)
+}
+
+// MigrateCloseSummaryTLVContext is identical to MigrateCloseSummaryTLV, but
+// aborts the migration with the context's error once the passed context is
+// done.
+func MigrateCloseSummaryTLVContext(ctx context.Context, tx *bbolt.Tx) error {
+	closedChanBucket := tx.Bucket(closedChannelBucket)
+	if closedChanBucket == nil {
+		return nil
+	}
+
+	log.Infof("Migrating channel close summaries to TLV encoding")
+
+	// We'll first collect all summaries, as we can't modify the bucket
+	// while iterating over it.
+	var keys, summaries [][]byte
+	err := closedChanBucket.ForEach(func(k, v []byte) error {
+		keys = append(keys, k)
+		summaries = append(summaries, v)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		summary, err := convertCloseSummary(summaries[i])
+		if err != nil {
+			return fmt.Errorf("unable to migrate close summary "+
+				"for key=%x: %v", key, err)
+		}
+
+		if err := closedChanBucket.Put(key, summary); err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Migration of %v channel close summaries to TLV encoding "+
+		"complete", len(keys))
+
+	return nil
+}
+
+// convertCloseSummary converts a close summary serialized using the legacy
+// encoding into one that encodes its optional fields as a TLV stream. The
+// fixed size fields of the summary are carried over as is.
+func convertCloseSummary(legacy []byte) ([]byte, error) {
+	if len(legacy) < closeSummaryPrefixLen+1 {
+		return nil, errShortSummary
+	}
+
+	var b bytes.Buffer
+	b.Write(legacy[:closeSummaryPrefixLen])
+	b.WriteByte(closeSummaryTLVMarker)
+
+	// If the optional fields aren't present, then we're left with an
+	// empty TLV stream.
+	r := bytes.NewReader(legacy[closeSummaryPrefixLen+1:])
+	switch legacy[closeSummaryPrefixLen] {
+	case 0:
+		return b.Bytes(), nil
+
+	// If the summary has already been migrated, then we'll leave it as
+	// is.
+	case closeSummaryTLVMarker:
+		return legacy, nil
+	}
+
+	// Otherwise, the remote party's current revocation point and our
+	// channel config are always present.
+	var remoteCurrentRevocation [pubKeyLen]byte
+	if _, err := io.ReadFull(r, remoteCurrentRevocation[:]); err != nil {
+		return nil, errShortSummary
+	}
+	chanConfig, err := readRawChanConfig(r)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(
+			remoteCurrentRevocationType, &remoteCurrentRevocation,
+		),
+		tlv.MakePrimitiveRecord(localChanConfigType, &chanConfig),
+	}
+
+	// The remote party's next revocation point is optional, and preceded
+	// by a boolean indicating its presence.
+	hasRemoteNextRevocation, err := r.ReadByte()
+	if err != nil {
+		return nil, errShortSummary
+	}
+	var remoteNextRevocation [pubKeyLen]byte
+	if hasRemoteNextRevocation != 0 {
+		if _, err := io.ReadFull(r, remoteNextRevocation[:]); err != nil {
+			return nil, errShortSummary
+		}
+
+		records = append(records, tlv.MakePrimitiveRecord(
+			remoteNextRevocationType, &remoteNextRevocation,
+		))
+	}
+
+	// Finally, summaries written before the addition of the channel sync
+	// message may end here. Otherwise, the message is the final field.
+	hasChanSyncMsg, err := r.ReadByte()
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if err == nil && hasChanSyncMsg != 0 {
+		chanSyncMsg := make([]byte, r.Len())
+		if _, err := io.ReadFull(r, chanSyncMsg); err != nil {
+			return nil, err
+		}
+
+		records = append(records, tlv.MakePrimitiveRecord(
+			lastChanSyncMsgType, &chanSyncMsg,
+		))
+	}
+
+	tlvStream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+	if err := tlvStream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// readRawChanConfig reads the raw bytes of a serialized channel config. The
+// config consists of a set of fixed size constraints followed by a number of
+// key descriptors, each of which may or may not include a public key.
+func readRawChanConfig(r *bytes.Reader) ([]byte, error) {
+	var b bytes.Buffer
+
+	constraints := make([]byte, chanConstraintsLen)
+	if _, err := io.ReadFull(r, constraints); err != nil {
+		return nil, errShortSummary
+	}
+	b.Write(constraints)
+
+	for i := 0; i < numChanConfigKeys; i++ {
+		// Each key descriptor consists of its family and index (4
+		// bytes each), followed by a boolean indicating whether a
+		// public key is present.
+		locator := make([]byte, 9)
+		if _, err := io.ReadFull(r, locator); err != nil {
+			return nil, errShortSummary
+		}
+		b.Write(locator)
+
+		if locator[8] == 0 {
+			continue
+		}
+
+		pubKey := make([]byte, pubKeyLen)
+		if _, err := io.ReadFull(r, pubKey); err != nil {
+			return nil, errShortSummary
+		}
+		b.Write(pubKey)
+	}
+
+	return b.Bytes(), nil
+}
+
+// RevertCloseSummaryTLV re-encodes the optional fields of all channel close
+// summaries, which are encoded as a TLV stream, using the legacy boolean
+// flags, undoing MigrateCloseSummaryTLV.
+func RevertCloseSummaryTLV(tx *bbolt.Tx) error {
+	closedChanBucket := tx.Bucket(closedChannelBucket)
+	if closedChanBucket == nil {
+		return nil
+	}
+
+	log.Infof("Reverting channel close summaries to legacy encoding")
+
+	var keys, summaries [][]byte
+	err := closedChanBucket.ForEach(func(k, v []byte) error {
+		keys = append(keys, k)
+		summaries = append(summaries, v)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, key := range keys {
+		summary, err := revertCloseSummary(summaries[i])
+		if err != nil {
+			return fmt.Errorf("unable to revert close summary "+
+				"for key=%x: %v", key, err)
+		}
+
+		if err := closedChanBucket.Put(key, summary); err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Reverted %v channel close summaries to legacy encoding",
+		len(keys))
+
+	return nil
+}
+
+// revertCloseSummary converts a close summary that encodes its optional
+// fields as a TLV stream into one serialized using the legacy encoding. The
+// legacy encoding can only represent the optional fields if the remote
+// party's current revocation point is known, so they're dropped otherwise,
+// just as the legacy encoding did.
+func revertCloseSummary(summary []byte) ([]byte, error) {
+	if len(summary) < closeSummaryPrefixLen+1 {
+		return nil, errShortSummary
+	}
+
+	var b bytes.Buffer
+	b.Write(summary[:closeSummaryPrefixLen])
+
+	// Summaries that are already using the legacy encoding are left as
+	// is.
+	if summary[closeSummaryPrefixLen] != closeSummaryTLVMarker {
+		return summary, nil
+	}
+
+	var (
+		remoteCurrentRevocation [pubKeyLen]byte
+		remoteNextRevocation    [pubKeyLen]byte
+		chanConfig, chanSyncMsg []byte
+	)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(
+			remoteCurrentRevocationType, &remoteCurrentRevocation,
+		),
+		tlv.MakePrimitiveRecord(localChanConfigType, &chanConfig),
+		tlv.MakePrimitiveRecord(
+			remoteNextRevocationType, &remoteNextRevocation,
+		),
+		tlv.MakePrimitiveRecord(lastChanSyncMsgType, &chanSyncMsg),
+	)
+	if err != nil {
+		return nil, err
+	}
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(
+		bytes.NewReader(summary[closeSummaryPrefixLen+1:]),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := parsedTypes[remoteCurrentRevocationType]; !ok {
+		b.WriteByte(0)
+		return b.Bytes(), nil
+	}
+
+	b.WriteByte(1)
+	b.Write(remoteCurrentRevocation[:])
+
+	// The legacy encoding always includes our channel config, so we'll
+	// write an empty one if it wasn't known.
+	if _, ok := parsedTypes[localChanConfigType]; !ok {
+		chanConfig = make(
+			[]byte, chanConstraintsLen+numChanConfigKeys*9,
+		)
+	}
+	b.Write(chanConfig)
+
+	if _, ok := parsedTypes[remoteNextRevocationType]; ok {
+		b.WriteByte(1)
+		b.Write(remoteNextRevocation[:])
+	} else {
+		b.WriteByte(0)
+	}
+
+	if _, ok := parsedTypes[lastChanSyncMsgType]; ok {
+		b.WriteByte(1)
+		b.Write(chanSyncMsg)
+	} else {
+		b.WriteByte(0)
+	}
+
+	return b.Bytes(), nil
+}
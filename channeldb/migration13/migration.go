@@ -0,0 +1,35 @@
+package migration13
+
+import (
+	"github.com/coreos/bbolt"
+)
+
+// channelEventLogBucket is the top-level bucket housing the append-only log
+// of channel status transitions.
+var channelEventLogBucket = []byte("channel-event-log")
+
+// CreateChannelEventLog creates the top-level bucket used to record the status
+// transitions of channels for databases that were created before the event
+// log was introduced. It only pre-creates the bucket: no events are
+// backfilled, and the bucket is created on demand once the first event is
+// logged anyway, so the migration is kept only as the version number is
+// already taken.
+func CreateChannelEventLog(tx *bbolt.Tx) error {
+	log.Infof("Creating channel event log bucket")
+
+	_, err := tx.CreateBucketIfNotExists(channelEventLogBucket)
+	return err
+}
+
+// DeleteChannelEventLog removes the top-level bucket housing the channel event
+// log, undoing CreateChannelEventLog. Any events recorded within it are lost.
+func DeleteChannelEventLog(tx *bbolt.Tx) error {
+	log.Infof("Deleting channel event log bucket")
+
+	err := tx.DeleteBucket(channelEventLogBucket)
+	if err != nil && err != bbolt.ErrBucketNotFound {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package migration14
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// openChannelBucket is the top-level bucket housing the state of all
+	// open channels, keyed by the node public key, the chain hash and the
+	// channel point of each channel.
+	openChannelBucket = []byte("open-chan-bucket")
+
+	// chanInfoKey is the key within the bucket of each channel that stores
+	// its static information.
+	chanInfoKey = []byte("chan-info-key")
+
+	// chanOpenHeightBucket is the top-level bucket indexing open channels
+	// by the height of the block their funding transaction confirmed in.
+	chanOpenHeightBucket = []byte("chan-open-height-index")
+
+	// byteOrder is the byte order used to serialize integers.
+	byteOrder = binary.BigEndian
+)
+
+const (
+	// chanInfoPrefixLen is the length of the fixed size fields at the
+	// start of the static channel info that are needed by the migration:
+	// the channel type (1), chain hash (32), funding outpoint (36), short
+	// channel ID (8) and pending flag (1).
+	chanInfoPrefixLen = 78
+
+	// shortChanIDOffset is the offset of the short channel ID within the
+	// static channel info.
+	shortChanIDOffset = 69
+
+	// pubKeyLen is the length of a serialized compressed public key.
+	pubKeyLen = 33
+)
+
+// errShortChanInfo is returned when the static info of a channel ends before
+// all of the fields needed by the migration could be read.
+var errShortChanInfo = errors.New("channel info too short")
+
+// CreateChannelOpenHeightIndex creates the index of open channels by the
+// height of the block their funding transaction confirmed in, and backfills
+// it from the short channel IDs of all channels that are no longer pending.
+func CreateChannelOpenHeightIndex(tx *bbolt.Tx) error {
+	log.Infof("Creating channel open height index")
+
+	heightIndex, err := tx.CreateBucketIfNotExists(chanOpenHeightBucket)
+	if err != nil {
+		return err
+	}
+
+	openChanBucket := tx.Bucket(openChannelBucket)
+	if openChanBucket == nil {
+		return nil
+	}
+
+	var numIndexed int
+	err = openChanBucket.ForEach(func(nodePub, v []byte) error {
+		if len(nodePub) != pubKeyLen || v != nil {
+			return nil
+		}
+		nodeChanBucket := openChanBucket.Bucket(nodePub)
+		if nodeChanBucket == nil {
+			return nil
+		}
+
+		return nodeChanBucket.ForEach(func(chainHash, v []byte) error {
+			if v != nil {
+				return nil
+			}
+			chainBucket := nodeChanBucket.Bucket(chainHash)
+			if chainBucket == nil {
+				return nil
+			}
+
+			return chainBucket.ForEach(func(chanPoint, v []byte) error {
+				if v != nil {
+					return nil
+				}
+				chanBucket := chainBucket.Bucket(chanPoint)
+				if chanBucket == nil {
+					return nil
+				}
+
+				indexed, err := indexChannel(
+					heightIndex, nodePub, chainHash,
+					chanPoint, chanBucket,
+				)
+				if err != nil {
+					return fmt.Errorf("unable to index "+
+						"channel %x: %v", chanPoint,
+						err)
+				}
+				if indexed {
+					numIndexed++
+				}
+
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Indexed open height of %d channels", numIndexed)
+
+	return nil
+}
+
+// indexChannel adds the channel stored within the passed bucket to the open
+// height index, returning true if it was indexed. Channels that are still
+// pending aren't indexed, as their funding transaction is yet to confirm.
+func indexChannel(heightIndex *bbolt.Bucket, nodePub, chainHash,
+	chanPoint []byte, chanBucket *bbolt.Bucket) (bool, error) {
+
+	info := chanBucket.Get(chanInfoKey)
+	if info == nil {
+		return false, nil
+	}
+	if len(info) < chanInfoPrefixLen {
+		return false, errShortChanInfo
+	}
+
+	isPending := info[chanInfoPrefixLen-1] != 0
+	if isPending {
+		return false, nil
+	}
+	shortChanID := byteOrder.Uint64(info[shortChanIDOffset:])
+	blockHeight := uint32(shortChanID >> 40)
+
+	// The index is keyed by the block height followed by the channel
+	// point, and maps to the node public key and chain hash needed to
+	// locate the channel's bucket.
+	key := make([]byte, 4+len(chanPoint))
+	byteOrder.PutUint32(key[:4], blockHeight)
+	copy(key[4:], chanPoint)
+
+	value := make([]byte, 0, len(nodePub)+len(chainHash))
+	value = append(value, nodePub...)
+	value = append(value, chainHash...)
+
+	return true, heightIndex.Put(key, value)
+}
+
+// DeleteChannelOpenHeightIndex removes the index of open channels by the
+// height of the block their funding transaction confirmed in, undoing
+// CreateChannelOpenHeightIndex.
+func DeleteChannelOpenHeightIndex(tx *bbolt.Tx) error {
+	log.Infof("Deleting channel open height index")
+
+	err := tx.DeleteBucket(chanOpenHeightBucket)
+	if err != nil && err != bbolt.ErrBucketNotFound {
+		return err
+	}
+
+	return nil
+}
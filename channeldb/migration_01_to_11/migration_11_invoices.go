@@ -2,6 +2,7 @@ package migration_01_to_11
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"fmt"
 	"io"
@@ -17,6 +18,12 @@ import (
 // MigrateInvoices adds invoice htlcs and a separate cltv delta field to the
 // invoices.
 func MigrateInvoices(tx *bbolt.Tx) error {
+	return MigrateInvoicesContext(context.Background(), tx)
+}
+
+// MigrateInvoicesContext is identical to MigrateInvoices, but aborts the
+// migration with the context's error once the passed context is done.
+func MigrateInvoicesContext(ctx context.Context, tx *bbolt.Tx) error {
 	log.Infof("Migrating invoices to new invoice format")
 
 	invoiceB := tx.Bucket(invoiceBucket)
@@ -59,6 +66,10 @@ func MigrateInvoices(tx *bbolt.Tx) error {
 
 	// Iterate over all stored keys and migrate the invoices.
 	for _, k := range invoiceKeys {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
 		v := invoiceB.Get(k)
 
 		// Deserialize the invoice with the deserializing function that
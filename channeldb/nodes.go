@@ -2,6 +2,7 @@ package channeldb
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"net"
 	"time"
@@ -185,6 +186,70 @@ func fetchLinkNode(tx *bbolt.Tx, targetPub *btcec.PublicKey) (*LinkNode, error)
 	return deserializeLinkNode(nodeReader)
 }
 
+// MarkLinkNodeSeen updates the last seen time of the link node with the given
+// identity to the current time, as reported by the database's clock. If no
+// link node for the identity exists, then ErrNodeNotFound is returned.
+func (db *DB) MarkLinkNodeSeen(identity *btcec.PublicKey) error {
+	return db.Update(func(tx *bbolt.Tx) error {
+		linkNode, err := fetchLinkNode(tx, identity)
+		if err != nil {
+			return err
+		}
+
+		// The last seen time is stored with second precision, so
+		// we'll truncate it here to mirror what will be read back.
+		linkNode.LastSeen = time.Unix(db.now().Unix(), 0)
+
+		return putLinkNode(tx.Bucket(nodeInfoBucket), linkNode)
+	})
+}
+
+// PutLinkNodeAddresses merges the passed addresses into the set of addresses
+// the link node with the given identity is known to be reachable at, skipping
+// any that are already known. If no link node for the identity exists yet,
+// then it's created, such that the addresses are returned by AddrsForNode
+// alongside those advertised by the node within the channel graph.
+func (db *DB) PutLinkNodeAddresses(nodePub *btcec.PublicKey,
+	addrs ...net.Addr) error {
+
+	if len(addrs) == 0 {
+		return nil
+	}
+
+	return db.Update(func(tx *bbolt.Tx) error {
+		nodeMetaBucket := tx.Bucket(nodeInfoBucket)
+		if nodeMetaBucket == nil {
+			return ErrLinkNodesNotFound
+		}
+
+		// As with the link nodes created when a channel is opened,
+		// a new link node is assumed to be on mainnet.
+		linkNode, err := fetchLinkNode(tx, nodePub)
+		switch {
+		case err == ErrNodeNotFound:
+			linkNode = db.NewLinkNode(wire.MainNet, nodePub)
+
+		case err != nil:
+			return err
+		}
+
+		known := make(map[string]struct{})
+		for _, addr := range linkNode.Addresses {
+			known[addr.String()] = struct{}{}
+		}
+		for _, addr := range addrs {
+			if _, ok := known[addr.String()]; ok {
+				continue
+			}
+
+			known[addr.String()] = struct{}{}
+			linkNode.Addresses = append(linkNode.Addresses, addr)
+		}
+
+		return putLinkNode(nodeMetaBucket, linkNode)
+	})
+}
+
 // TODO(roasbeef): update link node addrs in server upon connection
 
 // FetchAllLinkNodes starts a new database transaction to fetch all nodes with
@@ -210,13 +275,36 @@ func (db *DB) FetchAllLinkNodes() ([]*LinkNode, error) {
 // fetchAllLinkNodes uses an existing database transaction to fetch all nodes
 // with whom we have active channels with.
 func (db *DB) fetchAllLinkNodes(tx *bbolt.Tx) ([]*LinkNode, error) {
+	var linkNodes []*LinkNode
+	err := forEachLinkNode(tx, func(linkNode *LinkNode) error {
+		linkNodes = append(linkNodes, linkNode)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return linkNodes, nil
+}
+
+// ForEachLinkNode iterates through all nodes with whom we have active channels
+// with, executing the passed callback for each one. If the callback returns an
+// error, then iteration is halted and the error is returned to the caller.
+func (db *DB) ForEachLinkNode(cb func(*LinkNode) error) error {
+	return db.View(func(tx *bbolt.Tx) error {
+		return forEachLinkNode(tx, cb)
+	})
+}
+
+// forEachLinkNode uses an existing database transaction to execute the passed
+// callback for each link node within the database.
+func forEachLinkNode(tx *bbolt.Tx, cb func(*LinkNode) error) error {
 	nodeMetaBucket := tx.Bucket(nodeInfoBucket)
 	if nodeMetaBucket == nil {
-		return nil, ErrLinkNodesNotFound
+		return ErrLinkNodesNotFound
 	}
 
-	var linkNodes []*LinkNode
-	err := nodeMetaBucket.ForEach(func(k, v []byte) error {
+	return nodeMetaBucket.ForEach(func(k, v []byte) error {
 		if v == nil {
 			return nil
 		}
@@ -224,17 +312,12 @@ func (db *DB) fetchAllLinkNodes(tx *bbolt.Tx) ([]*LinkNode, error) {
 		nodeReader := bytes.NewReader(v)
 		linkNode, err := deserializeLinkNode(nodeReader)
 		if err != nil {
-			return err
+			return fmt.Errorf("unable to decode link node %x: %v",
+				k, err)
 		}
 
-		linkNodes = append(linkNodes, linkNode)
-		return nil
+		return cb(linkNode)
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	return linkNodes, nil
 }
 
 func serializeLinkNode(w io.Writer, l *LinkNode) error {
@@ -2,11 +2,15 @@ package channeldb
 
 import (
 	"bytes"
+	"errors"
+	"io/ioutil"
 	"net"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 )
 
@@ -138,3 +142,355 @@ func TestDeleteLinkNode(t *testing.T) {
 		t.Fatal("should not have found link node in db, but did")
 	}
 }
+
+// TestStartupLinkNodeGC asserts that link nodes we have no open channels with
+// are pruned on Open when the startup garbage collection option is set.
+func TestStartupLinkNodeGC(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	cdb, err := Open(tempDirName)
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+
+	// We'll write a link node without any accompanying channels, as a
+	// version that didn't prune link nodes on close would've left behind.
+	_, pubKey := btcec.PrivKeyFromBytes(btcec.S256(), key[:])
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 1337,
+	}
+	linkNode := cdb.NewLinkNode(wire.TestNet3, pubKey, addr)
+	if err := linkNode.Sync(); err != nil {
+		t.Fatalf("unable to write link node to db: %v", err)
+	}
+	cdb.Close()
+
+	// Re-opening the database without the option shouldn't touch the
+	// link node.
+	cdb, err = Open(tempDirName)
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+	if _, err := cdb.FetchLinkNode(pubKey); err != nil {
+		t.Fatalf("unable to find link node: %v", err)
+	}
+	cdb.Close()
+
+	// With the option set however, the stale link node should be removed.
+	cdb, err = Open(tempDirName, OptionSetStartupLinkNodeGC(true))
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+	defer cdb.Close()
+
+	if _, err := cdb.FetchLinkNode(pubKey); err != ErrNodeNotFound {
+		t.Fatalf("expected ErrNodeNotFound, got: %v", err)
+	}
+}
+
+// TestPruneLinkNodesExcept tests that link nodes within the keep set survive a
+// prune, while all other link nodes without channels are removed.
+func TestPruneLinkNodesExcept(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// We'll create two link nodes, neither of which have any channels.
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 1337,
+	}
+	_, keepPub := btcec.PrivKeyFromBytes(btcec.S256(), key[:])
+	_, prunePub := btcec.PrivKeyFromBytes(btcec.S256(), rev[:])
+	for _, pub := range []*btcec.PublicKey{keepPub, prunePub} {
+		linkNode := cdb.NewLinkNode(wire.TestNet3, pub, addr)
+		if err := linkNode.Sync(); err != nil {
+			t.Fatalf("unable to write link node to db: %v", err)
+		}
+	}
+
+	if err := cdb.PruneLinkNodesExcept([]*btcec.PublicKey{keepPub}); err != nil {
+		t.Fatalf("unable to prune link nodes: %v", err)
+	}
+
+	// Only the link node within the keep set should remain.
+	if _, err := cdb.FetchLinkNode(keepPub); err != nil {
+		t.Fatalf("unable to find link node: %v", err)
+	}
+	if _, err := cdb.FetchLinkNode(prunePub); err != ErrNodeNotFound {
+		t.Fatalf("expected ErrNodeNotFound, got: %v", err)
+	}
+}
+
+// TestForEachLinkNode tests that ForEachLinkNode visits every link node within
+// the database, and halts iteration once the callback returns an error.
+func TestForEachLinkNode(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 1337,
+	}
+	_, pub1 := btcec.PrivKeyFromBytes(btcec.S256(), key[:])
+	_, pub2 := btcec.PrivKeyFromBytes(btcec.S256(), rev[:])
+	pubs := []*btcec.PublicKey{pub1, pub2}
+	for _, pub := range pubs {
+		linkNode := cdb.NewLinkNode(wire.TestNet3, pub, addr)
+		if err := linkNode.Sync(); err != nil {
+			t.Fatalf("unable to write link node to db: %v", err)
+		}
+	}
+
+	// All of the link nodes written above should be visited.
+	visited := make(map[string]struct{})
+	err = cdb.ForEachLinkNode(func(linkNode *LinkNode) error {
+		if len(linkNode.Addresses) != 1 ||
+			linkNode.Addresses[0].String() != addr.String() {
+
+			t.Fatalf("unexpected addresses: %v",
+				linkNode.Addresses)
+		}
+
+		pub := linkNode.IdentityPub.SerializeCompressed()
+		visited[string(pub)] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to iterate link nodes: %v", err)
+	}
+	for _, pub := range pubs {
+		if _, ok := visited[string(pub.SerializeCompressed())]; !ok {
+			t.Fatalf("link node %x not visited",
+				pub.SerializeCompressed())
+		}
+	}
+
+	// An error returned by the callback should halt iteration and be
+	// returned to the caller.
+	errStop := errors.New("stop")
+	numVisited := 0
+	err = cdb.ForEachLinkNode(func(*LinkNode) error {
+		numVisited++
+		return errStop
+	})
+	if err != errStop {
+		t.Fatalf("expected errStop, got: %v", err)
+	}
+	if numVisited != 1 {
+		t.Fatalf("expected iteration to halt after 1 node, visited %v",
+			numVisited)
+	}
+}
+
+// TestMarkLinkNodeSeen tests that marking a link node as seen updates its last
+// seen time to the current time of the database's clock.
+func TestMarkLinkNodeSeen(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	now := time.Unix(1000000, 0)
+	cdb.now = func() time.Time {
+		return now
+	}
+
+	_, pub := btcec.PrivKeyFromBytes(btcec.S256(), key[:])
+
+	// Marking an unknown link node as seen should fail.
+	if err := cdb.MarkLinkNodeSeen(pub); err != ErrNodeNotFound {
+		t.Fatalf("expected ErrNodeNotFound, got: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 1337,
+	}
+	linkNode := cdb.NewLinkNode(wire.TestNet3, pub, addr)
+	linkNode.LastSeen = time.Unix(0, 0)
+	if err := linkNode.Sync(); err != nil {
+		t.Fatalf("unable to write link node to db: %v", err)
+	}
+
+	if err := cdb.MarkLinkNodeSeen(pub); err != nil {
+		t.Fatalf("unable to mark link node seen: %v", err)
+	}
+
+	// The last seen time should now reflect our clock, while the rest of
+	// the link node is left untouched.
+	dbNode, err := cdb.FetchLinkNode(pub)
+	if err != nil {
+		t.Fatalf("unable to fetch link node: %v", err)
+	}
+	if !dbNode.LastSeen.Equal(now) {
+		t.Fatalf("expected last seen %v, got %v", now,
+			dbNode.LastSeen)
+	}
+	if len(dbNode.Addresses) != 1 ||
+		dbNode.Addresses[0].String() != addr.String() {
+
+		t.Fatalf("unexpected addresses: %v", dbNode.Addresses)
+	}
+}
+
+// TestPutLinkNodeAddresses asserts that addresses are merged into those of an
+// existing link node without duplicates, and that a link node is created for
+// an unknown identity.
+func TestPutLinkNodeAddresses(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr1 := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 1337,
+	}
+	addr2 := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.2"),
+		Port: 1338,
+	}
+	assertAddrs := func(pub *btcec.PublicKey, network wire.BitcoinNet,
+		expected ...net.Addr) {
+
+		t.Helper()
+
+		dbNode, err := cdb.FetchLinkNode(pub)
+		if err != nil {
+			t.Fatalf("unable to fetch link node: %v", err)
+		}
+		if dbNode.Network != network {
+			t.Fatalf("expected network %v, got %v", network,
+				dbNode.Network)
+		}
+		if len(dbNode.Addresses) != len(expected) {
+			t.Fatalf("expected %v addresses, got %v",
+				len(expected), dbNode.Addresses)
+		}
+		for i, addr := range expected {
+			if dbNode.Addresses[i].String() != addr.String() {
+				t.Fatalf("expected address %v, got %v", addr,
+					dbNode.Addresses[i])
+			}
+		}
+	}
+
+	// Adding addresses for an unknown identity should create its link
+	// node, without duplicating addresses.
+	_, pub1 := btcec.PrivKeyFromBytes(btcec.S256(), key[:])
+	if err := cdb.PutLinkNodeAddresses(pub1, addr1, addr1); err != nil {
+		t.Fatalf("unable to put link node addresses: %v", err)
+	}
+	assertAddrs(pub1, wire.MainNet, addr1)
+
+	// Adding addresses to an existing link node should only add those
+	// that aren't known yet, leaving the rest of the node untouched.
+	_, pub2 := btcec.PrivKeyFromBytes(btcec.S256(), rev[:])
+	linkNode := cdb.NewLinkNode(wire.TestNet3, pub2, addr1)
+	if err := linkNode.Sync(); err != nil {
+		t.Fatalf("unable to write link node to db: %v", err)
+	}
+	if err := cdb.PutLinkNodeAddresses(pub2, addr2, addr1); err != nil {
+		t.Fatalf("unable to put link node addresses: %v", err)
+	}
+	assertAddrs(pub2, wire.TestNet3, addr1, addr2)
+
+	// The addresses should be surfaced when querying for the addresses of
+	// the node.
+	addrs, err := cdb.AddrsForNode(pub2)
+	if err != nil {
+		t.Fatalf("unable to fetch addresses: %v", err)
+	}
+	if len(addrs) != 2 {
+		t.Fatalf("expected 2 addresses, got %v", addrs)
+	}
+}
+
+// TestPruneLinkNodeMultipleChains tests that a link node isn't pruned once a
+// channel with it is fully closed while it still has a channel open on
+// another chain.
+func TestPruneLinkNodeMultipleChains(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 1337,
+	}
+
+	// We'll open two channels with the same peer, each on a different
+	// chain.
+	var channels []*OpenChannel
+	for _, chainHash := range []chainhash.Hash{key, rev} {
+		channel, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		channel.ChainHash = chainHash
+		if err := channel.SyncPending(addr, 10); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+
+		channels = append(channels, channel)
+	}
+	peer := channels[0].IdentityPub
+
+	closeChannel := func(channel *OpenChannel) {
+		err := channel.CloseChannel(&ChannelCloseSummary{
+			ChanPoint: channel.FundingOutpoint,
+			ChainHash: channel.ChainHash,
+			RemotePub: channel.IdentityPub,
+			IsPending: true,
+		})
+		if err != nil {
+			t.Fatalf("unable to close channel: %v", err)
+		}
+		err = cdb.MarkChanFullyClosed(&channel.FundingOutpoint)
+		if err != nil {
+			t.Fatalf("unable to mark channel fully closed: %v", err)
+		}
+	}
+
+	// Once the channel on the first chain is fully closed, the link node
+	// should be retained as the channel on the second chain is still
+	// open.
+	closeChannel(channels[0])
+	if _, err := cdb.FetchLinkNode(peer); err != nil {
+		t.Fatalf("unable to find link node: %v", err)
+	}
+
+	// However, once the channel on the second chain is fully closed as
+	// well, the link node should be pruned.
+	closeChannel(channels[1])
+	if _, err := cdb.FetchLinkNode(peer); err != ErrNodeNotFound {
+		t.Fatalf("expected ErrNodeNotFound, got: %v", err)
+	}
+}
@@ -1,5 +1,11 @@
 package channeldb
 
+import (
+	"time"
+
+	"github.com/coreos/bbolt"
+)
+
 const (
 	// DefaultRejectCacheSize is the default number of rejectCacheEntries to
 	// cache for use in the rejection cache of incoming gossip traffic. This
@@ -26,6 +32,112 @@ type Options struct {
 	// freelist to disk, resulting in improved performance at the expense of
 	// increased startup time.
 	NoFreelistSync bool
+
+	// FreelistType is the backend used by bolt to track free pages. The
+	// hashmap freelist allocates and frees pages in near constant time,
+	// while the array freelist is simpler and uses less memory, but
+	// allocations grow linearly with the size of the freelist which can
+	// become slow for large, fragmented databases. Either type composes
+	// with NoFreelistSync.
+	FreelistType bbolt.FreelistType
+
+	// InitialMmapSize is the initial size in bytes of the memory map of the
+	// database. Pre-sizing the mapping of large databases avoids the
+	// repeated remapping otherwise incurred as the database grows, which
+	// can fail on platforms with a limited address space. A zero value
+	// leaves the initial size to bolt, which maps the size of the file.
+	InitialMmapSize int
+
+	// PageSize is the page size in bytes used when creating a new
+	// database. Larger pages reduce the number of overflow pages needed
+	// for large values, such as big graph edges or channels with many
+	// HTLCs. As the page size is fixed once the database file is created,
+	// it's ignored for existing databases. A zero value leaves the page
+	// size to bolt, which uses the page size of the OS.
+	PageSize int
+
+	// OpenTimeout is the amount of time to wait to obtain the file lock on
+	// the database before giving up. A zero value means that we'll block
+	// indefinitely until the lock is released.
+	OpenTimeout time.Duration
+
+	// AllowDowngrade, if true, permits reverting the database to a prior
+	// version through DB.Downgrade. This should only be used during
+	// development.
+	AllowDowngrade bool
+
+	// StartupLinkNodeGC, if true, prunes all link nodes we no longer have
+	// any open channels with once during Open, after all migrations have
+	// been applied.
+	StartupLinkNodeGC bool
+
+	// WarmGraphCaches, if true, populates the graph's reject and channel
+	// caches during Open by scanning the edge index once, trading some
+	// startup time for consistent query latency after boot.
+	WarmGraphCaches bool
+
+	// ArchiveClosedChannels, if true, stores a trimmed snapshot of the
+	// final state of each channel alongside its close summary when it's
+	// closed.
+	ArchiveClosedChannels bool
+
+	// KeepHistoricalChannels, if true, retains the complete state of each
+	// channel, including its revocation log, when it's closed.
+	KeepHistoricalChannels bool
+
+	// UpdateMaxRetries is the maximum number of times a mutating operation
+	// retries its transaction after failing due to a transient error of
+	// the underlying storage. A zero value disables retries.
+	UpdateMaxRetries int
+
+	// UpdateRetryBackoff is the delay before the first retry of a failed
+	// transaction, which is doubled with each subsequent retry.
+	UpdateRetryBackoff time.Duration
+
+	// TxMetrics, if non-nil, receives the time each transaction spent
+	// waiting to begin and being held open.
+	TxMetrics TxMetricsRecorder
+
+	// MigrationTimeout is the maximum amount of time the migrations
+	// applied during Open may take. Once it has elapsed, the migrations
+	// are rolled back and Open fails with ErrMigrationTimeout, wrapped
+	// within a MigrationError if any of them were attempted. Only
+	// migrations that check for cancellation can be interrupted, so the
+	// timeout may be exceeded by a migration that doesn't. A zero value
+	// means that there's no limit.
+	MigrationTimeout time.Duration
+
+	// ReadOnly, if true, opens an existing database without attempting
+	// any writes, such that it can be inspected on a read-only
+	// filesystem. The database must already be at the latest version, as
+	// no migrations can be applied, and every operation that mutates the
+	// database fails.
+	ReadOnly bool
+
+	// MigrationOverrides are corrected migrations keyed by the version of
+	// the migration they replace. If the database is yet to reach the
+	// version, then the override is applied in place of the original
+	// migration. Otherwise, it's applied as a fix on top of the already
+	// migrated database. Either way, each override is only ever applied
+	// once.
+	MigrationOverrides map[uint32]func(*bbolt.Tx) error
+
+	// PreMigrationBackupDir, if non-empty, is the directory a copy of the
+	// database is written to before any pending migrations are applied,
+	// such that it can be restored should the migrations fail.
+	PreMigrationBackupDir string
+
+	// MigrationMinFreeBytes, if non-zero, is the minimum number of bytes
+	// that must be free on the filesystem of the database before any
+	// pending migrations are applied. As migrations may temporarily grow
+	// the database considerably, this allows Open to fail early with
+	// ErrInsufficientDiskSpace rather than once the disk fills up.
+	MigrationMinFreeBytes uint64
+
+	// PostOpenHook, if non-nil, is invoked with the database once all
+	// migrations have been applied, but before Open returns. If it
+	// returns an error, then the database is closed and Open fails.
+	PostOpenHook func(*DB) error
 }
 
 // DefaultOptions returns an Options populated with default values.
@@ -34,6 +146,7 @@ func DefaultOptions() Options {
 		RejectCacheSize:  DefaultRejectCacheSize,
 		ChannelCacheSize: DefaultChannelCacheSize,
 		NoFreelistSync:   true,
+		FreelistType:     bbolt.FreelistMapType,
 	}
 }
 
@@ -60,3 +173,148 @@ func OptionSetSyncFreelist(b bool) OptionModifier {
 		o.NoFreelistSync = !b
 	}
 }
+
+// OptionSetFreelistType sets the backend bolt uses to track free pages.
+func OptionSetFreelistType(t bbolt.FreelistType) OptionModifier {
+	return func(o *Options) {
+		o.FreelistType = t
+	}
+}
+
+// OptionSetPageSize sets the page size in bytes used when creating a new
+// database.
+func OptionSetPageSize(n int) OptionModifier {
+	return func(o *Options) {
+		o.PageSize = n
+	}
+}
+
+// OptionSetInitialMmapSize sets the initial size in bytes of the memory map of
+// the database.
+func OptionSetInitialMmapSize(n int) OptionModifier {
+	return func(o *Options) {
+		o.InitialMmapSize = n
+	}
+}
+
+// OptionSetOpenTimeout sets the amount of time Open will wait to acquire the
+// database file lock before failing with ErrDatabaseLocked.
+func OptionSetOpenTimeout(d time.Duration) OptionModifier {
+	return func(o *Options) {
+		o.OpenTimeout = d
+	}
+}
+
+// OptionSetAllowDowngrade allows the database to be reverted to a prior version
+// using DB.Downgrade.
+func OptionSetAllowDowngrade(b bool) OptionModifier {
+	return func(o *Options) {
+		o.AllowDowngrade = b
+	}
+}
+
+// OptionSetStartupLinkNodeGC sets whether stale link nodes should be garbage
+// collected when the database is opened.
+func OptionSetStartupLinkNodeGC(b bool) OptionModifier {
+	return func(o *Options) {
+		o.StartupLinkNodeGC = b
+	}
+}
+
+// OptionSetGraphCacheWarming sets whether the graph caches should be populated
+// when the database is opened.
+func OptionSetGraphCacheWarming(b bool) OptionModifier {
+	return func(o *Options) {
+		o.WarmGraphCaches = b
+	}
+}
+
+// OptionSetArchiveClosedChannels sets whether a snapshot of the final state of
+// each channel should be archived when it's closed.
+func OptionSetArchiveClosedChannels(b bool) OptionModifier {
+	return func(o *Options) {
+		o.ArchiveClosedChannels = b
+	}
+}
+
+// OptionSetKeepHistoricalChannels sets whether the complete state of each
+// channel should be retained when it's closed.
+func OptionSetKeepHistoricalChannels(b bool) OptionModifier {
+	return func(o *Options) {
+		o.KeepHistoricalChannels = b
+	}
+}
+
+// OptionSetUpdateRetry sets the maximum number of times a mutating operation
+// retries its transaction after a transient error, along with the delay
+// before the first retry.
+func OptionSetUpdateRetry(maxRetries int, backoff time.Duration) OptionModifier {
+	return func(o *Options) {
+		o.UpdateMaxRetries = maxRetries
+		o.UpdateRetryBackoff = backoff
+	}
+}
+
+// OptionSetTxMetrics sets the recorder the timing of each transaction is
+// reported to.
+func OptionSetTxMetrics(recorder TxMetricsRecorder) OptionModifier {
+	return func(o *Options) {
+		o.TxMetrics = recorder
+	}
+}
+
+// OptionSetMigrationTimeout sets the maximum amount of time the migrations
+// applied during Open may take.
+func OptionSetMigrationTimeout(d time.Duration) OptionModifier {
+	return func(o *Options) {
+		o.MigrationTimeout = d
+	}
+}
+
+// OptionSetReadOnly sets whether an existing database should be opened without
+// attempting any writes.
+func OptionSetReadOnly(b bool) OptionModifier {
+	return func(o *Options) {
+		o.ReadOnly = b
+	}
+}
+
+// OptionSetPreMigrationBackup sets the directory a copy of the database is
+// written to before any pending migrations are applied.
+func OptionSetPreMigrationBackup(dir string) OptionModifier {
+	return func(o *Options) {
+		o.PreMigrationBackupDir = dir
+	}
+}
+
+// OptionSetMigrationDiskCheck sets the minimum number of bytes that must be
+// free on the filesystem of the database before migrations are applied.
+func OptionSetMigrationDiskCheck(minFreeBytes uint64) OptionModifier {
+	return func(o *Options) {
+		o.MigrationMinFreeBytes = minFreeBytes
+	}
+}
+
+// OptionSetPostOpenHook sets a hook that's invoked with the database once it
+// has been opened and migrated, but before Open returns.
+func OptionSetPostOpenHook(hook func(*DB) error) OptionModifier {
+	return func(o *Options) {
+		o.PostOpenHook = hook
+	}
+}
+
+// OptionSetMigrationOverride registers fix as a corrected version of the
+// migration to the passed version, which is applied exactly once, even if the
+// database has already been migrated past the version.
+func OptionSetMigrationOverride(version uint32,
+	fix func(*bbolt.Tx) error) OptionModifier {
+
+	return func(o *Options) {
+		if o.MigrationOverrides == nil {
+			o.MigrationOverrides = make(
+				map[uint32]func(*bbolt.Tx) error,
+			)
+		}
+		o.MigrationOverrides[version] = fix
+	}
+}
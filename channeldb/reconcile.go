@@ -0,0 +1,222 @@
+package channeldb
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+// ReconcileReport details the discrepancies found between the set of open
+// channels and our own edges within the channel graph.
+type ReconcileReport struct {
+	// MissingEdges is the set of confirmed open channels that lack a
+	// corresponding edge within the channel graph.
+	MissingEdges []wire.OutPoint
+
+	// StaleEdges is the set of edges within the channel graph that have
+	// our node as one of their endpoints, yet don't correspond to any of
+	// our open channels.
+	StaleEdges []wire.OutPoint
+
+	// Fixed indicates whether the discrepancies above have been repaired.
+	Fixed bool
+}
+
+// ReconcileChannelsAndGraph cross-references the set of open channels against
+// the edges of our node within the channel graph, and returns a report of any
+// discrepancies found. Unless fix is set, the database isn't modified.
+// Otherwise, a shell edge is added for each open channel that is missing from
+// the graph, and each stale edge, such as one left behind by a channel that
+// has since been closed, is removed from the graph.
+//
+// NOTE: If the source node of the graph hasn't been set, then
+// ErrSourceNodeNotSet is returned.
+func (d *DB) ReconcileChannelsAndGraph(fix bool) (*ReconcileReport, error) {
+	chanGraph := d.ChannelGraph()
+
+	var (
+		report        *ReconcileReport
+		chansModified []uint64
+	)
+	reconcile := func(tx *bbolt.Tx) error {
+		report = &ReconcileReport{
+			Fixed: fix,
+		}
+		chansModified = nil
+
+		nodes := tx.Bucket(nodeBucket)
+		if nodes == nil {
+			return ErrGraphNotFound
+		}
+		selfNode, err := chanGraph.sourceNode(nodes)
+		if err != nil {
+			return err
+		}
+
+		var edges, edgeIndex, chanIndex *bbolt.Bucket
+		if edges = tx.Bucket(edgeBucket); edges != nil {
+			edgeIndex = edges.Bucket(edgeIndexBucket)
+			chanIndex = edges.Bucket(channelPointBucket)
+		}
+
+		// We'll gather the set of our open channels, along with the
+		// confirmed ones that don't have an edge within the graph.
+		var missingChans []*OpenChannel
+		openChans := make(map[string]struct{})
+		openChanBucket := tx.Bucket(openChannelBucket)
+		if openChanBucket != nil {
+			err := forEachChanBucket(openChanBucket, func(_,
+				chanPoint []byte, chanBucket *bbolt.Bucket) error {
+
+				openChans[string(chanPoint)] = struct{}{}
+
+				var channel OpenChannel
+				err := readOutpoint(
+					bytes.NewReader(chanPoint),
+					&channel.FundingOutpoint,
+				)
+				if err != nil {
+					return err
+				}
+				err = fetchChanInfo(chanBucket, &channel)
+				if err != nil {
+					return err
+				}
+
+				// Pending channels aren't expected to have an
+				// edge yet.
+				if channel.IsPending {
+					return nil
+				}
+
+				if chanIndex != nil &&
+					chanIndex.Get(chanPoint) != nil {
+
+					return nil
+				}
+
+				missingChans = append(missingChans, &channel)
+				report.MissingEdges = append(
+					report.MissingEdges,
+					channel.FundingOutpoint,
+				)
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Next, we'll run through the edges of the graph to find any
+		// of ours that don't correspond to an open channel.
+		var staleChanIDs [][]byte
+		if edgeIndex != nil && chanIndex != nil {
+			err := chanIndex.ForEach(func(chanPoint,
+				chanID []byte) error {
+
+				if _, ok := openChans[string(chanPoint)]; ok {
+					return nil
+				}
+
+				edgeInfo, err := fetchChanEdgeInfo(
+					edgeIndex, chanID,
+				)
+				if err != nil {
+					return err
+				}
+				selfPub := selfNode.PubKeyBytes
+				if edgeInfo.NodeKey1Bytes != selfPub &&
+					edgeInfo.NodeKey2Bytes != selfPub {
+
+					return nil
+				}
+
+				staleChanIDs = append(
+					staleChanIDs, copySlice(chanID),
+				)
+				report.StaleEdges = append(
+					report.StaleEdges,
+					edgeInfo.ChannelPoint,
+				)
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if !fix {
+			return nil
+		}
+
+		// We've been instructed to fix the discrepancies, so we'll add
+		// a shell edge for each of the missing channels, and remove
+		// each of the stale edges.
+		for _, channel := range missingChans {
+			err := putChannelEdgeShell(
+				tx, chanGraph, selfNode, channel,
+			)
+			if err != nil {
+				return err
+			}
+
+			chanID := channel.ShortChannelID.ToUint64()
+			chansModified = append(chansModified, chanID)
+		}
+
+		if len(staleChanIDs) == 0 {
+			return nil
+		}
+
+		zombieIndex, err := edges.CreateBucketIfNotExists(zombieBucket)
+		if err != nil {
+			return err
+		}
+		for _, chanID := range staleChanIDs {
+			err := delChannelEdge(
+				edges, edgeIndex, chanIndex, zombieIndex, nodes,
+				chanID, false,
+			)
+			if err != nil {
+				return err
+			}
+
+			chansModified = append(
+				chansModified, byteOrder.Uint64(chanID),
+			)
+		}
+
+		return chanGraph.pruneGraphNodes(nodes, edgeIndex)
+	}
+
+	if !fix {
+		if err := d.View(reconcile); err != nil {
+			return nil, err
+		}
+
+		return report, nil
+	}
+
+	chanGraph.cacheMu.Lock()
+	defer chanGraph.cacheMu.Unlock()
+
+	if err := d.Update(reconcile); err != nil {
+		return nil, err
+	}
+
+	for _, chanID := range chansModified {
+		chanGraph.rejectCache.remove(chanID)
+		chanGraph.chanCache.remove(chanID)
+	}
+
+	if len(report.MissingEdges) > 0 || len(report.StaleEdges) > 0 {
+		log.Infof("Reconciled channel graph: added %v missing edges, "+
+			"removed %v stale edges", len(report.MissingEdges),
+			len(report.StaleEdges))
+	}
+
+	return report, nil
+}
@@ -0,0 +1,155 @@
+package channeldb
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestReconcileChannelsAndGraph tests that discrepancies between our open
+// channels and our edges within the graph are reported, and only repaired when
+// requested.
+func TestReconcileChannelsAndGraph(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	graph := db.ChannelGraph()
+
+	// Without a source node, we're unable to determine which edges are
+	// ours.
+	_, err = db.ReconcileChannelsAndGraph(false)
+	if err != ErrSourceNodeNotSet {
+		t.Fatalf("expected ErrSourceNodeNotSet, got: %v", err)
+	}
+
+	newNode := func() *LightningNode {
+		node, err := createTestVertex(db)
+		if err != nil {
+			t.Fatalf("unable to create test node: %v", err)
+		}
+		if err := graph.AddLightningNode(node); err != nil {
+			t.Fatalf("unable to add node: %v", err)
+		}
+		return node
+	}
+	source := newNode()
+	if err := graph.SetSourceNode(source); err != nil {
+		t.Fatalf("unable to set source node: %v", err)
+	}
+	peer, err := createLightningNode(db, privKey)
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+	if err := graph.AddLightningNode(peer); err != nil {
+		t.Fatalf("unable to add node: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	newChannel := func(scid uint64, open bool) *OpenChannel {
+		channel, err := createTestChannelState(db)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		if err := channel.SyncPending(addr, 9); err != nil {
+			t.Fatalf("unable to sync pending channel: %v", err)
+		}
+		if !open {
+			return channel
+		}
+
+		err = channel.MarkAsOpen(lnwire.NewShortChanIDFromInt(scid))
+		if err != nil {
+			t.Fatalf("unable to mark channel open: %v", err)
+		}
+		return channel
+	}
+	newEdge := func(node1, node2 *LightningNode,
+		chanPoint *wire.OutPoint) *ChannelEdgeInfo {
+
+		edgeInfo, _, _ := createChannelEdge(db, node1, node2)
+		edgeInfo.ChannelPoint = *chanPoint
+		if err := graph.AddChannelEdge(edgeInfo); err != nil {
+			t.Fatalf("unable to create channel edge: %v", err)
+		}
+		return edgeInfo
+	}
+
+	// We'll create an open channel that lacks an edge, an open channel
+	// that has one, and a pending channel, which isn't expected to have
+	// one yet.
+	missingChan := newChannel(100, true)
+	knownChan := newChannel(101, true)
+	newEdge(source, peer, &knownChan.FundingOutpoint)
+	newChannel(0, false)
+
+	// We'll also add an edge of ours that doesn't correspond to any open
+	// channel, along with an edge between two other nodes.
+	staleEdge := newEdge(source, peer, &wire.OutPoint{Hash: key, Index: 1})
+	newEdge(newNode(), newNode(), &wire.OutPoint{Hash: key, Index: 2})
+
+	expectedReport := &ReconcileReport{
+		MissingEdges: []wire.OutPoint{missingChan.FundingOutpoint},
+		StaleEdges:   []wire.OutPoint{staleEdge.ChannelPoint},
+	}
+
+	// Reconciling without fixing should only report the discrepancies,
+	// leaving the database untouched.
+	for i := 0; i < 2; i++ {
+		report, err := db.ReconcileChannelsAndGraph(false)
+		if err != nil {
+			t.Fatalf("unable to reconcile: %v", err)
+		}
+		if !reflect.DeepEqual(report, expectedReport) {
+			t.Fatalf("expected report %v, got %v", expectedReport,
+				report)
+		}
+	}
+
+	// Now, we'll fix the discrepancies.
+	report, err := db.ReconcileChannelsAndGraph(true)
+	if err != nil {
+		t.Fatalf("unable to reconcile: %v", err)
+	}
+	expectedReport.Fixed = true
+	if !reflect.DeepEqual(report, expectedReport) {
+		t.Fatalf("expected report %v, got %v", expectedReport, report)
+	}
+
+	// The missing edge should have been added, while the stale edge
+	// should have been removed without being marked as a zombie.
+	_, _, _, err = graph.FetchChannelEdgesByOutpoint(
+		&missingChan.FundingOutpoint,
+	)
+	if err != nil {
+		t.Fatalf("unable to find restored edge: %v", err)
+	}
+	_, _, _, err = graph.FetchChannelEdgesByOutpoint(
+		&staleEdge.ChannelPoint,
+	)
+	if err != ErrEdgeNotFound {
+		t.Fatalf("expected ErrEdgeNotFound, got: %v", err)
+	}
+	if isZombie, _, _ := graph.IsZombieEdge(staleEdge.ChannelID); isZombie {
+		t.Fatalf("stale edge marked as zombie")
+	}
+
+	// Finally, reconciling once more should find no discrepancies.
+	report, err = db.ReconcileChannelsAndGraph(false)
+	if err != nil {
+		t.Fatalf("unable to reconcile: %v", err)
+	}
+	if len(report.MissingEdges) != 0 || len(report.StaleEdges) != 0 {
+		t.Fatalf("expected no discrepancies, got %v", report)
+	}
+}
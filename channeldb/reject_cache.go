@@ -1,5 +1,7 @@
 package channeldb
 
+import "sync/atomic"
+
 // rejectFlags is a compact representation of various metadata stored by the
 // reject cache about a particular channel.
 type rejectFlags uint8
@@ -50,6 +52,12 @@ type rejectCacheEntry struct {
 // HasChannelEdge. It caches information about the whether or channel exists, as
 // well as the most recent timestamps for each policy (if they exists).
 type rejectCache struct {
+	// hits and misses count the lookups served from and missed by the
+	// cache respectively. They must be accessed atomically, and are kept
+	// at the start of the struct to ensure 64-bit alignment.
+	hits   uint64
+	misses uint64
+
 	n     int
 	edges map[uint64]rejectCacheEntry
 }
@@ -93,3 +101,28 @@ func (c *rejectCache) insert(chanid uint64, entry rejectCacheEntry) {
 func (c *rejectCache) remove(chanid uint64) {
 	delete(c.edges, chanid)
 }
+
+// resize changes the maximum capacity of the cache to n entries, evicting
+// entries at random if the cache holds more than n entries.
+func (c *rejectCache) resize(n int) {
+	edges := make(map[uint64]rejectCacheEntry, n)
+	for chanid, entry := range c.edges {
+		if len(edges) == n {
+			break
+		}
+		edges[chanid] = entry
+	}
+
+	c.n = n
+	c.edges = edges
+}
+
+// recordLookup records whether a lookup was served from the cache. This can be
+// called concurrently while only holding a shared lock.
+func (c *rejectCache) recordLookup(hit bool) {
+	if hit {
+		atomic.AddUint64(&c.hits, 1)
+	} else {
+		atomic.AddUint64(&c.misses, 1)
+	}
+}
@@ -105,3 +105,47 @@ func entryForInt(i uint64) rejectCacheEntry {
 		flags:    packRejectFlags(exists, isZombie),
 	}
 }
+
+// TestRejectCacheResize checks that resizing the rejectCache evicts entries
+// down to the new capacity, and that the new capacity is respected on
+// subsequent insertions.
+func TestRejectCacheResize(t *testing.T) {
+	const cacheSize = 100
+
+	c := newRejectCache(cacheSize)
+	for i := uint64(0); i < cacheSize; i++ {
+		c.insert(i, entryForInt(i))
+	}
+
+	// Shrinking the cache should evict entries until it is at its new
+	// capacity.
+	c.resize(cacheSize / 2)
+	if len(c.edges) != cacheSize/2 {
+		t.Fatalf("expected %d entries, got: %d", cacheSize/2,
+			len(c.edges))
+	}
+	for i, entry := range c.edges {
+		if !reflect.DeepEqual(entry, entryForInt(i)) {
+			t.Fatalf("entry mismatch for %d", i)
+		}
+	}
+
+	// Further insertions should cause evictions at the new capacity.
+	c.insert(cacheSize, entryForInt(cacheSize))
+	if len(c.edges) != cacheSize/2 {
+		t.Fatalf("expected %d entries, got: %d", cacheSize/2,
+			len(c.edges))
+	}
+
+	// Growing the cache should retain all entries, and allow it to fill up
+	// to its new capacity.
+	c.resize(cacheSize * 2)
+	for i := uint64(cacheSize + 1); len(c.edges) < cacheSize*2; i++ {
+		c.insert(i, entryForInt(i))
+	}
+	c.insert(cacheSize*10, entryForInt(cacheSize*10))
+	if len(c.edges) != cacheSize*2 {
+		t.Fatalf("expected %d entries, got: %d", cacheSize*2,
+			len(c.edges))
+	}
+}
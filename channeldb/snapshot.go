@@ -0,0 +1,63 @@
+package channeldb
+
+import (
+	"net"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+// Snapshot is a consistent, point-in-time view of the database backed by a
+// single long-lived read-only transaction. It allows a burst of related reads
+// to be served without setting up a new transaction for each one, while
+// guaranteeing they all observe the same state. Writes committed after the
+// snapshot was taken aren't visible to it.
+//
+// NOTE: A snapshot isn't safe for concurrent use. While it's held open, the
+// database file can't be grown by writers, as bolt is unable to remap the file
+// until all read transactions have finished, so a snapshot should be closed as
+// soon as it's no longer needed.
+type Snapshot struct {
+	db *DB
+	tx *bbolt.Tx
+}
+
+// Snapshot takes a consistent snapshot of the database. The caller must call
+// Close on the returned snapshot once done with it.
+func (d *DB) Snapshot() (*Snapshot, error) {
+	tx, err := d.Begin(false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Snapshot{
+		db: d,
+		tx: tx,
+	}, nil
+}
+
+// FetchChannel attempts to locate the open channel specified by the passed
+// channel point within the snapshot. See DB.FetchChannel.
+func (s *Snapshot) FetchChannel(chanPoint wire.OutPoint) (*OpenChannel, error) {
+	return s.db.FetchChannelTx(s.tx, chanPoint)
+}
+
+// FetchClosedChannel queries for the close summary of the channel with the
+// passed channel point within the snapshot. See DB.FetchClosedChannel.
+func (s *Snapshot) FetchClosedChannel(
+	chanPoint *wire.OutPoint) (*ChannelCloseSummary, error) {
+
+	return FetchClosedChannelTx(s.tx, chanPoint)
+}
+
+// AddrsForNode returns all known addresses for the target node within the
+// snapshot. See DB.AddrsForNode.
+func (s *Snapshot) AddrsForNode(nodePub *btcec.PublicKey) ([]net.Addr, error) {
+	return AddrsForNodeTx(s.tx, nodePub)
+}
+
+// Close releases the snapshot, after which it must no longer be used.
+func (s *Snapshot) Close() error {
+	return s.tx.Rollback()
+}
@@ -0,0 +1,95 @@
+package channeldb
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+)
+
+// TestSnapshot asserts that reads made through a snapshot observe the state of
+// the database at the time it was taken.
+func TestSnapshot(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	// As writers can't grow the database file while the snapshot is held
+	// open, we'll pre-size the memory map so the close below doesn't
+	// block.
+	cdb, err := Open(tempDirName, OptionSetInitialMmapSize(16*1024*1024))
+	if err != nil {
+		t.Fatalf("unable to open database: %v", err)
+	}
+	defer cdb.Close()
+
+	channel, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := channel.SyncPending(addr, 10); err != nil {
+		t.Fatalf("unable to sync pending channel: %v", err)
+	}
+	chanPoint := channel.FundingOutpoint
+
+	snapshot, err := cdb.Snapshot()
+	if err != nil {
+		t.Fatalf("unable to take snapshot: %v", err)
+	}
+
+	// We'll now close the channel, which shouldn't be reflected within
+	// the snapshot.
+	err = channel.CloseChannel(&ChannelCloseSummary{
+		ChanPoint: chanPoint,
+		RemotePub: channel.IdentityPub,
+	})
+	if err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+
+	snapChannel, err := snapshot.FetchChannel(chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch channel from snapshot: %v", err)
+	}
+	if snapChannel.FundingOutpoint != chanPoint {
+		t.Fatalf("expected channel %v, got %v", chanPoint,
+			snapChannel.FundingOutpoint)
+	}
+	_, err = snapshot.FetchClosedChannel(&chanPoint)
+	if err != ErrClosedChannelNotFound {
+		t.Fatalf("expected ErrClosedChannelNotFound, got: %v", err)
+	}
+	addrs, err := snapshot.AddrsForNode(channel.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch addrs from snapshot: %v", err)
+	}
+	if len(addrs) != 1 || addrs[0].String() != addr.String() {
+		t.Fatalf("expected addrs [%v], got %v", addr, addrs)
+	}
+
+	if err := snapshot.Close(); err != nil {
+		t.Fatalf("unable to close snapshot: %v", err)
+	}
+
+	// A new snapshot should reflect the close.
+	snapshot, err = cdb.Snapshot()
+	if err != nil {
+		t.Fatalf("unable to take snapshot: %v", err)
+	}
+	defer snapshot.Close()
+
+	if _, err := snapshot.FetchChannel(chanPoint); err == nil {
+		t.Fatalf("expected closed channel not to be found")
+	}
+	if _, err := snapshot.FetchClosedChannel(&chanPoint); err != nil {
+		t.Fatalf("unable to fetch closed channel: %v", err)
+	}
+}
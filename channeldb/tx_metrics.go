@@ -0,0 +1,129 @@
+package channeldb
+
+import (
+	"runtime"
+	"time"
+
+	"github.com/coreos/bbolt"
+)
+
+// TxMetrics describes the timing of a single database transaction.
+type TxMetrics struct {
+	// Caller is the fully qualified name of the function that executed
+	// the transaction. Transactions executed through helpers such as
+	// WithTx are attributed to the helper.
+	Caller string
+
+	// ReadOnly is true if this was a read-only transaction.
+	ReadOnly bool
+
+	// WaitTime is the time spent waiting to begin the transaction. For
+	// read-write transactions, this includes the time spent waiting to
+	// acquire the database's single write lock.
+	WaitTime time.Duration
+
+	// HoldTime is the time the transaction was held open for, including
+	// the time taken to commit it.
+	HoldTime time.Duration
+
+	// Err is the error the transaction failed with, if any.
+	Err error
+}
+
+// TxMetricsRecorder is an interface that receives the timing of each database
+// transaction, allowing contention on the database to be diagnosed.
+//
+// NOTE: RecordTx is called synchronously once each transaction completes, and
+// may be called concurrently, so implementations should return quickly.
+type TxMetricsRecorder interface {
+	// RecordTx records the timing of a completed transaction.
+	RecordTx(metrics *TxMetrics)
+}
+
+// Update executes the passed closure within a read-write transaction. If a
+// TxMetricsRecorder is configured, then the timing of the transaction is
+// reported to it.
+func (d *DB) Update(fn func(tx *bbolt.Tx) error) error {
+	if d.txMetrics == nil {
+		return d.DB.Update(fn)
+	}
+
+	return d.recordTx(false, d.DB.Update, fn)
+}
+
+// View executes the passed closure within a read-only transaction. If a
+// TxMetricsRecorder is configured, then the timing of the transaction is
+// reported to it.
+func (d *DB) View(fn func(tx *bbolt.Tx) error) error {
+	if d.txMetrics == nil {
+		return d.DB.View(fn)
+	}
+
+	return d.recordTx(true, d.DB.View, fn)
+}
+
+// Batch executes the passed closure within a read-write transaction that may
+// be shared with the closures of concurrent calls to Batch, coalescing them
+// into a single commit. If a TxMetricsRecorder is configured, then the timing
+// of the transaction is reported to it.
+//
+// NOTE: Unlike with Update, the closure may be called multiple times, even if
+// it returned no error: if any closure within a batch fails, then the shared
+// transaction is rolled back, the batch is retried without it, and the failed
+// closure is retried on its own. Closures must therefore be idempotent, and
+// any of their side effects outside of the transaction must only take effect
+// once Batch has returned successfully. As the changes of each closure are
+// committed along with those of other callers, Batch should only be used
+// where doing so doesn't violate the atomicity required by the caller. It's
+// only beneficial when called from multiple goroutines, as a lone caller waits
+// up to MaxBatchDelay for others to join its batch.
+func (d *DB) Batch(fn func(tx *bbolt.Tx) error) error {
+	if d.txMetrics == nil {
+		return d.DB.Batch(fn)
+	}
+
+	return d.recordTx(false, d.DB.Batch, fn)
+}
+
+// recordTx executes the passed closure using the passed transaction function,
+// reporting the timing of the transaction to the configured recorder.
+func (d *DB) recordTx(readOnly bool,
+	txFunc func(func(tx *bbolt.Tx) error) error,
+	fn func(tx *bbolt.Tx) error) error {
+
+	// We'll skip the frames of this method and of Update, View or Batch to
+	// obtain the caller that initiated the transaction.
+	var caller string
+	if pc, _, _, ok := runtime.Caller(2); ok {
+		if f := runtime.FuncForPC(pc); f != nil {
+			caller = f.Name()
+		}
+	}
+
+	var began time.Time
+	start := time.Now()
+	err := txFunc(func(tx *bbolt.Tx) error {
+		began = time.Now()
+		return fn(tx)
+	})
+	end := time.Now()
+
+	metrics := &TxMetrics{
+		Caller:   caller,
+		ReadOnly: readOnly,
+		Err:      err,
+	}
+
+	// If the transaction couldn't be started, then all time was spent
+	// waiting for it.
+	if began.IsZero() {
+		metrics.WaitTime = end.Sub(start)
+	} else {
+		metrics.WaitTime = began.Sub(start)
+		metrics.HoldTime = end.Sub(began)
+	}
+
+	d.txMetrics.RecordTx(metrics)
+
+	return err
+}
@@ -0,0 +1,225 @@
+package channeldb
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coreos/bbolt"
+)
+
+// mockTxMetricsRecorder is a TxMetricsRecorder that stores all recorded
+// metrics.
+type mockTxMetricsRecorder struct {
+	mu      sync.Mutex
+	metrics []*TxMetrics
+}
+
+// RecordTx records the timing of a completed transaction.
+func (m *mockTxMetricsRecorder) RecordTx(metrics *TxMetrics) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.metrics = append(m.metrics, metrics)
+}
+
+// TestTxMetrics asserts that the timing of transactions is reported to the
+// configured recorder, and that time spent waiting for the write lock is
+// attributed to the waiting transaction.
+func TestTxMetrics(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	recorder := &mockTxMetricsRecorder{}
+	cdb, err := Open(tempDirName, OptionSetTxMetrics(recorder))
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+	defer cdb.Close()
+
+	// Opening the database executes transactions of its own, which we'll
+	// ignore.
+	recorder.mu.Lock()
+	recorder.metrics = nil
+	recorder.mu.Unlock()
+
+	const holdTime = 50 * time.Millisecond
+
+	// We'll hold a write transaction open while another one attempts to
+	// begin, which should be forced to wait.
+	holding := make(chan struct{})
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- cdb.Update(func(tx *bbolt.Tx) error {
+			close(holding)
+			time.Sleep(holdTime)
+			return nil
+		})
+	}()
+
+	<-holding
+	err = cdb.Update(func(tx *bbolt.Tx) error {
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to update: %v", err)
+	}
+	if err := <-errChan; err != nil {
+		t.Fatalf("unable to update: %v", err)
+	}
+
+	err = cdb.View(func(tx *bbolt.Tx) error {
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to view: %v", err)
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	if len(recorder.metrics) != 3 {
+		t.Fatalf("expected 3 recorded transactions, got %v",
+			len(recorder.metrics))
+	}
+
+	// As the holding transaction is executed within a goroutine, the
+	// order in which the write transactions are recorded isn't defined.
+	holder, waiter := recorder.metrics[0], recorder.metrics[1]
+	if !strings.HasSuffix(holder.Caller, "func1") {
+		holder, waiter = waiter, holder
+	}
+	reader := recorder.metrics[2]
+
+	if holder.ReadOnly || holder.HoldTime < holdTime {
+		t.Fatalf("unexpected metrics for holding tx: %v", holder)
+	}
+	if waiter.ReadOnly || waiter.WaitTime < holdTime/2 {
+		t.Fatalf("unexpected metrics for waiting tx: %v", waiter)
+	}
+	if !reader.ReadOnly {
+		t.Fatalf("expected read-only tx")
+	}
+
+	for _, metrics := range []*TxMetrics{waiter, reader} {
+		if !strings.HasSuffix(metrics.Caller, "TestTxMetrics") {
+			t.Fatalf("unexpected caller: %v", metrics.Caller)
+		}
+	}
+}
+
+// TestBatch asserts that concurrent closures executed through Batch are all
+// committed, with the exception of those that fail, and that the transactions
+// are reported to the configured recorder.
+func TestBatch(t *testing.T) {
+	t.Parallel()
+
+	tempDirName, err := ioutil.TempDir("", "channeldb")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDirName)
+
+	recorder := &mockTxMetricsRecorder{}
+	cdb, err := Open(tempDirName, OptionSetTxMetrics(recorder))
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+	defer cdb.Close()
+
+	recorder.mu.Lock()
+	recorder.metrics = nil
+	recorder.mu.Unlock()
+
+	// We'll execute a number of closures concurrently, each writing a
+	// single key, with one of them failing after writing its key.
+	const numWriters = 10
+	const failingWriter = 3
+	bucketName := []byte("batch")
+	errFailed := errors.New("closure failed")
+
+	var wg sync.WaitGroup
+	errs := make([]error, numWriters)
+	for i := 0; i < numWriters; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			errs[i] = cdb.Batch(func(tx *bbolt.Tx) error {
+				bucket, err := tx.CreateBucketIfNotExists(
+					bucketName,
+				)
+				if err != nil {
+					return err
+				}
+				k := []byte{byte(i)}
+				if err := bucket.Put(k, k); err != nil {
+					return err
+				}
+
+				if i == failingWriter {
+					return errFailed
+				}
+				return nil
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		switch {
+		case i == failingWriter && err != errFailed:
+			t.Fatalf("expected failing closure to return its "+
+				"error, got: %v", err)
+
+		case i != failingWriter && err != nil:
+			t.Fatalf("unable to batch write %v: %v", i, err)
+		}
+	}
+
+	// Only the keys of the closures that succeeded should be found.
+	err = cdb.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(bucketName)
+		if bucket == nil {
+			return errors.New("bucket not found")
+		}
+		for i := 0; i < numWriters; i++ {
+			found := bucket.Get([]byte{byte(i)}) != nil
+			if found != (i != failingWriter) {
+				return fmt.Errorf("key %v found: %v", i, found)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	// Each closure is attributed to the goroutine executing Batch. The last
+	// transaction is the read-only one above.
+	if len(recorder.metrics) < 2 {
+		t.Fatalf("expected batch transactions to be recorded, got %v",
+			len(recorder.metrics))
+	}
+	for _, metrics := range recorder.metrics[:len(recorder.metrics)-1] {
+		if metrics.ReadOnly {
+			t.Fatalf("expected read-write tx")
+		}
+		if !strings.HasSuffix(metrics.Caller, "TestBatch.func1") {
+			t.Fatalf("unexpected caller: %v", metrics.Caller)
+		}
+	}
+}
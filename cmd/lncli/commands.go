@@ -1214,6 +1214,10 @@ var abandonChannelCommand = cli.Command{
 
 	Only available when lnd is built in debug mode.
 
+	Channels that aren't in the default state, such as those whose
+	commitment has already been broadcast, are only abandoned if the
+	--force flag is set.
+
 	To view which funding_txids/output_indexes can be used for this command,
 	see the channel_point values within the listchannels command output.
 	The format for a channel_point is 'funding_txid:output_index'.`,
@@ -1228,6 +1232,11 @@ var abandonChannelCommand = cli.Command{
 			Usage: "the output index for the funding output of the funding " +
 				"transaction",
 		},
+		cli.BoolFlag{
+			Name: "force",
+			Usage: "abandon the channel even if it isn't in the " +
+				"default state",
+		},
 	},
 	Action: actionDecorator(abandonChannel),
 }
@@ -1251,6 +1260,7 @@ func abandonChannel(ctx *cli.Context) error {
 
 	req := &lnrpc.AbandonChannelRequest{
 		ChannelPoint: channelPoint,
+		Force:        ctx.Bool("force"),
 	}
 
 	resp, err := client.AbandonChannel(ctxb, req)
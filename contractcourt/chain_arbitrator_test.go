@@ -178,7 +178,7 @@ func TestResolveContract(t *testing.T) {
 
 	// While the resolver are active, we'll now remove the channel from the
 	// database (mark is as closed).
-	err = db.AbandonChannel(&channel.FundingOutpoint, 4)
+	err = db.AbandonChannel(&channel.FundingOutpoint, 4, false)
 	if err != nil {
 		t.Fatalf("unable to remove channel: %v", err)
 	}
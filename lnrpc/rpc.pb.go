@@ -8056,10 +8056,12 @@ func (m *DeleteAllPaymentsResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_DeleteAllPaymentsResponse proto.InternalMessageInfo
 
 type AbandonChannelRequest struct {
-	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	/// If true, then the channel will be abandoned even if it isn't in the default state, such as when its commitment has already been broadcast.
+	Force                bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *AbandonChannelRequest) Reset()         { *m = AbandonChannelRequest{} }
@@ -8094,6 +8096,13 @@ func (m *AbandonChannelRequest) GetChannelPoint() *ChannelPoint {
 	return nil
 }
 
+func (m *AbandonChannelRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
 type AbandonChannelResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -9635,560 +9644,553 @@ func init() {
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 8836 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7d, 0x5f, 0x6c, 0x1c, 0x5b,
-	0x9a, 0x97, 0xab, 0xbb, 0x6d, 0x77, 0x7f, 0xdd, 0xb6, 0xdb, 0xc7, 0x8e, 0xdd, 0xe9, 0x9b, 0x9b,
-	0x9b, 0x5b, 0x93, 0x4d, 0x32, 0x99, 0x3b, 0x4e, 0xae, 0x77, 0xe6, 0x72, 0xf7, 0x86, 0x65, 0x71,
-	0x6c, 0x27, 0xce, 0x5c, 0xc7, 0xf1, 0x94, 0x93, 0x09, 0x33, 0xb3, 0xab, 0x9e, 0x72, 0xf7, 0xb1,
-	0x5d, 0x93, 0xee, 0xaa, 0x9e, 0xaa, 0x6a, 0x27, 0x9e, 0xcb, 0x45, 0x02, 0x21, 0x84, 0x78, 0x41,
-	0x03, 0x42, 0x02, 0x04, 0x5a, 0x69, 0x16, 0x89, 0x5d, 0xf1, 0x00, 0x2f, 0x48, 0x0b, 0x5a, 0x9e,
-	0x10, 0x42, 0x42, 0x42, 0x3c, 0xf0, 0x80, 0xc4, 0x03, 0x23, 0x04, 0x12, 0x5a, 0xf1, 0x86, 0xe0,
-	0x1d, 0x7d, 0xdf, 0xf9, 0x53, 0xe7, 0x54, 0x55, 0xc7, 0xb9, 0x33, 0x03, 0x4f, 0xee, 0xf3, 0x3b,
-	0xa7, 0xce, 0xdf, 0xef, 0xfb, 0xce, 0x77, 0xbe, 0xef, 0x3b, 0xc7, 0xd0, 0x88, 0xc7, 0xfd, 0x8d,
-	0x71, 0x1c, 0xa5, 0x11, 0x9b, 0x1d, 0x86, 0xf1, 0xb8, 0xdf, 0xbd, 0x76, 0x1a, 0x45, 0xa7, 0x43,
-	0x7e, 0xcf, 0x1f, 0x07, 0xf7, 0xfc, 0x30, 0x8c, 0x52, 0x3f, 0x0d, 0xa2, 0x30, 0x11, 0x85, 0xdc,
-	0x1f, 0xc1, 0xe2, 0x63, 0x1e, 0x1e, 0x71, 0x3e, 0xf0, 0xf8, 0x4f, 0x26, 0x3c, 0x49, 0xd9, 0x37,
-	0x60, 0xd9, 0xe7, 0x3f, 0xe5, 0x7c, 0xd0, 0x1b, 0xfb, 0x49, 0x32, 0x3e, 0x8b, 0xfd, 0x84, 0x77,
-	0x9c, 0x1b, 0xce, 0x9d, 0x96, 0xd7, 0x16, 0x19, 0x87, 0x1a, 0x67, 0x1f, 0x42, 0x2b, 0xc1, 0xa2,
-	0x3c, 0x4c, 0xe3, 0x68, 0x7c, 0xd1, 0xa9, 0x50, 0xb9, 0x26, 0x62, 0xbb, 0x02, 0x72, 0x87, 0xb0,
-	0xa4, 0x5b, 0x48, 0xc6, 0x51, 0x98, 0x70, 0x76, 0x1f, 0x56, 0xfb, 0xc1, 0xf8, 0x8c, 0xc7, 0x3d,
-	0xfa, 0x78, 0x14, 0xf2, 0x51, 0x14, 0x06, 0xfd, 0x8e, 0x73, 0xa3, 0x7a, 0xa7, 0xe1, 0x31, 0x91,
-	0x87, 0x5f, 0x3c, 0x95, 0x39, 0xec, 0x36, 0x2c, 0xf1, 0x50, 0xe0, 0x7c, 0x40, 0x5f, 0xc9, 0xa6,
-	0x16, 0x33, 0x18, 0x3f, 0x70, 0xff, 0x7a, 0x05, 0x96, 0x9f, 0x84, 0x41, 0xfa, 0xd2, 0x1f, 0x0e,
-	0x79, 0xaa, 0xc6, 0x74, 0x1b, 0x96, 0x5e, 0x13, 0x40, 0x63, 0x7a, 0x1d, 0xc5, 0x03, 0x39, 0xa2,
-	0x45, 0x01, 0x1f, 0x4a, 0x74, 0x6a, 0xcf, 0x2a, 0x53, 0x7b, 0x56, 0x3a, 0x5d, 0xd5, 0x29, 0xd3,
-	0x75, 0x1b, 0x96, 0x62, 0xde, 0x8f, 0xce, 0x79, 0x7c, 0xd1, 0x7b, 0x1d, 0x84, 0x83, 0xe8, 0x75,
-	0xa7, 0x76, 0xc3, 0xb9, 0x33, 0xeb, 0x2d, 0x2a, 0xf8, 0x25, 0xa1, 0xec, 0x21, 0x2c, 0xf5, 0xcf,
-	0xfc, 0x30, 0xe4, 0xc3, 0xde, 0xb1, 0xdf, 0x7f, 0x35, 0x19, 0x27, 0x9d, 0xd9, 0x1b, 0xce, 0x9d,
-	0xe6, 0xe6, 0xd5, 0x0d, 0x5a, 0xd5, 0x8d, 0xed, 0x33, 0x3f, 0x7c, 0x48, 0x39, 0x47, 0xa1, 0x3f,
-	0x4e, 0xce, 0xa2, 0xd4, 0x5b, 0x94, 0x5f, 0x08, 0x38, 0x71, 0x57, 0x81, 0x99, 0x33, 0x21, 0xe6,
-	0xde, 0xfd, 0x27, 0x0e, 0xac, 0xbc, 0x08, 0x87, 0x51, 0xff, 0xd5, 0x2f, 0x39, 0x45, 0x25, 0x63,
-	0xa8, 0xbc, 0xeb, 0x18, 0xaa, 0x5f, 0x75, 0x0c, 0x6b, 0xb0, 0x6a, 0x77, 0x56, 0x8e, 0x82, 0xc3,
-	0x15, 0xfc, 0xfa, 0x94, 0xab, 0x6e, 0xa9, 0x61, 0x7c, 0x1d, 0xda, 0xfd, 0x49, 0x1c, 0xf3, 0xb0,
-	0x30, 0x8e, 0x25, 0x89, 0xeb, 0x81, 0x7c, 0x08, 0xad, 0x90, 0xbf, 0xce, 0x8a, 0x49, 0xda, 0x0d,
-	0xf9, 0x6b, 0x55, 0xc4, 0xed, 0xc0, 0x5a, 0xbe, 0x19, 0xd9, 0x81, 0xff, 0xea, 0x40, 0xed, 0x45,
-	0xfa, 0x26, 0x62, 0x1b, 0x50, 0x4b, 0x2f, 0xc6, 0x82, 0x43, 0x16, 0x37, 0x99, 0x1c, 0xda, 0xd6,
-	0x60, 0x10, 0xf3, 0x24, 0x79, 0x7e, 0x31, 0xe6, 0x5e, 0xcb, 0x17, 0x89, 0x1e, 0x96, 0x63, 0x1d,
-	0x98, 0x97, 0x69, 0x6a, 0xb0, 0xe1, 0xa9, 0x24, 0xbb, 0x0e, 0xe0, 0x8f, 0xa2, 0x49, 0x98, 0xf6,
-	0x12, 0x3f, 0xa5, 0xa9, 0xaa, 0x7a, 0x06, 0xc2, 0xae, 0x41, 0x63, 0xfc, 0xaa, 0x97, 0xf4, 0xe3,
-	0x60, 0x9c, 0x12, 0xd9, 0x34, 0xbc, 0x0c, 0x60, 0xdf, 0x80, 0x7a, 0x34, 0x49, 0xc7, 0x51, 0x10,
-	0xa6, 0x92, 0x54, 0x96, 0x64, 0x5f, 0x9e, 0x4d, 0xd2, 0x43, 0x84, 0x3d, 0x5d, 0x80, 0xdd, 0x84,
-	0x85, 0x7e, 0x14, 0x9e, 0x04, 0xf1, 0x48, 0x08, 0x83, 0xce, 0x1c, 0xb5, 0x66, 0x83, 0xee, 0xbf,
-	0xa8, 0x40, 0xf3, 0x79, 0xec, 0x87, 0x89, 0xdf, 0x47, 0x00, 0xbb, 0x9e, 0xbe, 0xe9, 0x9d, 0xf9,
-	0xc9, 0x19, 0x8d, 0xb6, 0xe1, 0xa9, 0x24, 0x5b, 0x83, 0x39, 0xd1, 0x51, 0x1a, 0x53, 0xd5, 0x93,
-	0x29, 0xf6, 0x11, 0x2c, 0x87, 0x93, 0x51, 0xcf, 0x6e, 0xab, 0x4a, 0xd4, 0x52, 0xcc, 0xc0, 0x09,
-	0x38, 0xc6, 0xb5, 0x16, 0x4d, 0x88, 0x11, 0x1a, 0x08, 0x73, 0xa1, 0x25, 0x53, 0x3c, 0x38, 0x3d,
-	0x13, 0xc3, 0x9c, 0xf5, 0x2c, 0x0c, 0xeb, 0x48, 0x83, 0x11, 0xef, 0x25, 0xa9, 0x3f, 0x1a, 0xcb,
-	0x61, 0x19, 0x08, 0xe5, 0x47, 0xa9, 0x3f, 0xec, 0x9d, 0x70, 0x9e, 0x74, 0xe6, 0x65, 0xbe, 0x46,
-	0xd8, 0x2d, 0x58, 0x1c, 0xf0, 0x24, 0xed, 0xc9, 0x45, 0xe1, 0x49, 0xa7, 0x4e, 0xac, 0x9f, 0x43,
-	0xb1, 0x9e, 0xd8, 0x7f, 0xdd, 0xc3, 0x09, 0xe0, 0x6f, 0x3a, 0x0d, 0xd1, 0xd7, 0x0c, 0x41, 0xca,
-	0x79, 0xcc, 0x53, 0x63, 0xf6, 0x12, 0x49, 0xa1, 0xee, 0x3e, 0x30, 0x03, 0xde, 0xe1, 0xa9, 0x1f,
-	0x0c, 0x13, 0xf6, 0x09, 0xb4, 0x52, 0xa3, 0x30, 0x89, 0xc2, 0xa6, 0x26, 0x27, 0xe3, 0x03, 0xcf,
-	0x2a, 0xe7, 0x9e, 0x41, 0xfd, 0x11, 0xe7, 0xfb, 0xc1, 0x28, 0x48, 0xd9, 0x1a, 0xcc, 0x9e, 0x04,
-	0x6f, 0xb8, 0x20, 0xf8, 0xea, 0xde, 0x8c, 0x27, 0x92, 0xec, 0x03, 0x00, 0xfa, 0xd1, 0x1b, 0x69,
-	0xc2, 0xda, 0x9b, 0xf1, 0x1a, 0x84, 0x3d, 0x45, 0xca, 0xea, 0xc2, 0xfc, 0x98, 0xc7, 0x7d, 0xae,
-	0xd6, 0x6f, 0x6f, 0xc6, 0x53, 0xc0, 0xc3, 0x79, 0x98, 0x1d, 0x62, 0xed, 0xee, 0x1f, 0xd7, 0xa0,
-	0x79, 0xc4, 0x43, 0xcd, 0x69, 0x0c, 0x6a, 0x38, 0x27, 0x92, 0xbb, 0xe8, 0x37, 0xfb, 0x1a, 0x34,
-	0x69, 0x9e, 0x92, 0x34, 0x0e, 0xc2, 0x53, 0x41, 0xe0, 0x0f, 0x2b, 0x1d, 0xc7, 0x03, 0x84, 0x8f,
-	0x08, 0x65, 0x6d, 0xa8, 0xfa, 0x23, 0x45, 0xe0, 0xf8, 0x93, 0x5d, 0x85, 0xba, 0x3f, 0x4a, 0x45,
-	0xf7, 0x5a, 0x04, 0xcf, 0xfb, 0xa3, 0x94, 0xba, 0xf6, 0x21, 0xb4, 0xc6, 0xfe, 0xc5, 0x08, 0xf9,
-	0x59, 0x53, 0x45, 0xcb, 0x6b, 0x4a, 0x6c, 0x0f, 0xc9, 0x62, 0x13, 0x56, 0xcc, 0x22, 0xaa, 0xf1,
-	0x59, 0xdd, 0xf8, 0xb2, 0x51, 0x5a, 0xf6, 0xe1, 0x36, 0x2c, 0xa9, 0x6f, 0x62, 0x31, 0x1e, 0xa2,
-	0x95, 0x86, 0xb7, 0x28, 0x61, 0x35, 0xca, 0x3b, 0xd0, 0x3e, 0x09, 0x42, 0x7f, 0xd8, 0xeb, 0x0f,
-	0xd3, 0xf3, 0xde, 0x80, 0x0f, 0x53, 0x9f, 0xa8, 0x66, 0xd6, 0x5b, 0x24, 0x7c, 0x7b, 0x98, 0x9e,
-	0xef, 0x20, 0xca, 0x3e, 0x82, 0xc6, 0x09, 0xe7, 0x3d, 0x9a, 0xac, 0x4e, 0xdd, 0xe2, 0x40, 0xb5,
-	0x42, 0x5e, 0xfd, 0x44, 0xad, 0xd5, 0x47, 0xd0, 0x8e, 0x26, 0xe9, 0x69, 0x14, 0x84, 0xa7, 0x3d,
-	0x94, 0x79, 0xbd, 0x60, 0x40, 0x54, 0x54, 0x7b, 0x58, 0xb9, 0xef, 0x78, 0x8b, 0x2a, 0x0f, 0xa5,
-	0xcf, 0x93, 0x01, 0xbb, 0x05, 0x4b, 0x43, 0x3f, 0x49, 0x7b, 0x67, 0xd1, 0xb8, 0x37, 0x9e, 0x1c,
-	0xbf, 0xe2, 0x17, 0x9d, 0x05, 0x9a, 0x88, 0x05, 0x84, 0xf7, 0xa2, 0xf1, 0x21, 0x81, 0xec, 0x7d,
-	0x00, 0xea, 0xa7, 0xe8, 0x04, 0xdc, 0x70, 0xee, 0x2c, 0x78, 0x0d, 0x44, 0x44, 0xa3, 0x9f, 0x41,
-	0x9d, 0x96, 0x27, 0x1d, 0x9e, 0x77, 0x9a, 0x44, 0x60, 0x1f, 0xc8, 0x1e, 0x1a, 0x0b, 0xbb, 0xb1,
-	0xc3, 0x93, 0xf4, 0xf9, 0xf0, 0x1c, 0xf7, 0xef, 0x0b, 0x6f, 0x7e, 0x20, 0x52, 0xdd, 0xcf, 0xa0,
-	0x65, 0x66, 0xe0, 0x2a, 0x62, 0x37, 0x70, 0xf5, 0x6b, 0x1e, 0xfe, 0x64, 0xab, 0x30, 0x7b, 0xee,
-	0x0f, 0x27, 0x5c, 0x0a, 0x52, 0x91, 0xf8, 0xac, 0xf2, 0xa9, 0xe3, 0xfe, 0xb1, 0x03, 0x2d, 0xd1,
-	0x82, 0x54, 0x00, 0x6e, 0xc2, 0x82, 0x9a, 0x7e, 0x1e, 0xc7, 0x51, 0x2c, 0xe5, 0x89, 0x0d, 0xb2,
-	0xbb, 0xd0, 0x56, 0xc0, 0x38, 0xe6, 0xc1, 0xc8, 0x3f, 0x55, 0x75, 0x17, 0x70, 0xb6, 0x99, 0xd5,
-	0x18, 0x47, 0x93, 0x94, 0xcb, 0xad, 0xa6, 0x25, 0xc7, 0xe7, 0x21, 0xe6, 0xd9, 0x45, 0x50, 0x9e,
-	0x94, 0xd0, 0x96, 0x85, 0xb9, 0x7f, 0xc7, 0x01, 0x86, 0x5d, 0x7f, 0x1e, 0x89, 0x2a, 0x24, 0x59,
-	0xe4, 0xc9, 0xd2, 0x79, 0x67, 0xb2, 0xac, 0xbc, 0x8d, 0x2c, 0x5d, 0x98, 0x15, 0xbd, 0xaf, 0x95,
-	0xf4, 0x5e, 0x64, 0x7d, 0xa7, 0x56, 0xaf, 0xb6, 0x6b, 0xee, 0x7f, 0xae, 0xc2, 0xea, 0xb6, 0xd8,
-	0x2b, 0xb7, 0xfa, 0x7d, 0x3e, 0xd6, 0x04, 0xfb, 0x01, 0x34, 0xc3, 0x68, 0xc0, 0x15, 0x99, 0x88,
-	0x8e, 0x01, 0x42, 0x06, 0x8d, 0x9c, 0xf9, 0x41, 0x28, 0x3a, 0x2e, 0xe6, 0xb3, 0x41, 0x08, 0x75,
-	0xfb, 0x16, 0x2c, 0x8d, 0x79, 0x38, 0x30, 0xe9, 0x52, 0x68, 0x33, 0x0b, 0x12, 0x96, 0x24, 0xf9,
-	0x01, 0x34, 0x4f, 0x26, 0xa2, 0x1c, 0x72, 0x73, 0x8d, 0xe8, 0x00, 0x24, 0xb4, 0x25, 0x98, 0x7a,
-	0x3c, 0x49, 0xce, 0x28, 0x77, 0x96, 0x72, 0xe7, 0x31, 0x8d, 0x59, 0xef, 0x03, 0x0c, 0x26, 0x49,
-	0x2a, 0xc9, 0x74, 0x8e, 0x32, 0x1b, 0x88, 0x08, 0x32, 0xfd, 0x26, 0xac, 0x8c, 0xfc, 0x37, 0x3d,
-	0xa2, 0x9f, 0x5e, 0x10, 0xf6, 0x4e, 0x86, 0x24, 0xee, 0xe7, 0xa9, 0x5c, 0x7b, 0xe4, 0xbf, 0xf9,
-	0x1e, 0xe6, 0x3c, 0x09, 0x1f, 0x11, 0x8e, 0xbc, 0xac, 0xf4, 0x8c, 0x98, 0x27, 0x3c, 0x3e, 0xe7,
-	0xc4, 0x7e, 0x35, 0xad, 0x4c, 0x78, 0x02, 0xc5, 0x1e, 0x8d, 0x70, 0xdc, 0xe9, 0xb0, 0x2f, 0x78,
-	0xcd, 0x9b, 0x1f, 0x05, 0xe1, 0x5e, 0x3a, 0xec, 0xb3, 0x6b, 0x00, 0xc8, 0xbc, 0x63, 0x1e, 0xf7,
-	0x5e, 0xbd, 0x26, 0xc6, 0xa9, 0x11, 0xb3, 0x1e, 0xf2, 0xf8, 0xf3, 0xd7, 0xec, 0x3d, 0x68, 0xf4,
-	0x13, 0xe2, 0x7e, 0xff, 0xa2, 0xd3, 0x24, 0xae, 0xaa, 0xf7, 0x13, 0xe4, 0x7b, 0xff, 0x82, 0x7d,
-	0x04, 0x0c, 0x7b, 0xeb, 0xd3, 0x2a, 0xf0, 0x01, 0x55, 0x9f, 0x90, 0x18, 0x5b, 0xa0, 0xce, 0x6e,
-	0xc9, 0x0c, 0x6c, 0x27, 0x61, 0x5f, 0x83, 0x05, 0xd5, 0xd9, 0x93, 0xa1, 0x7f, 0x9a, 0x10, 0x1f,
-	0x2f, 0x78, 0x2d, 0x09, 0x3e, 0x42, 0xcc, 0x7d, 0x29, 0xb4, 0x1b, 0x63, 0x6d, 0x25, 0xdf, 0xe0,
-	0x3e, 0x4b, 0x08, 0xad, 0x6b, 0xdd, 0x93, 0xa9, 0xb2, 0x45, 0xab, 0x94, 0x2c, 0x9a, 0xfb, 0x73,
-	0x07, 0x5a, 0xb2, 0x66, 0x52, 0x09, 0xd8, 0x7d, 0x60, 0x6a, 0x15, 0xd3, 0x37, 0xc1, 0xa0, 0x77,
-	0x7c, 0x91, 0xf2, 0x44, 0x10, 0xcd, 0xde, 0x8c, 0x57, 0x92, 0x87, 0x82, 0xcb, 0x42, 0x93, 0x34,
-	0x16, 0x34, 0xbd, 0x37, 0xe3, 0x15, 0x72, 0x90, 0xc5, 0x50, 0xe9, 0x98, 0xa4, 0xbd, 0x20, 0x1c,
-	0xf0, 0x37, 0x44, 0x4a, 0x0b, 0x9e, 0x85, 0x3d, 0x5c, 0x84, 0x96, 0xf9, 0x9d, 0xfb, 0x63, 0xa8,
-	0x2b, 0x95, 0x85, 0xb6, 0xeb, 0x5c, 0xbf, 0x3c, 0x03, 0x61, 0x5d, 0xa8, 0xdb, 0xbd, 0xf0, 0xea,
-	0x5f, 0xa5, 0x6d, 0xf7, 0xcf, 0x41, 0x7b, 0x1f, 0x89, 0x28, 0x44, 0xa2, 0x95, 0x7a, 0xd8, 0x1a,
-	0xcc, 0x19, 0xcc, 0xd3, 0xf0, 0x64, 0x0a, 0x37, 0xbc, 0xb3, 0x28, 0x49, 0x65, 0x3b, 0xf4, 0xdb,
-	0xfd, 0xb7, 0x0e, 0xb0, 0xdd, 0x24, 0x0d, 0x46, 0x7e, 0xca, 0x1f, 0x71, 0x2d, 0x1e, 0x9e, 0x41,
-	0x0b, 0x6b, 0x7b, 0x1e, 0x6d, 0x09, 0xad, 0x48, 0xec, 0xe6, 0xdf, 0x90, 0xec, 0x5c, 0xfc, 0x60,
-	0xc3, 0x2c, 0x2d, 0x04, 0xaf, 0x55, 0x01, 0x72, 0x5b, 0xea, 0xc7, 0xa7, 0x3c, 0x25, 0x95, 0x49,
-	0x2a, 0xdc, 0x20, 0xa0, 0xed, 0x28, 0x3c, 0xe9, 0xfe, 0x0e, 0x2c, 0x17, 0xea, 0x30, 0x65, 0x74,
-	0xa3, 0x44, 0x46, 0x57, 0x4d, 0x19, 0xdd, 0x87, 0x15, 0xab, 0x5f, 0x92, 0xe2, 0x3a, 0x30, 0x8f,
-	0x8c, 0x81, 0x3b, 0xb3, 0x23, 0x76, 0x66, 0x99, 0x64, 0x9b, 0xb0, 0x7a, 0xc2, 0x79, 0xec, 0xa7,
-	0x94, 0x24, 0xd6, 0xc1, 0x35, 0x91, 0x35, 0x97, 0xe6, 0xb9, 0xff, 0xcd, 0x81, 0x25, 0x94, 0xa6,
-	0x4f, 0xfd, 0xf0, 0x42, 0xcd, 0xd5, 0x7e, 0xe9, 0x5c, 0xdd, 0x31, 0x36, 0x26, 0xa3, 0xf4, 0x57,
-	0x9d, 0xa8, 0x6a, 0x7e, 0xa2, 0xd8, 0x0d, 0x68, 0x59, 0xdd, 0x9d, 0x15, 0x2a, 0x60, 0xe2, 0xa7,
-	0x87, 0x3c, 0x7e, 0x78, 0x91, 0xf2, 0x5f, 0x7d, 0x2a, 0x6f, 0x41, 0x3b, 0xeb, 0xb6, 0x9c, 0x47,
-	0x06, 0x35, 0x24, 0x4c, 0x59, 0x01, 0xfd, 0x76, 0xff, 0x81, 0x23, 0x0a, 0x6e, 0x47, 0x81, 0x56,
-	0x0f, 0xb1, 0x20, 0x6a, 0x99, 0xaa, 0x20, 0xfe, 0x9e, 0xaa, 0x5e, 0xff, 0xea, 0x83, 0x45, 0x99,
-	0x98, 0xf0, 0x70, 0xd0, 0xf3, 0x87, 0x43, 0x12, 0xc4, 0x75, 0x6f, 0x1e, 0xd3, 0x5b, 0xc3, 0xa1,
-	0x7b, 0x1b, 0x96, 0x8d, 0xde, 0xbd, 0x65, 0x1c, 0x07, 0xc0, 0xf6, 0x83, 0x24, 0x7d, 0x11, 0x26,
-	0x63, 0x43, 0x73, 0x7a, 0x0f, 0x1a, 0x28, 0x6d, 0xb1, 0x67, 0x82, 0x73, 0x67, 0x3d, 0x14, 0xbf,
-	0xd8, 0xaf, 0x84, 0x32, 0xfd, 0x37, 0x32, 0xb3, 0x22, 0x33, 0xfd, 0x37, 0x94, 0xe9, 0x7e, 0x0a,
-	0x2b, 0x56, 0x7d, 0xb2, 0xe9, 0x0f, 0x61, 0x76, 0x92, 0xbe, 0x89, 0x94, 0x6e, 0xdc, 0x94, 0x14,
-	0x82, 0xa7, 0x30, 0x4f, 0xe4, 0xb8, 0x0f, 0x60, 0xf9, 0x80, 0xbf, 0x96, 0x8c, 0xac, 0x3a, 0x72,
-	0xeb, 0xd2, 0x13, 0x1a, 0xe5, 0xbb, 0x1b, 0xc0, 0xcc, 0x8f, 0x33, 0x06, 0x50, 0xe7, 0x35, 0xc7,
-	0x3a, 0xaf, 0xb9, 0xb7, 0x80, 0x1d, 0x05, 0xa7, 0xe1, 0x53, 0x9e, 0x24, 0xfe, 0xa9, 0x66, 0xfd,
-	0x36, 0x54, 0x47, 0xc9, 0xa9, 0x14, 0x55, 0xf8, 0xd3, 0xfd, 0x4d, 0x58, 0xb1, 0xca, 0xc9, 0x8a,
-	0xaf, 0x41, 0x23, 0x09, 0x4e, 0x43, 0x3f, 0x9d, 0xc4, 0x5c, 0x56, 0x9d, 0x01, 0xee, 0x23, 0x58,
-	0xfd, 0x1e, 0x8f, 0x83, 0x93, 0x8b, 0xcb, 0xaa, 0xb7, 0xeb, 0xa9, 0xe4, 0xeb, 0xd9, 0x85, 0x2b,
-	0xb9, 0x7a, 0x64, 0xf3, 0x82, 0x7c, 0xe5, 0x4a, 0xd6, 0x3d, 0x91, 0x30, 0x64, 0x5f, 0xc5, 0x94,
-	0x7d, 0xee, 0x0b, 0x60, 0xdb, 0x51, 0x18, 0xf2, 0x7e, 0x7a, 0xc8, 0x79, 0x9c, 0x99, 0x8a, 0x32,
-	0x5a, 0x6d, 0x6e, 0xae, 0xcb, 0x99, 0xcd, 0x0b, 0x54, 0x49, 0xc4, 0x0c, 0x6a, 0x63, 0x1e, 0x8f,
-	0xa8, 0xe2, 0xba, 0x47, 0xbf, 0xdd, 0x2b, 0xb0, 0x62, 0x55, 0x2b, 0x0f, 0xd7, 0x1f, 0xc3, 0x95,
-	0x9d, 0x20, 0xe9, 0x17, 0x1b, 0xec, 0xc0, 0xfc, 0x78, 0x72, 0xdc, 0xcb, 0x38, 0x51, 0x25, 0xf1,
-	0xbc, 0x95, 0xff, 0x44, 0x56, 0xf6, 0xd7, 0x1c, 0xa8, 0xed, 0x3d, 0xdf, 0xdf, 0xc6, 0xbd, 0x22,
-	0x08, 0xfb, 0xd1, 0x08, 0xb5, 0x30, 0x31, 0x68, 0x9d, 0x9e, 0xca, 0x61, 0xd7, 0xa0, 0x41, 0xca,
-	0x1b, 0x1e, 0x31, 0xa5, 0x1e, 0x94, 0x01, 0x78, 0xbc, 0xe5, 0x6f, 0xc6, 0x41, 0x4c, 0xe7, 0x57,
-	0x75, 0x2a, 0xad, 0xd1, 0x36, 0x53, 0xcc, 0x70, 0x7f, 0x3e, 0x0f, 0xf3, 0x72, 0xf3, 0x15, 0x1b,
-	0x79, 0x1a, 0x9c, 0xf3, 0x6c, 0x23, 0xc7, 0x14, 0x2a, 0xc6, 0x31, 0x1f, 0x45, 0xa9, 0xd6, 0xdf,
-	0xc4, 0x32, 0xd8, 0x20, 0x1d, 0xdf, 0xa5, 0x12, 0x21, 0x0e, 0xfc, 0x55, 0x51, 0xca, 0x02, 0xd9,
-	0x35, 0x98, 0x57, 0xca, 0x40, 0x4d, 0x9f, 0x2c, 0x14, 0x84, 0xb3, 0xd1, 0xf7, 0xc7, 0x7e, 0x3f,
-	0x48, 0x2f, 0xa4, 0x58, 0xd0, 0x69, 0xac, 0x7f, 0x18, 0xf5, 0xfd, 0x61, 0xef, 0xd8, 0x1f, 0xfa,
-	0x61, 0x9f, 0x2b, 0xf3, 0x80, 0x05, 0xe2, 0x51, 0x59, 0x76, 0x4b, 0x15, 0x13, 0xc7, 0xe9, 0x1c,
-	0x8a, 0x7b, 0x78, 0x3f, 0x1a, 0x8d, 0x82, 0x14, 0x4f, 0xd8, 0xa4, 0x9a, 0x55, 0x3d, 0x03, 0x11,
-	0xc6, 0x08, 0x4a, 0xbd, 0x16, 0x33, 0xd8, 0x50, 0xc6, 0x08, 0x03, 0xc4, 0x5a, 0x72, 0x1a, 0x5a,
-	0xd5, 0x33, 0x10, 0x5c, 0x8b, 0x49, 0x98, 0xf0, 0x34, 0x1d, 0xf2, 0x81, 0xee, 0x50, 0x93, 0x8a,
-	0x15, 0x33, 0xd8, 0x7d, 0x58, 0x11, 0x87, 0xfe, 0xc4, 0x4f, 0xa3, 0xe4, 0x2c, 0x48, 0x7a, 0x09,
-	0x9e, 0x7e, 0xc5, 0xe1, 0xb3, 0x2c, 0x8b, 0x7d, 0x0a, 0xeb, 0x39, 0x38, 0xe6, 0x7d, 0x1e, 0x9c,
-	0xf3, 0x01, 0xa9, 0x70, 0x55, 0x6f, 0x5a, 0x36, 0xbb, 0x01, 0xcd, 0x70, 0x32, 0xea, 0x4d, 0xc6,
-	0x03, 0x1f, 0x95, 0x98, 0x45, 0x52, 0x2e, 0x4d, 0x88, 0x7d, 0x0c, 0x4a, 0x4f, 0x93, 0xda, 0xe3,
-	0x92, 0x25, 0xe1, 0x90, 0x7a, 0x3d, 0xbb, 0x04, 0x12, 0x66, 0xa6, 0x92, 0xb6, 0xe5, 0x41, 0x4f,
-	0x01, 0xc4, 0x27, 0x71, 0x70, 0xee, 0xa7, 0xbc, 0xb3, 0x2c, 0x84, 0xba, 0x4c, 0xe2, 0x77, 0x41,
-	0x18, 0xa4, 0x81, 0x9f, 0x46, 0x71, 0x87, 0x51, 0x5e, 0x06, 0xe0, 0x24, 0x12, 0x7d, 0x24, 0xa9,
-	0x9f, 0x4e, 0x12, 0xa9, 0xa1, 0xae, 0x10, 0x71, 0x15, 0x33, 0xd8, 0x27, 0xb0, 0x26, 0x28, 0x82,
-	0xb2, 0xa4, 0xee, 0x4d, 0xaa, 0xc2, 0x2a, 0xcd, 0xc8, 0x94, 0x5c, 0x9c, 0x4a, 0x49, 0x22, 0x85,
-	0x0f, 0xaf, 0x88, 0xa9, 0x9c, 0x92, 0x8d, 0xfd, 0xc3, 0x1e, 0x04, 0xfd, 0x9e, 0x2c, 0x81, 0x2c,
-	0xb2, 0x46, 0xa3, 0x28, 0x66, 0x20, 0x89, 0x0f, 0x83, 0x13, 0x9e, 0x06, 0x23, 0xde, 0x59, 0x17,
-	0x24, 0xae, 0xd2, 0xc8, 0x80, 0x93, 0x31, 0xe5, 0x74, 0x04, 0xc3, 0x8b, 0x94, 0xfb, 0xfb, 0x8e,
-	0xd8, 0x7c, 0x24, 0xa3, 0x26, 0xc6, 0xb1, 0x4a, 0xb0, 0x68, 0x2f, 0x0a, 0x87, 0x17, 0x92, 0x6b,
-	0x41, 0x40, 0xcf, 0xc2, 0xe1, 0x05, 0x2a, 0xf6, 0x41, 0x68, 0x16, 0x11, 0x72, 0xae, 0xa5, 0x40,
-	0x2a, 0xf4, 0x01, 0x34, 0xc7, 0x93, 0xe3, 0x61, 0xd0, 0x17, 0x45, 0xaa, 0xa2, 0x16, 0x01, 0x51,
-	0x01, 0x3c, 0x57, 0x8a, 0x95, 0x12, 0x25, 0x6a, 0x54, 0xa2, 0x29, 0x31, 0x2c, 0xe2, 0x3e, 0x84,
-	0x55, 0xbb, 0x83, 0x52, 0xa0, 0xdf, 0x85, 0xba, 0xe4, 0xff, 0x44, 0x1e, 0xee, 0x17, 0x0d, 0x3b,
-	0x2b, 0x1e, 0x83, 0x74, 0xbe, 0xfb, 0x2f, 0x6b, 0xb0, 0x22, 0xd1, 0xed, 0x61, 0x94, 0xf0, 0xa3,
-	0xc9, 0x68, 0xe4, 0xc7, 0x25, 0x82, 0xc5, 0xb9, 0x44, 0xb0, 0x54, 0x8a, 0x82, 0xe5, 0xba, 0x75,
-	0xbe, 0x14, 0x92, 0xc9, 0x40, 0xd8, 0x1d, 0x58, 0xea, 0x0f, 0xa3, 0x44, 0xa8, 0xfb, 0xa6, 0xa9,
-	0x2f, 0x0f, 0x17, 0x85, 0xe1, 0x6c, 0x99, 0x30, 0x34, 0x05, 0xd9, 0x5c, 0x4e, 0x90, 0xb9, 0xd0,
-	0xc2, 0x4a, 0xb9, 0x92, 0xcd, 0xf3, 0xf2, 0xb0, 0x65, 0x60, 0xd8, 0x9f, 0xbc, 0xd8, 0x10, 0x32,
-	0x6a, 0xa9, 0x4c, 0x68, 0x04, 0x23, 0x4e, 0xb2, 0xdf, 0x28, 0xdd, 0x90, 0x42, 0xa3, 0x98, 0xc5,
-	0x1e, 0x01, 0x88, 0xb6, 0x48, 0x01, 0x01, 0x52, 0x40, 0x6e, 0xd9, 0xab, 0x62, 0xce, 0xff, 0x06,
-	0x26, 0x26, 0x31, 0x27, 0xa5, 0xc4, 0xf8, 0xd2, 0xfd, 0x1b, 0x0e, 0x34, 0x8d, 0x3c, 0x76, 0x05,
-	0x96, 0xb7, 0x9f, 0x3d, 0x3b, 0xdc, 0xf5, 0xb6, 0x9e, 0x3f, 0xf9, 0xde, 0x6e, 0x6f, 0x7b, 0xff,
-	0xd9, 0xd1, 0x6e, 0x7b, 0x06, 0xe1, 0xfd, 0x67, 0xdb, 0x5b, 0xfb, 0xbd, 0x47, 0xcf, 0xbc, 0x6d,
-	0x05, 0x3b, 0x6c, 0x0d, 0x98, 0xb7, 0xfb, 0xf4, 0xd9, 0xf3, 0x5d, 0x0b, 0xaf, 0xb0, 0x36, 0xb4,
-	0x1e, 0x7a, 0xbb, 0x5b, 0xdb, 0x7b, 0x12, 0xa9, 0xb2, 0x55, 0x68, 0x3f, 0x7a, 0x71, 0xb0, 0xf3,
-	0xe4, 0xe0, 0x71, 0x6f, 0x7b, 0xeb, 0x60, 0x7b, 0x77, 0x7f, 0x77, 0xa7, 0x5d, 0x63, 0x0b, 0xd0,
-	0xd8, 0x7a, 0xb8, 0x75, 0xb0, 0xf3, 0xec, 0x60, 0x77, 0xa7, 0x3d, 0xeb, 0xfe, 0x17, 0x07, 0xae,
-	0x50, 0xaf, 0x07, 0x79, 0x26, 0xb9, 0x01, 0xcd, 0x7e, 0x14, 0x8d, 0x51, 0xf1, 0xcf, 0xb6, 0x36,
-	0x13, 0x42, 0x06, 0x10, 0x42, 0xe1, 0x24, 0x8a, 0xfb, 0x5c, 0xf2, 0x08, 0x10, 0xf4, 0x08, 0x11,
-	0x64, 0x00, 0xb9, 0xbc, 0xa2, 0x84, 0x60, 0x91, 0xa6, 0xc0, 0x44, 0x91, 0x35, 0x98, 0x3b, 0x8e,
-	0xb9, 0xdf, 0x3f, 0x93, 0xdc, 0x21, 0x53, 0xec, 0xeb, 0xd9, 0xc9, 0xb4, 0x8f, 0xb3, 0x3f, 0xe4,
-	0x03, 0xa2, 0x98, 0xba, 0xb7, 0x24, 0xf1, 0x6d, 0x09, 0xa3, 0x14, 0xf4, 0x8f, 0xfd, 0x70, 0x10,
-	0x85, 0x7c, 0x20, 0xd5, 0xde, 0x0c, 0x70, 0x0f, 0x61, 0x2d, 0x3f, 0x3e, 0xc9, 0x63, 0x9f, 0x18,
-	0x3c, 0x26, 0xb4, 0xd0, 0xee, 0xf4, 0xd5, 0x34, 0xf8, 0xed, 0x17, 0x15, 0xa8, 0xa1, 0x52, 0x32,
-	0x5d, 0x81, 0x31, 0xf5, 0xcc, 0x6a, 0xc1, 0x2f, 0x40, 0x87, 0x5d, 0xb1, 0x45, 0x49, 0x43, 0x4b,
-	0x86, 0x64, 0xf9, 0x31, 0xef, 0x9f, 0x4b, 0x53, 0x8b, 0x81, 0x20, 0x83, 0xe0, 0x21, 0x80, 0xbe,
-	0x96, 0x0c, 0xa2, 0xd2, 0x2a, 0x8f, 0xbe, 0x9c, 0xcf, 0xf2, 0xe8, 0xbb, 0x0e, 0xcc, 0x07, 0xe1,
-	0x71, 0x34, 0x09, 0x07, 0xc4, 0x10, 0x75, 0x4f, 0x25, 0xc9, 0x13, 0x41, 0x8c, 0x8a, 0xf2, 0x53,
-	0x90, 0x7f, 0x06, 0xb0, 0x4d, 0x68, 0x24, 0x17, 0x61, 0xdf, 0xa4, 0xf9, 0x55, 0x39, 0x4b, 0x38,
-	0x07, 0x1b, 0x47, 0x17, 0x61, 0x9f, 0x28, 0x3c, 0x2b, 0xe6, 0xfe, 0x0e, 0xd4, 0x15, 0x8c, 0x64,
-	0xf9, 0xe2, 0xe0, 0xf3, 0x83, 0x67, 0x2f, 0x0f, 0x7a, 0x47, 0xdf, 0x3f, 0xd8, 0x6e, 0xcf, 0xb0,
-	0x25, 0x68, 0x6e, 0x6d, 0x13, 0xa5, 0x13, 0xe0, 0x60, 0x91, 0xc3, 0xad, 0xa3, 0x23, 0x8d, 0x54,
-	0x5c, 0x86, 0x07, 0xf9, 0x84, 0x34, 0x3f, 0x6d, 0x69, 0xff, 0x04, 0x96, 0x0d, 0x2c, 0x3b, 0x45,
-	0x8c, 0x11, 0xc8, 0x9d, 0x22, 0x48, 0x65, 0x14, 0x39, 0x6e, 0x1b, 0x16, 0x1f, 0xf3, 0xf4, 0x49,
-	0x78, 0x12, 0xa9, 0x9a, 0xfe, 0x47, 0x0d, 0x96, 0x34, 0x24, 0x2b, 0xba, 0x03, 0x4b, 0xc1, 0x80,
-	0x87, 0x69, 0x90, 0x5e, 0xf4, 0x2c, 0x7b, 0x41, 0x1e, 0x46, 0x55, 0xdb, 0x1f, 0x06, 0xbe, 0x72,
-	0xf8, 0x88, 0x04, 0x9e, 0x9f, 0x51, 0x07, 0x30, 0xed, 0x36, 0x44, 0x57, 0xc2, 0x4c, 0x51, 0x9a,
-	0x87, 0x12, 0x08, 0x71, 0xb9, 0xcd, 0xe8, 0x4f, 0x84, 0xca, 0x59, 0x96, 0x85, 0x4b, 0x25, 0x6a,
-	0xc2, 0x21, 0xcf, 0x0a, 0x3d, 0x41, 0x03, 0x05, 0x8f, 0xca, 0x9c, 0x90, 0x8f, 0x79, 0x8f, 0x8a,
-	0xe1, 0x95, 0xa9, 0x17, 0xbc, 0x32, 0x28, 0x3f, 0x2f, 0xc2, 0x3e, 0x1f, 0xf4, 0xd2, 0xa8, 0x47,
-	0x72, 0x9e, 0x48, 0xa2, 0xee, 0xe5, 0x61, 0xdc, 0x37, 0x52, 0x9e, 0xa4, 0x21, 0x17, 0xa6, 0xe9,
-	0x3a, 0x59, 0x41, 0x15, 0x84, 0xe7, 0x83, 0x49, 0x1c, 0x24, 0x9d, 0x16, 0xf9, 0x5b, 0xe8, 0x37,
-	0xfb, 0x16, 0x5c, 0x39, 0xe6, 0x49, 0xda, 0x3b, 0xe3, 0xfe, 0x80, 0xc7, 0x44, 0x5e, 0xc2, 0xb1,
-	0x23, 0x54, 0xae, 0xf2, 0x4c, 0x24, 0xdc, 0x73, 0x1e, 0x27, 0x41, 0x14, 0x92, 0xb2, 0xd5, 0xf0,
-	0x54, 0x12, 0xeb, 0xc3, 0xc1, 0xeb, 0x8d, 0x5a, 0xcf, 0xe0, 0x12, 0x0d, 0xbc, 0x3c, 0x93, 0xdd,
-	0x84, 0x39, 0x1a, 0x40, 0xd2, 0x69, 0x13, 0xcd, 0xb4, 0x32, 0x9e, 0x0f, 0x42, 0x4f, 0xe6, 0xe1,
-	0x2a, 0xf7, 0xa3, 0x61, 0x14, 0x93, 0xc6, 0xd5, 0xf0, 0x44, 0xc2, 0x9e, 0x9d, 0xd3, 0xd8, 0x1f,
-	0x9f, 0x49, 0xad, 0x2b, 0x0f, 0x7f, 0xa7, 0x56, 0x6f, 0xb6, 0x5b, 0xee, 0x9f, 0x81, 0x59, 0xaa,
-	0x96, 0xaa, 0xa3, 0xc9, 0x74, 0x64, 0x75, 0x84, 0x76, 0x60, 0x3e, 0xe4, 0xe9, 0xeb, 0x28, 0x7e,
-	0xa5, 0xbc, 0x87, 0x32, 0xe9, 0xfe, 0x94, 0x4e, 0x68, 0xda, 0x9b, 0xf6, 0x82, 0x54, 0x4b, 0x3c,
-	0x67, 0x8b, 0xa5, 0x4a, 0xce, 0x7c, 0x79, 0x68, 0xac, 0x13, 0x70, 0x74, 0xe6, 0xa3, 0xac, 0xb5,
-	0x56, 0x5f, 0x9c, 0xc3, 0x9b, 0x84, 0xed, 0x89, 0xc5, 0xbf, 0x09, 0x8b, 0xca, 0x4f, 0x97, 0xf4,
-	0x86, 0xfc, 0x24, 0x55, 0x56, 0xb4, 0x70, 0x32, 0xa2, 0xc3, 0xfa, 0x3e, 0x3f, 0x49, 0xdd, 0x03,
-	0x58, 0x96, 0xf2, 0xef, 0xd9, 0x98, 0xab, 0xa6, 0x7f, 0xab, 0x4c, 0x97, 0x68, 0x6e, 0xae, 0xd8,
-	0x02, 0x53, 0x78, 0x26, 0xed, 0x92, 0xae, 0x07, 0xcc, 0x94, 0xa7, 0xb2, 0x42, 0xb9, 0x99, 0x2b,
-	0x3b, 0xa1, 0x1c, 0x8e, 0x85, 0xe1, 0xfc, 0x24, 0x93, 0x7e, 0x5f, 0x79, 0x57, 0xeb, 0x9e, 0x4a,
-	0xba, 0x7f, 0xe8, 0xc0, 0x0a, 0xd5, 0xa6, 0xb4, 0x21, 0xb9, 0x67, 0x7d, 0xfa, 0x15, 0xba, 0xa9,
-	0xac, 0xb4, 0xc2, 0x36, 0xb9, 0x0a, 0xb3, 0xe6, 0x2e, 0x26, 0x12, 0x5f, 0xdd, 0x26, 0x53, 0xcb,
-	0xdb, 0x64, 0xdc, 0xbf, 0xeb, 0xc0, 0xb2, 0xd8, 0x48, 0x48, 0xdb, 0x96, 0xc3, 0xff, 0xb3, 0xb0,
-	0x20, 0x34, 0x02, 0x29, 0x15, 0x64, 0x47, 0x33, 0xd1, 0x4a, 0xa8, 0x28, 0xbc, 0x37, 0xe3, 0xd9,
-	0x85, 0xd9, 0x03, 0xd2, 0xca, 0xc2, 0x1e, 0xa1, 0x25, 0x7e, 0x78, 0x7b, 0xae, 0xf7, 0x66, 0x3c,
-	0xa3, 0xf8, 0xc3, 0x3a, 0x2a, 0xcb, 0x88, 0xbb, 0x8f, 0x61, 0xc1, 0x6a, 0xc8, 0xb2, 0x07, 0xb5,
-	0x84, 0x3d, 0xa8, 0x60, 0x78, 0xad, 0x94, 0x18, 0x5e, 0xff, 0x79, 0x15, 0x18, 0x12, 0x4b, 0x6e,
-	0x35, 0x6e, 0xd8, 0xde, 0x0b, 0xe5, 0x92, 0xcf, 0x20, 0xb6, 0x09, 0xcc, 0x48, 0x2a, 0xaf, 0x4a,
-	0x55, 0x7b, 0x55, 0x4a, 0x72, 0x51, 0xd4, 0x4a, 0xad, 0x43, 0x7b, 0x2c, 0xe8, 0xac, 0x2f, 0xa6,
-	0xbe, 0x34, 0x0f, 0x77, 0x46, 0x72, 0x5f, 0xe0, 0xa9, 0x44, 0x9e, 0x8f, 0x55, 0x3a, 0xbf, 0xc6,
-	0x73, 0x97, 0xae, 0xf1, 0x7c, 0xc1, 0xee, 0x66, 0x9c, 0xd0, 0xea, 0xf6, 0x09, 0xed, 0x26, 0x2c,
-	0x28, 0x2f, 0x85, 0xf0, 0x88, 0xca, 0xe3, 0xb0, 0x05, 0xb2, 0xbb, 0xd0, 0x56, 0x87, 0x24, 0x7d,
-	0x0c, 0x14, 0xfe, 0xbe, 0x02, 0x8e, 0x7b, 0x40, 0x66, 0x89, 0x6b, 0x52, 0x67, 0x33, 0x80, 0xce,
-	0x54, 0x48, 0x25, 0xbd, 0x49, 0x28, 0xdd, 0xf1, 0x7c, 0x40, 0x07, 0x61, 0x3c, 0x53, 0xe5, 0x33,
-	0xdc, 0xbf, 0xe5, 0x40, 0x1b, 0xd7, 0xcd, 0x22, 0xcd, 0xcf, 0x80, 0x38, 0xe3, 0x1d, 0x29, 0xd3,
-	0x2a, 0xcb, 0x3e, 0x85, 0x06, 0xa5, 0xa3, 0x31, 0x0f, 0x25, 0x5d, 0x76, 0x6c, 0xba, 0xcc, 0x64,
-	0xca, 0xde, 0x8c, 0x97, 0x15, 0x36, 0xa8, 0xf2, 0x3f, 0x38, 0xd0, 0x94, 0xad, 0xfc, 0xd2, 0x96,
-	0x9e, 0xae, 0x11, 0x3f, 0x21, 0x14, 0xb0, 0x2c, 0x5c, 0xe2, 0x0e, 0x2c, 0x8d, 0xfc, 0x74, 0x12,
-	0xe3, 0x9e, 0x6e, 0x59, 0x79, 0xf2, 0x30, 0x6e, 0xd0, 0x24, 0x3e, 0x93, 0x5e, 0x1a, 0x0c, 0x7b,
-	0x2a, 0x57, 0x46, 0x2a, 0x94, 0x65, 0xa1, 0x14, 0x49, 0x52, 0xff, 0x94, 0xcb, 0xbd, 0x57, 0x24,
-	0xdc, 0x0e, 0xac, 0x1d, 0x66, 0x9e, 0x1b, 0x43, 0xc7, 0x76, 0xff, 0xe9, 0x02, 0xac, 0x17, 0xb2,
-	0x74, 0x5c, 0x95, 0x34, 0x5d, 0x0c, 0x83, 0xd1, 0x71, 0xa4, 0x0f, 0x28, 0x8e, 0x69, 0xd5, 0xb0,
-	0xb2, 0xd8, 0x29, 0x5c, 0x51, 0x4a, 0x06, 0xce, 0x69, 0xb6, 0x21, 0x56, 0x68, 0xa7, 0xfb, 0xd8,
-	0x5e, 0xc2, 0x7c, 0x83, 0x0a, 0x37, 0x19, 0xb9, 0xbc, 0x3e, 0x76, 0x06, 0x1d, 0xad, 0xcd, 0x48,
-	0x81, 0x6d, 0x68, 0x3c, 0xd8, 0xd6, 0x47, 0x97, 0xb4, 0x65, 0xa9, 0xe4, 0xde, 0xd4, 0xda, 0xd8,
-	0x05, 0x5c, 0x57, 0x79, 0x24, 0x91, 0x8b, 0xed, 0xd5, 0xde, 0x69, 0x6c, 0x74, 0xd8, 0xb0, 0x1b,
-	0xbd, 0xa4, 0x62, 0xf6, 0x63, 0x58, 0x7b, 0xed, 0x07, 0xa9, 0xea, 0x96, 0xa1, 0x5f, 0xcc, 0x52,
-	0x93, 0x9b, 0x97, 0x34, 0xf9, 0x52, 0x7c, 0x6c, 0x6d, 0x53, 0x53, 0x6a, 0xec, 0xfe, 0x49, 0x05,
-	0x16, 0xed, 0x7a, 0x90, 0x4c, 0x25, 0xef, 0x2b, 0x19, 0xa8, 0x34, 0xd2, 0x1c, 0x5c, 0x3c, 0xe7,
-	0x57, 0xca, 0xce, 0xf9, 0xe6, 0xc9, 0xba, 0x7a, 0x99, 0x89, 0xb0, 0xf6, 0x6e, 0x26, 0xc2, 0xd9,
-	0x52, 0x13, 0xe1, 0x74, 0x4b, 0xd2, 0xdc, 0x2f, 0x6b, 0x49, 0x9a, 0x7f, 0xab, 0x25, 0xa9, 0xfb,
-	0x7f, 0x1c, 0x60, 0x45, 0xea, 0x65, 0x8f, 0x85, 0x69, 0x23, 0xe4, 0x43, 0x29, 0xc4, 0xbe, 0xf9,
-	0x6e, 0x1c, 0xa0, 0x56, 0x4b, 0x7d, 0x8d, 0xac, 0x68, 0x06, 0x37, 0x99, 0x2a, 0xd6, 0x82, 0x57,
-	0x96, 0x95, 0x33, 0x93, 0xd6, 0x2e, 0x37, 0x93, 0xce, 0x5e, 0x6e, 0x26, 0x9d, 0xcb, 0x9b, 0x49,
-	0xbb, 0x7f, 0xd5, 0x81, 0x95, 0x12, 0x32, 0xfb, 0xf5, 0x0d, 0x1c, 0x09, 0xc3, 0x92, 0x3e, 0x15,
-	0x49, 0x18, 0x26, 0xd8, 0xfd, 0x8b, 0xb0, 0x60, 0xb1, 0xd6, 0xaf, 0xaf, 0xfd, 0xbc, 0x96, 0x28,
-	0x28, 0xdb, 0xc2, 0xba, 0xff, 0xb3, 0x02, 0xac, 0xc8, 0xde, 0xff, 0x5f, 0xfb, 0x50, 0x9c, 0xa7,
-	0x6a, 0xc9, 0x3c, 0xfd, 0x3f, 0xdd, 0x79, 0x3e, 0x82, 0x65, 0x19, 0xb1, 0x69, 0x18, 0xb3, 0x04,
-	0xc5, 0x14, 0x33, 0x50, 0x4f, 0xb6, 0x6d, 0xd4, 0x75, 0x2b, 0x42, 0xcd, 0xd8, 0x7e, 0x73, 0xa6,
-	0x6a, 0xb7, 0x0b, 0x1d, 0x39, 0x43, 0xbb, 0xe7, 0x3c, 0x4c, 0x8f, 0x26, 0xc7, 0x22, 0x64, 0x31,
-	0x88, 0x42, 0x52, 0x03, 0xcd, 0x4c, 0xa9, 0x50, 0x7c, 0x0b, 0x5a, 0xe6, 0xf6, 0x21, 0x97, 0x23,
-	0x67, 0xcf, 0x44, 0x55, 0xc2, 0x2c, 0xc5, 0x76, 0x60, 0x91, 0x84, 0xe4, 0x40, 0x7f, 0x57, 0xa1,
-	0xef, 0xde, 0x62, 0xa3, 0xd9, 0x9b, 0xf1, 0x72, 0xdf, 0xb0, 0xdf, 0x86, 0x45, 0xfb, 0x00, 0x28,
-	0xb5, 0x92, 0xb2, 0x13, 0x01, 0x7e, 0x6e, 0x17, 0x66, 0x5b, 0xd0, 0xce, 0x9f, 0x20, 0x65, 0x34,
-	0xcf, 0x94, 0x0a, 0x0a, 0xc5, 0xd9, 0xa7, 0xd2, 0x61, 0x39, 0x4b, 0xb6, 0x93, 0x9b, 0xf6, 0x67,
-	0xc6, 0x34, 0x6d, 0x88, 0x3f, 0x86, 0x0b, 0xf3, 0x77, 0x01, 0x32, 0x8c, 0xb5, 0xa1, 0xf5, 0xec,
-	0x70, 0xf7, 0xa0, 0xb7, 0xbd, 0xb7, 0x75, 0x70, 0xb0, 0xbb, 0xdf, 0x9e, 0x61, 0x0c, 0x16, 0xc9,
-	0xd4, 0xb7, 0xa3, 0x31, 0x07, 0x31, 0x69, 0x5c, 0x51, 0x58, 0x85, 0xad, 0x42, 0xfb, 0xc9, 0x41,
-	0x0e, 0xad, 0x3e, 0x6c, 0x68, 0xfe, 0x70, 0xd7, 0x60, 0x55, 0x44, 0xe4, 0x3e, 0x14, 0xe4, 0xa1,
-	0xb4, 0x93, 0x7f, 0xe8, 0xc0, 0x95, 0x5c, 0x46, 0x16, 0xf2, 0x25, 0x14, 0x10, 0x5b, 0x2b, 0xb1,
-	0x41, 0x72, 0x40, 0x28, 0x5d, 0x33, 0x27, 0x41, 0x8a, 0x19, 0x48, 0xf3, 0x86, 0x6e, 0x9a, 0xe3,
-	0xa4, 0xb2, 0x2c, 0x77, 0x5d, 0x47, 0xd6, 0xe4, 0x3a, 0x7e, 0x22, 0x22, 0x7d, 0xcd, 0x8c, 0xcc,
-	0x01, 0x6c, 0x77, 0x59, 0x25, 0xf1, 0x58, 0x61, 0x29, 0x3b, 0x76, 0x7f, 0x4b, 0xf3, 0xdc, 0x7f,
-	0x53, 0x05, 0xf6, 0xdd, 0x09, 0x8f, 0x2f, 0x28, 0xa6, 0x4b, 0x5b, 0x4e, 0xd7, 0xf3, 0x76, 0xc1,
-	0xb9, 0xf1, 0xe4, 0xf8, 0x73, 0x7e, 0xa1, 0x82, 0x25, 0x2b, 0xef, 0x14, 0x2c, 0x59, 0x16, 0xac,
-	0x58, 0xbb, 0x3c, 0x58, 0x71, 0xf6, 0xb2, 0x60, 0xc5, 0xaf, 0xc1, 0x42, 0x70, 0x1a, 0x46, 0x28,
-	0x0e, 0x50, 0x85, 0x48, 0x3a, 0x73, 0x37, 0xaa, 0x78, 0xf4, 0x96, 0xe0, 0x01, 0x62, 0xec, 0x41,
-	0x56, 0x88, 0x0f, 0x4e, 0x29, 0xb8, 0xd6, 0x14, 0x10, 0xbb, 0x83, 0x53, 0xbe, 0x1f, 0xf5, 0xfd,
-	0x34, 0x8a, 0xe9, 0x9c, 0xa6, 0x3e, 0x46, 0x3c, 0x61, 0x37, 0x61, 0x31, 0x89, 0x26, 0xa8, 0x54,
-	0xa9, 0x69, 0x10, 0x86, 0xa6, 0x96, 0x40, 0x0f, 0xc5, 0x64, 0x6c, 0xc0, 0xca, 0x24, 0xe1, 0xbd,
-	0x51, 0x90, 0x24, 0xb8, 0x71, 0xf6, 0xa3, 0x30, 0x8d, 0xa3, 0xa1, 0x34, 0x37, 0x2d, 0x4f, 0x12,
-	0xfe, 0x54, 0xe4, 0x6c, 0x8b, 0x0c, 0xf6, 0xad, 0xac, 0x4b, 0x63, 0x3f, 0x88, 0x93, 0x0e, 0x50,
-	0x97, 0xd4, 0x48, 0xb1, 0xdf, 0x87, 0x7e, 0x10, 0xeb, 0xbe, 0x60, 0x22, 0xc9, 0x05, 0x51, 0x36,
-	0x73, 0x41, 0x94, 0x32, 0xfe, 0x6e, 0x03, 0xea, 0xea, 0x73, 0x3c, 0x03, 0x9f, 0xc4, 0xd1, 0x48,
-	0x9d, 0x81, 0xf1, 0x37, 0x5b, 0x84, 0x4a, 0x1a, 0xc9, 0xf3, 0x6b, 0x25, 0x8d, 0xdc, 0xdf, 0x83,
-	0xa6, 0x31, 0x03, 0xec, 0x43, 0x71, 0x1c, 0x47, 0x5d, 0x4b, 0x1e, 0x9e, 0x85, 0x17, 0xa5, 0x21,
-	0xd1, 0x27, 0x03, 0xf6, 0x0d, 0x58, 0x1e, 0x04, 0x31, 0xa7, 0x38, 0xdf, 0x5e, 0xcc, 0xcf, 0x79,
-	0x9c, 0x28, 0x53, 0x43, 0x5b, 0x67, 0x78, 0x02, 0x77, 0x7b, 0xb0, 0x62, 0x51, 0x95, 0x66, 0xba,
-	0x39, 0x0a, 0x1a, 0x54, 0xd6, 0x4e, 0x3b, 0xa0, 0x50, 0xe6, 0xe1, 0x76, 0x25, 0xad, 0x24, 0xbd,
-	0x71, 0x1c, 0x1d, 0x53, 0x23, 0x8e, 0x67, 0x61, 0xee, 0x2f, 0x2a, 0x50, 0xdd, 0x8b, 0xc6, 0xa6,
-	0xef, 0xc7, 0x29, 0xfa, 0x7e, 0xa4, 0x5e, 0xd9, 0xd3, 0x6a, 0xa3, 0xdc, 0xfc, 0x2d, 0x90, 0xdd,
-	0x85, 0x45, 0xa4, 0xe0, 0x34, 0x42, 0x3d, 0xfa, 0xb5, 0x1f, 0x8b, 0x08, 0xc3, 0x2a, 0x91, 0x45,
-	0x2e, 0x87, 0xad, 0x42, 0x55, 0xab, 0x43, 0x54, 0x00, 0x93, 0x78, 0x88, 0x23, 0xff, 0xfa, 0x85,
-	0x34, 0x69, 0xca, 0x14, 0x0a, 0x04, 0xfb, 0x7b, 0xc1, 0x26, 0x62, 0x53, 0x2b, 0xcb, 0x42, 0x1d,
-	0x17, 0x19, 0x61, 0x94, 0xa9, 0x8c, 0x3a, 0x6d, 0x1a, 0xeb, 0xeb, 0xb6, 0xb1, 0xfe, 0x06, 0x34,
-	0xd3, 0xe1, 0x79, 0x6f, 0xec, 0x5f, 0x0c, 0x23, 0x7f, 0x20, 0x09, 0xd0, 0x84, 0xd8, 0x7d, 0x80,
-	0xd1, 0x78, 0xdc, 0xc3, 0x7d, 0x34, 0x1e, 0xd0, 0xc9, 0xbc, 0xb9, 0xd9, 0x96, 0xb3, 0xff, 0xf4,
-	0xf0, 0xd0, 0x23, 0xdc, 0x33, 0xca, 0xb8, 0x2f, 0xa1, 0xa1, 0x33, 0xcc, 0xd0, 0x54, 0x8a, 0xb0,
-	0x68, 0xda, 0xa1, 0xa9, 0x14, 0x50, 0x71, 0x0b, 0x16, 0x85, 0xf0, 0xd4, 0xe2, 0x40, 0x78, 0xc5,
-	0x73, 0xa8, 0xfb, 0xa7, 0x0e, 0xcc, 0xd2, 0x82, 0xa3, 0x36, 0x21, 0xf2, 0xb4, 0xaf, 0x8a, 0x16,
-	0x71, 0xc1, 0xcb, 0xc3, 0xcc, 0xb5, 0xc2, 0xe4, 0x2b, 0x7a, 0xf6, 0xcd, 0x50, 0xf9, 0x1b, 0xd0,
-	0xd0, 0x2d, 0x19, 0x2b, 0x98, 0x81, 0xec, 0x3a, 0xd4, 0xce, 0xa2, 0xb1, 0x3a, 0x70, 0x81, 0x72,
-	0x67, 0x47, 0x63, 0x8f, 0xf0, 0xac, 0x3f, 0x58, 0x9f, 0x18, 0x82, 0x50, 0x6a, 0xf3, 0x70, 0xc9,
-	0x58, 0xe7, 0x4a, 0xc7, 0xfa, 0x02, 0x96, 0x90, 0x2d, 0x0d, 0xdb, 0xfd, 0x74, 0xd1, 0xfa, 0x75,
-	0xdc, 0xa9, 0xfb, 0xc3, 0xc9, 0x80, 0x9b, 0xc7, 0x5e, 0xb2, 0xcd, 0x4a, 0x5c, 0x29, 0x7c, 0xee,
-	0x3f, 0x73, 0x04, 0xbb, 0x63, 0xbd, 0xec, 0x0e, 0xd4, 0x50, 0x0a, 0xe6, 0xac, 0x1c, 0x3a, 0xea,
-	0x05, 0xcb, 0x79, 0x54, 0x02, 0x57, 0x91, 0xac, 0xa7, 0x66, 0xed, 0xc2, 0x76, 0x9a, 0x9d, 0x19,
-	0xf5, 0xc8, 0x72, 0x47, 0xad, 0x1c, 0xca, 0x36, 0x0c, 0xd7, 0x53, 0xcd, 0x92, 0xac, 0x4a, 0x31,
-	0x18, 0x9c, 0x72, 0xc3, 0xe5, 0xf4, 0x47, 0x0e, 0x2c, 0x58, 0x7d, 0x42, 0xa2, 0xa5, 0x20, 0x72,
-	0x61, 0x34, 0x91, 0x2b, 0x6f, 0x42, 0x26, 0xc1, 0x57, 0x6c, 0x82, 0xd7, 0x2e, 0x8c, 0xaa, 0xe9,
-	0xc2, 0xb8, 0x0f, 0x8d, 0xec, 0x9e, 0x84, 0xdd, 0x29, 0x6c, 0x51, 0xc5, 0xff, 0x64, 0x85, 0x32,
-	0x23, 0xf9, 0xac, 0x61, 0x24, 0x77, 0x1f, 0x40, 0xd3, 0x28, 0x6f, 0x1a, 0xb9, 0x1d, 0xcb, 0xc8,
-	0xad, 0x83, 0xe3, 0x2a, 0x59, 0x70, 0x9c, 0xfb, 0xb3, 0x0a, 0x2c, 0x20, 0x79, 0x07, 0xe1, 0xe9,
-	0x61, 0x34, 0x0c, 0xfa, 0x17, 0x44, 0x56, 0x8a, 0x92, 0xe5, 0x2e, 0xa8, 0xc8, 0xdc, 0x86, 0x91,
-	0xfb, 0x75, 0x44, 0xb0, 0x10, 0x55, 0x3a, 0x8d, 0xb2, 0x0c, 0x25, 0xc1, 0xb1, 0x9f, 0x70, 0xe3,
-	0xe2, 0x84, 0x67, 0x83, 0x28, 0x71, 0x10, 0xa0, 0x50, 0xc7, 0x51, 0x30, 0x1c, 0x06, 0xa2, 0xac,
-	0x38, 0xbe, 0x95, 0x65, 0x61, 0x9b, 0x83, 0x20, 0xf1, 0x8f, 0x33, 0xf7, 0xa4, 0x4e, 0x93, 0xed,
-	0xcf, 0x7f, 0x63, 0xd8, 0xfe, 0x44, 0x6c, 0xb4, 0x0d, 0xe6, 0x17, 0x72, 0xbe, 0xb0, 0x90, 0xee,
-	0xbf, 0xae, 0x40, 0xd3, 0x20, 0x0b, 0x64, 0xe7, 0xd2, 0xed, 0xc6, 0x40, 0xa5, 0xdf, 0x3e, 0xb4,
-	0x0c, 0x02, 0x06, 0xc2, 0x6e, 0xda, 0xad, 0x92, 0x1f, 0x80, 0x18, 0xde, 0x22, 0xa1, 0x6b, 0xd0,
-	0x40, 0xd2, 0xff, 0x98, 0xac, 0x0f, 0xf2, 0x92, 0x92, 0x06, 0x54, 0xee, 0x26, 0xe5, 0xce, 0x66,
-	0xb9, 0x04, 0xbc, 0xd5, 0x93, 0xff, 0x29, 0xb4, 0x64, 0x35, 0xb4, 0xc6, 0x34, 0xe8, 0x8c, 0xf9,
-	0xac, 0xf5, 0xf7, 0xac, 0x92, 0xea, 0xcb, 0x4d, 0xf5, 0x65, 0xfd, 0xb2, 0x2f, 0x55, 0x49, 0xf7,
-	0xb1, 0x0e, 0x92, 0x78, 0x1c, 0xfb, 0xe3, 0x33, 0x25, 0x50, 0xee, 0xc3, 0x8a, 0x92, 0x1b, 0x93,
-	0xd0, 0x0f, 0xc3, 0x68, 0x12, 0xf6, 0xb9, 0x8a, 0xa3, 0x2b, 0xcb, 0x72, 0x07, 0x3a, 0xea, 0x9a,
-	0x2a, 0x62, 0x77, 0x61, 0x56, 0xe8, 0x51, 0x62, 0x57, 0x2e, 0x17, 0x21, 0xa2, 0x08, 0xbb, 0x03,
-	0xb3, 0x42, 0x9d, 0xaa, 0x4c, 0x65, 0x7a, 0x51, 0xc0, 0xdd, 0x80, 0x25, 0x0a, 0xf3, 0x36, 0x64,
-	0xdf, 0x7b, 0x65, 0xbb, 0xf5, 0x5c, 0x5f, 0x04, 0x83, 0xaf, 0x02, 0x3b, 0x10, 0x7c, 0x65, 0xba,
-	0x3a, 0xff, 0xb4, 0x0a, 0x4d, 0x03, 0x46, 0xf9, 0x44, 0xfe, 0xa9, 0xde, 0x20, 0xf0, 0x47, 0x3c,
-	0xe5, 0xb1, 0xe4, 0xa5, 0x1c, 0x8a, 0xe5, 0xfc, 0xf3, 0xd3, 0x5e, 0x34, 0x49, 0x7b, 0x03, 0x7e,
-	0x1a, 0x73, 0x2e, 0xd5, 0x88, 0x1c, 0x8a, 0xe5, 0x90, 0x9a, 0x8d, 0x72, 0xc2, 0xa3, 0x94, 0x43,
-	0x95, 0xe3, 0x52, 0xcc, 0x53, 0x2d, 0x73, 0x5c, 0x8a, 0x59, 0xc9, 0x4b, 0xd6, 0xd9, 0x12, 0xc9,
-	0xfa, 0x09, 0xac, 0x09, 0x19, 0x2a, 0xa5, 0x47, 0x2f, 0x47, 0x5c, 0x53, 0x72, 0xd9, 0x5d, 0x68,
-	0x63, 0x9f, 0x15, 0x6b, 0x24, 0xc1, 0x4f, 0x05, 0x8f, 0x39, 0x5e, 0x01, 0xc7, 0xb2, 0x64, 0x49,
-	0x37, 0xcb, 0x8a, 0xe8, 0x91, 0x02, 0x4e, 0x65, 0xfd, 0x37, 0x76, 0xd9, 0x86, 0x2c, 0x9b, 0xc3,
-	0xd9, 0xa7, 0xb0, 0x3e, 0xe2, 0x83, 0xc0, 0xb7, 0xab, 0xe8, 0x65, 0x9b, 0xfc, 0xb4, 0x6c, 0x6c,
-	0x05, 0x67, 0xe1, 0xa7, 0xd1, 0xe8, 0x38, 0x10, 0x1b, 0x9b, 0xb0, 0xf9, 0xd7, 0xbc, 0x02, 0xee,
-	0x2e, 0x40, 0xf3, 0x28, 0x8d, 0xc6, 0x6a, 0xe9, 0x17, 0xa1, 0x25, 0x92, 0x32, 0x72, 0xf2, 0x3d,
-	0xb8, 0x4a, 0xf4, 0xfa, 0x3c, 0x1a, 0x47, 0xc3, 0xe8, 0xf4, 0xc2, 0x3a, 0xb9, 0xff, 0x7b, 0x07,
-	0x56, 0xac, 0xdc, 0xec, 0xe8, 0x4e, 0x66, 0x46, 0x15, 0xee, 0x26, 0x48, 0x7c, 0xd9, 0xd8, 0x16,
-	0x44, 0x41, 0xe1, 0xd5, 0x79, 0x21, 0x23, 0xe0, 0xb6, 0xb2, 0x3b, 0x1c, 0xea, 0x43, 0x41, 0xef,
-	0x9d, 0x22, 0xbd, 0xcb, 0xef, 0xd5, 0xed, 0x0e, 0x55, 0xc5, 0x6f, 0xcb, 0x58, 0x9f, 0x81, 0x1c,
-	0x74, 0xd5, 0x8e, 0xcf, 0x30, 0x2d, 0x3d, 0xaa, 0x07, 0x7d, 0x0d, 0x26, 0xee, 0xcf, 0x1d, 0x80,
-	0xac, 0x77, 0x14, 0x21, 0xa2, 0xb7, 0x36, 0x71, 0x2f, 0xd9, 0xd8, 0xc6, 0x3e, 0x84, 0x96, 0x76,
-	0xf2, 0x67, 0xbb, 0x65, 0x53, 0x61, 0xa8, 0x5d, 0xdc, 0x86, 0xa5, 0xd3, 0x61, 0x74, 0x4c, 0x5a,
-	0x0c, 0x85, 0xe2, 0x26, 0x32, 0x7e, 0x74, 0x51, 0xc0, 0x8f, 0x24, 0x9a, 0x6d, 0xad, 0x35, 0x73,
-	0x6b, 0x2d, 0xdf, 0x28, 0x7f, 0x56, 0xd1, 0x9e, 0xd6, 0x6c, 0x26, 0xde, 0xca, 0xe5, 0x6c, 0xb3,
-	0x20, 0xd6, 0xa7, 0x38, 0x37, 0xe9, 0xe8, 0x71, 0x78, 0xa9, 0xe1, 0xf7, 0x01, 0x2c, 0xc6, 0x42,
-	0x66, 0x2a, 0x81, 0x5a, 0x7b, 0x8b, 0x40, 0x5d, 0x88, 0xad, 0x9d, 0xf9, 0xeb, 0xd0, 0xf6, 0x07,
-	0xe7, 0x3c, 0x4e, 0x03, 0x32, 0x84, 0x91, 0x1a, 0x25, 0x06, 0xb8, 0x64, 0xe0, 0xa4, 0xad, 0xdc,
-	0x86, 0x25, 0x19, 0xcd, 0xab, 0x4b, 0xca, 0x1b, 0x7a, 0x19, 0x8c, 0x05, 0xdd, 0x7f, 0xac, 0x1c,
-	0xbb, 0xf6, 0xea, 0xbe, 0x7d, 0x56, 0xcc, 0x11, 0x56, 0x72, 0x23, 0xfc, 0x9a, 0x74, 0xb4, 0x0e,
-	0x94, 0xc5, 0xad, 0x6a, 0x44, 0x8d, 0x0d, 0xa4, 0x63, 0xdc, 0x9e, 0xd6, 0xda, 0xbb, 0x4c, 0xab,
-	0xfb, 0x9f, 0x1c, 0x98, 0xdf, 0x8b, 0xc6, 0x7b, 0x38, 0xc5, 0xa8, 0xe3, 0x20, 0x9b, 0xe8, 0x50,
-	0x7a, 0x95, 0xbc, 0x24, 0xba, 0xae, 0x54, 0x2b, 0x59, 0xc8, 0x6b, 0x25, 0x7f, 0x1e, 0xde, 0x23,
-	0x9b, 0x6f, 0x1c, 0x8d, 0xa3, 0x18, 0xd9, 0xd5, 0x1f, 0x0a, 0x15, 0x24, 0x0a, 0xd3, 0x33, 0x25,
-	0x4e, 0xdf, 0x56, 0x84, 0x0c, 0x31, 0x78, 0x08, 0x16, 0x07, 0x2b, 0xa9, 0x45, 0x09, 0x29, 0x5b,
-	0xcc, 0x70, 0x7f, 0x0b, 0x1a, 0x74, 0xc2, 0xa0, 0xa1, 0x7d, 0x04, 0x8d, 0xb3, 0x68, 0xdc, 0x3b,
-	0x0b, 0xc2, 0x54, 0xb1, 0xff, 0x62, 0xa6, 0xfa, 0xef, 0xd1, 0xa4, 0xe8, 0x02, 0xee, 0x2f, 0xe6,
-	0x60, 0xfe, 0x49, 0x78, 0x1e, 0x05, 0x7d, 0x72, 0x26, 0x8f, 0xf8, 0x28, 0x52, 0x97, 0x0b, 0xf0,
-	0x37, 0x4e, 0x07, 0x45, 0xd2, 0x8e, 0x05, 0xf1, 0xb6, 0x44, 0xd0, 0x88, 0x84, 0xe8, 0x1a, 0x6e,
-	0x76, 0x39, 0x50, 0x30, 0x98, 0x81, 0xe0, 0x41, 0x31, 0x36, 0x2f, 0xf7, 0xc9, 0x54, 0x76, 0x79,
-	0x63, 0xd6, 0xb8, 0xbc, 0x81, 0xb5, 0x89, 0x4b, 0x67, 0x34, 0xb3, 0x22, 0x64, 0xd4, 0x40, 0xb0,
-	0x2f, 0x32, 0x26, 0x50, 0x04, 0x8d, 0x89, 0xbe, 0x48, 0x88, 0x0e, 0xbf, 0x31, 0x17, 0x36, 0x7d,
-	0xad, 0x98, 0xe1, 0xe1, 0xd7, 0x04, 0x51, 0x79, 0x13, 0x1f, 0x88, 0x32, 0x62, 0xb3, 0x30, 0x21,
-	0x54, 0x5f, 0xf3, 0x77, 0x53, 0xc5, 0xfd, 0xe2, 0x3c, 0x8c, 0xb2, 0x7e, 0xc0, 0xb5, 0x48, 0x16,
-	0xe3, 0x04, 0x71, 0x41, 0x32, 0x8f, 0x1b, 0x47, 0x66, 0x11, 0x14, 0xad, 0x8e, 0xcc, 0x48, 0x50,
-	0xfe, 0x70, 0x78, 0xec, 0xf7, 0x5f, 0x89, 0xa3, 0x66, 0x4b, 0xb8, 0x82, 0x2c, 0x90, 0x22, 0xfb,
-	0xb2, 0x55, 0xa7, 0xf0, 0x9b, 0x9a, 0x67, 0x42, 0x6c, 0x13, 0x9a, 0x64, 0x4e, 0x90, 0xeb, 0xbe,
-	0x48, 0xeb, 0xde, 0x36, 0xed, 0x0d, 0xb4, 0xf2, 0x66, 0x21, 0xd3, 0x09, 0xbe, 0x54, 0x08, 0x53,
-	0xf6, 0x07, 0x03, 0x19, 0x3f, 0xd0, 0x16, 0x17, 0x04, 0x35, 0x40, 0x06, 0x0b, 0x31, 0x61, 0xa2,
-	0xc0, 0x32, 0x15, 0xb0, 0x30, 0x76, 0x5d, 0x98, 0xc9, 0xc6, 0x7e, 0x30, 0xa0, 0x88, 0x1b, 0x71,
-	0x38, 0xd5, 0x18, 0xd6, 0xa1, 0x7e, 0xd3, 0xb6, 0xba, 0x42, 0xb3, 0x62, 0x61, 0x38, 0x37, 0x3a,
-	0x3d, 0xca, 0xe2, 0x9a, 0x6d, 0x90, 0x7d, 0x4c, 0x1e, 0xdc, 0x94, 0x53, 0xf0, 0xf2, 0xe2, 0xe6,
-	0x7b, 0x72, 0xcc, 0x92, 0xa8, 0xd5, 0xdf, 0x23, 0x2c, 0xe2, 0x89, 0x92, 0xa8, 0xd4, 0x09, 0x23,
-	0xfa, 0x9a, 0xa5, 0xd4, 0xc9, 0xa2, 0x64, 0x44, 0x17, 0x05, 0xdc, 0x2d, 0x68, 0x99, 0x15, 0xb0,
-	0x3a, 0xd4, 0x9e, 0x1d, 0xee, 0x1e, 0xb4, 0x67, 0x58, 0x13, 0xe6, 0x8f, 0x76, 0x9f, 0x3f, 0xdf,
-	0xdf, 0xdd, 0x69, 0x3b, 0xac, 0x05, 0x75, 0x1d, 0xb0, 0x59, 0xc1, 0xd4, 0xd6, 0xf6, 0xf6, 0xee,
-	0xe1, 0xf3, 0xdd, 0x9d, 0x76, 0xd5, 0xfd, 0xc3, 0x0a, 0x34, 0x8d, 0x9a, 0x2f, 0x31, 0xe1, 0x5c,
-	0x07, 0xa0, 0x93, 0x46, 0x16, 0xba, 0x51, 0xf3, 0x0c, 0x04, 0x25, 0xa7, 0x3e, 0x83, 0x57, 0xc5,
-	0x3d, 0x49, 0x95, 0xa6, 0xf9, 0xa2, 0x0b, 0x89, 0xa6, 0xaf, 0x62, 0xd6, 0xb3, 0x41, 0xa4, 0x25,
-	0x09, 0x50, 0xfc, 0xa0, 0xe0, 0x40, 0x13, 0xc2, 0xb5, 0x89, 0x79, 0x12, 0x0d, 0xcf, 0xb9, 0x28,
-	0x22, 0xf4, 0x35, 0x0b, 0xc3, 0xb6, 0xa4, 0x08, 0x32, 0x62, 0x7b, 0x67, 0x3d, 0x1b, 0x64, 0xdf,
-	0x54, 0x6b, 0x53, 0xa7, 0xb5, 0x59, 0x2f, 0x4e, 0xb4, 0xb9, 0x2e, 0x6e, 0x0a, 0x6c, 0x6b, 0x30,
-	0x90, 0xb9, 0xe6, 0xad, 0xcb, 0xd8, 0xbc, 0xe6, 0xab, 0x84, 0x48, 0x09, 0xa3, 0x56, 0xca, 0x19,
-	0xf5, 0xad, 0xe4, 0xec, 0x3e, 0x81, 0xe6, 0xa1, 0x71, 0x71, 0xd8, 0x45, 0x99, 0xa6, 0xae, 0x0c,
-	0x0b, 0x59, 0x28, 0x6c, 0x2f, 0x19, 0x6a, 0x74, 0xa9, 0x62, 0x76, 0xc9, 0xfd, 0x47, 0x8e, 0xb8,
-	0x8b, 0xa5, 0x87, 0x20, 0xda, 0x77, 0xa1, 0xa5, 0xad, 0xd1, 0x59, 0xf8, 0xba, 0x85, 0x61, 0x19,
-	0xea, 0x4e, 0x2f, 0x3a, 0x39, 0x49, 0xb8, 0x0a, 0x34, 0xb5, 0x30, 0xa5, 0x5c, 0xa2, 0xba, 0x1a,
-	0x88, 0x16, 0x12, 0x19, 0x70, 0x5a, 0xc0, 0x91, 0x50, 0xa4, 0xd5, 0x52, 0x85, 0xd8, 0xea, 0xb4,
-	0x8e, 0xb2, 0xcf, 0xcf, 0xf4, 0x5d, 0xa8, 0xeb, 0x7a, 0xed, 0x9d, 0x43, 0x95, 0xd4, 0xf9, 0xb8,
-	0x43, 0xd1, 0xc1, 0xd3, 0xea, 0xb4, 0xa0, 0xd7, 0x62, 0x06, 0xdb, 0x00, 0x76, 0x12, 0xc4, 0xf9,
-	0xe2, 0x82, 0x80, 0x4b, 0x72, 0xdc, 0x97, 0xb0, 0xa2, 0xf8, 0xce, 0xd0, 0x7a, 0xed, 0x85, 0x74,
-	0x2e, 0x93, 0x4b, 0x95, 0xa2, 0x5c, 0x72, 0xff, 0x55, 0x0d, 0xe6, 0xe5, 0x6a, 0x17, 0x2e, 0xa0,
-	0x8b, 0x7d, 0xcf, 0xc2, 0x58, 0xc7, 0xba, 0x66, 0x48, 0x84, 0x20, 0x77, 0xab, 0x3b, 0xf9, 0xfd,
-	0x26, 0xb3, 0xc1, 0xe5, 0xf6, 0x9c, 0x35, 0xa8, 0x8d, 0xfd, 0xf4, 0x8c, 0x4c, 0x34, 0x82, 0x96,
-	0x28, 0xad, 0x8c, 0xab, 0xb3, 0xb6, 0x71, 0xb5, 0xec, 0xda, 0xbd, 0x50, 0xbd, 0x8a, 0xd7, 0xee,
-	0xaf, 0x41, 0x43, 0xec, 0x8f, 0x99, 0xfd, 0x34, 0x03, 0x72, 0xfb, 0x69, 0xbd, 0xb0, 0x9f, 0xbe,
-	0xfb, 0x4e, 0xf7, 0x2d, 0x98, 0x13, 0x57, 0x4f, 0x64, 0x40, 0xf1, 0x35, 0xe5, 0x76, 0x14, 0xe5,
-	0xd4, 0x5f, 0x11, 0x95, 0xe4, 0xc9, 0xb2, 0xe6, 0xe5, 0xd5, 0xa6, 0x7d, 0x79, 0xd5, 0x34, 0xfb,
-	0xb6, 0x72, 0x66, 0xdf, 0xbb, 0xd0, 0xd6, 0xd3, 0x47, 0x06, 0xa3, 0x30, 0x91, 0xf1, 0xa6, 0x05,
-	0x3c, 0x13, 0xe4, 0x8b, 0x96, 0x20, 0x47, 0xc1, 0xb2, 0x95, 0xa6, 0x7c, 0x34, 0x4e, 0x95, 0x20,
-	0x7f, 0x04, 0x0b, 0x56, 0x27, 0x51, 0x7e, 0xcb, 0x30, 0xe7, 0xf6, 0x0c, 0x5b, 0x80, 0xc6, 0x93,
-	0x83, 0xde, 0xa3, 0xfd, 0x27, 0x8f, 0xf7, 0x9e, 0xb7, 0x1d, 0x4c, 0x1e, 0xbd, 0xd8, 0xde, 0xde,
-	0xdd, 0xdd, 0x21, 0x79, 0x0e, 0x30, 0xf7, 0x68, 0xeb, 0xc9, 0x3e, 0x49, 0xf3, 0xff, 0xed, 0x40,
-	0xd3, 0xa8, 0x9e, 0x7d, 0x5b, 0xcf, 0x8c, 0xb8, 0xdf, 0xf8, 0x7e, 0xb1, 0x0b, 0x1b, 0x4a, 0xce,
-	0x19, 0x53, 0xa3, 0x5f, 0x1a, 0xa8, 0x4c, 0x7d, 0x69, 0x00, 0x97, 0xc7, 0x17, 0x35, 0xe8, 0x79,
-	0x10, 0xa7, 0x81, 0x3c, 0x2c, 0x22, 0x4f, 0x32, 0xe1, 0x8c, 0x25, 0x85, 0x05, 0x2c, 0x0f, 0xbb,
-	0x9f, 0x00, 0x64, 0xbd, 0xb1, 0x87, 0x3d, 0x63, 0x0f, 0xdb, 0x31, 0x86, 0x5d, 0x71, 0x77, 0x84,
-	0xc0, 0x90, 0x53, 0xa8, 0xfd, 0x66, 0xdf, 0x04, 0xa6, 0x0c, 0x2e, 0x14, 0xe1, 0x35, 0x1e, 0xf2,
-	0x54, 0x5d, 0x3c, 0x58, 0x96, 0x39, 0x4f, 0x74, 0x86, 0xba, 0x3b, 0x93, 0xd5, 0x92, 0xc9, 0x1d,
-	0x49, 0x71, 0x79, 0xb9, 0x23, 0x8b, 0x7a, 0x3a, 0xdf, 0xed, 0x42, 0x67, 0x87, 0x63, 0x6d, 0x5b,
-	0xc3, 0x61, 0xae, 0x3b, 0x78, 0x62, 0x2e, 0xc9, 0x93, 0xc7, 0xe9, 0xef, 0xc2, 0x95, 0x2d, 0x71,
-	0xc7, 0xe0, 0xd7, 0x15, 0x82, 0xea, 0x76, 0x60, 0x2d, 0x5f, 0xa5, 0x6c, 0xec, 0x11, 0x2c, 0xef,
-	0xf0, 0xe3, 0xc9, 0xe9, 0x3e, 0x3f, 0xcf, 0x1a, 0x62, 0x50, 0x4b, 0xce, 0xa2, 0xd7, 0x72, 0x7e,
-	0xe8, 0x37, 0x7b, 0x1f, 0x60, 0x88, 0x65, 0x7a, 0xc9, 0x98, 0xf7, 0xd5, 0xfd, 0x51, 0x42, 0x8e,
-	0xc6, 0xbc, 0xef, 0x7e, 0x02, 0xcc, 0xac, 0x47, 0xce, 0x17, 0x2a, 0xb1, 0x93, 0xe3, 0x5e, 0x72,
-	0x91, 0xa4, 0x7c, 0xa4, 0x2e, 0xc6, 0x9a, 0x90, 0x7b, 0x1b, 0x5a, 0x87, 0xfe, 0x85, 0xc7, 0x7f,
-	0x22, 0x5f, 0xb6, 0x58, 0x87, 0xf9, 0xb1, 0x7f, 0x81, 0xfc, 0xac, 0xad, 0xf0, 0x94, 0xed, 0xfe,
-	0xaf, 0x0a, 0xcc, 0x89, 0x92, 0x58, 0xeb, 0x80, 0x27, 0x69, 0x10, 0x12, 0x8f, 0xa9, 0x5a, 0x0d,
-	0xa8, 0x20, 0x30, 0x2b, 0x25, 0x02, 0x53, 0x9a, 0x86, 0xd4, 0x3d, 0x3c, 0x49, 0xb2, 0x16, 0x86,
-	0x62, 0x2b, 0x8b, 0x25, 0x17, 0x94, 0x9a, 0x01, 0x39, 0xef, 0x52, 0xa6, 0x2a, 0x8b, 0xfe, 0xa9,
-	0xbd, 0x40, 0xca, 0x44, 0x13, 0x2a, 0x55, 0xc8, 0xe7, 0x85, 0xe8, 0x2c, 0x28, 0xe4, 0x05, 0xc5,
-	0xbb, 0xfe, 0x0e, 0x8a, 0xb7, 0xb0, 0x17, 0xbd, 0x4d, 0xf1, 0x86, 0x77, 0x50, 0xbc, 0x5d, 0x06,
-	0x6d, 0xba, 0xe4, 0x8f, 0x47, 0x3f, 0x45, 0xbb, 0x7f, 0xcf, 0x81, 0xb6, 0xa4, 0x22, 0x9d, 0xa7,
-	0xfc, 0x94, 0x6f, 0xbb, 0x0d, 0x76, 0x13, 0x16, 0xe8, 0xe0, 0xa9, 0xe5, 0xa9, 0xf4, 0xf9, 0x59,
-	0x20, 0x8e, 0x43, 0x45, 0x21, 0x8d, 0x82, 0xa1, 0x5c, 0x14, 0x13, 0x52, 0x22, 0x39, 0xf6, 0x65,
-	0x4c, 0xb4, 0xe3, 0xe9, 0xb4, 0xfb, 0x27, 0x0e, 0x2c, 0x1b, 0x1d, 0x96, 0x54, 0xf8, 0x00, 0x5a,
-	0xfa, 0x2d, 0x0d, 0xae, 0x35, 0x86, 0x75, 0x9b, 0x6d, 0xb2, 0xcf, 0xac, 0xc2, 0xb4, 0x98, 0xfe,
-	0x05, 0x75, 0x30, 0x99, 0x8c, 0xe4, 0x56, 0x6d, 0x42, 0x48, 0x48, 0xaf, 0x39, 0x7f, 0xa5, 0x8b,
-	0x08, 0x65, 0xc1, 0xc2, 0xc8, 0x60, 0x8f, 0x07, 0x66, 0x5d, 0xa8, 0x26, 0x0d, 0xf6, 0x26, 0xe8,
-	0xfe, 0xe5, 0x0a, 0xac, 0x08, 0x0b, 0x88, 0xb4, 0x3c, 0xe9, 0xeb, 0xcc, 0x73, 0xc2, 0x18, 0x24,
-	0x38, 0x72, 0x6f, 0xc6, 0x93, 0x69, 0xf6, 0xed, 0x77, 0xb4, 0xda, 0xe8, 0x40, 0xed, 0x29, 0x6b,
-	0x51, 0x2d, 0x5b, 0x8b, 0xb7, 0xcc, 0x74, 0x99, 0xef, 0x64, 0xb6, 0xdc, 0x77, 0xf2, 0x4e, 0xbe,
-	0x8a, 0x87, 0xf3, 0x30, 0x9b, 0xf4, 0xa3, 0x31, 0x77, 0xd7, 0x60, 0xd5, 0x9e, 0x02, 0x29, 0xa8,
-	0x7e, 0xee, 0x40, 0xe7, 0x91, 0xf0, 0xc8, 0x06, 0xe1, 0xe9, 0x5e, 0x90, 0xa4, 0x51, 0xac, 0xdf,
-	0x86, 0xb8, 0x0e, 0x90, 0xa4, 0x7e, 0x2c, 0x4f, 0x0a, 0x42, 0xdf, 0x32, 0x10, 0x1c, 0x09, 0x0f,
-	0x07, 0x22, 0x57, 0xac, 0xa0, 0x4e, 0x17, 0xf4, 0x59, 0x69, 0xc5, 0xb1, 0xb4, 0xc2, 0x5b, 0xe2,
-	0x7a, 0x03, 0x76, 0x99, 0x9f, 0x93, 0xf4, 0x17, 0xa6, 0x91, 0x1c, 0xea, 0xfe, 0x7e, 0x05, 0x96,
-	0xb2, 0x4e, 0x52, 0x08, 0x8e, 0x2d, 0x43, 0xa4, 0x2a, 0x98, 0xc9, 0x10, 0xe9, 0x71, 0xe9, 0x05,
-	0xa8, 0x1b, 0x1a, 0x86, 0x1c, 0x03, 0x65, 0x37, 0xa1, 0xa9, 0x52, 0xd1, 0x24, 0x35, 0x2e, 0x69,
-	0x9b, 0xb0, 0x08, 0x58, 0x46, 0xed, 0x54, 0x6a, 0xda, 0x32, 0x45, 0x17, 0xc6, 0x46, 0x29, 0x7d,
-	0x29, 0x66, 0x5e, 0x25, 0x59, 0x5b, 0xa8, 0x75, 0xe2, 0xbd, 0x1c, 0x52, 0xe9, 0x4c, 0x75, 0xa7,
-	0xae, 0x1f, 0xb7, 0xd1, 0x9c, 0x29, 0x6a, 0xcc, 0xa2, 0xcd, 0x6b, 0x9e, 0x09, 0xa9, 0xa3, 0x72,
-	0x34, 0x31, 0xdc, 0xcc, 0x35, 0xcf, 0xc2, 0xdc, 0xbf, 0xe9, 0xc0, 0xd5, 0x92, 0x65, 0x94, 0x9c,
-	0xba, 0x03, 0xcb, 0x27, 0x3a, 0x53, 0x4d, 0xb5, 0x60, 0xd7, 0x35, 0x15, 0x76, 0x62, 0x4f, 0xaf,
-	0x57, 0xfc, 0x40, 0x6b, 0xfc, 0x62, 0xf1, 0xac, 0xcb, 0x05, 0xc5, 0x0c, 0xf7, 0x10, 0xba, 0xbb,
-	0x6f, 0x90, 0xf1, 0xb7, 0xcd, 0x27, 0x05, 0x15, 0x65, 0x6d, 0x16, 0x04, 0xdb, 0xe5, 0xf6, 0xbb,
-	0x13, 0x58, 0xb0, 0xea, 0x62, 0xbf, 0xf9, 0xae, 0x95, 0x98, 0x3c, 0x7a, 0x43, 0xae, 0xba, 0x78,
-	0x13, 0x51, 0x5d, 0x71, 0x30, 0x20, 0xf7, 0x1c, 0x96, 0x9e, 0x4e, 0x86, 0x69, 0x90, 0xbd, 0x8f,
-	0xc8, 0xbe, 0x2d, 0x3f, 0xa2, 0x2a, 0xd4, 0xd4, 0x95, 0x36, 0x65, 0x96, 0xc3, 0x19, 0x1b, 0x61,
-	0x4d, 0xbd, 0x62, 0x8b, 0xc5, 0x0c, 0xf7, 0x2a, 0xac, 0x67, 0x4d, 0x8a, 0xb9, 0x53, 0x9b, 0xc3,
-	0x1f, 0x38, 0x22, 0x4e, 0xcf, 0x7e, 0xae, 0x91, 0x3d, 0x86, 0x95, 0x24, 0x08, 0x4f, 0x87, 0xdc,
-	0xac, 0x27, 0x91, 0x33, 0x71, 0xc5, 0xee, 0x9e, 0x7c, 0xd2, 0xd1, 0x2b, 0xfb, 0x02, 0x09, 0xa4,
-	0xbc, 0xa3, 0x19, 0x81, 0xe4, 0xa6, 0xa4, 0x6c, 0x00, 0xdf, 0x81, 0x45, 0xbb, 0x31, 0xf6, 0xa9,
-	0xbc, 0x99, 0x90, 0xf5, 0xcc, 0x74, 0xb8, 0xd9, 0x94, 0x61, 0x95, 0x74, 0x7f, 0xe6, 0x40, 0xc7,
-	0xe3, 0x48, 0xc6, 0xdc, 0x68, 0x54, 0x52, 0xcf, 0x83, 0x42, 0xb5, 0xd3, 0x07, 0xac, 0x6f, 0x3c,
-	0xa8, 0xb1, 0x6e, 0x4c, 0x5d, 0x94, 0xbd, 0x99, 0x92, 0x51, 0x3d, 0xac, 0xc3, 0x9c, 0x1c, 0xdf,
-	0x3a, 0x5c, 0x91, 0x5d, 0x52, 0xdd, 0xc9, 0x3c, 0x35, 0x56, 0xa3, 0x96, 0xa7, 0xa6, 0x0b, 0x1d,
-	0xf1, 0x04, 0x88, 0x39, 0x0e, 0xf9, 0xe1, 0x0e, 0xb0, 0xa7, 0x7e, 0xdf, 0x8f, 0xa3, 0x28, 0x3c,
-	0xe4, 0xb1, 0x0c, 0xac, 0x22, 0x05, 0x88, 0x1c, 0x19, 0x4a, 0x57, 0x13, 0x29, 0xf5, 0x6a, 0x45,
-	0x14, 0xaa, 0xd7, 0x41, 0x44, 0xca, 0xf5, 0x60, 0xe5, 0xa1, 0xff, 0x8a, 0xab, 0x9a, 0xb2, 0x59,
-	0x6a, 0x8e, 0x75, 0xa5, 0x6a, 0xee, 0xd5, 0xa5, 0xa3, 0x62, 0xb3, 0x9e, 0x59, 0xda, 0xdd, 0x84,
-	0x55, 0xbb, 0x4e, 0x29, 0x4a, 0xba, 0x50, 0x1f, 0x49, 0x4c, 0xf6, 0x4e, 0xa7, 0xef, 0x7e, 0x09,
-	0x4d, 0xe3, 0x59, 0x17, 0xb6, 0x0e, 0x2b, 0x2f, 0x9f, 0x3c, 0x3f, 0xd8, 0x3d, 0x3a, 0xea, 0x1d,
-	0xbe, 0x78, 0xf8, 0xf9, 0xee, 0xf7, 0x7b, 0x7b, 0x5b, 0x47, 0x7b, 0xed, 0x19, 0xb6, 0x06, 0xec,
-	0x60, 0xf7, 0xe8, 0xf9, 0xee, 0x8e, 0x85, 0x3b, 0xec, 0x3a, 0x74, 0x5f, 0x1c, 0xbc, 0x38, 0xda,
-	0xdd, 0xe9, 0x95, 0x7d, 0x57, 0x61, 0xef, 0xc3, 0x55, 0x99, 0x5f, 0xf2, 0x79, 0xf5, 0xee, 0x03,
-	0x68, 0xe7, 0x0d, 0x4b, 0x96, 0x29, 0xee, 0x6d, 0x36, 0xbb, 0xcd, 0x9f, 0x55, 0x61, 0x51, 0x44,
-	0x4e, 0x8a, 0x07, 0x4f, 0x79, 0xcc, 0x9e, 0xc2, 0xbc, 0x7c, 0x39, 0x97, 0x29, 0xd2, 0xb2, 0xdf,
-	0xea, 0xed, 0xae, 0xe5, 0x61, 0xb9, 0xac, 0x2b, 0x7f, 0xe5, 0x3f, 0xfe, 0xf7, 0xbf, 0x5d, 0x59,
-	0x60, 0xcd, 0x7b, 0xe7, 0x1f, 0xdf, 0x3b, 0xe5, 0x61, 0x82, 0x75, 0xfc, 0x2e, 0x40, 0xf6, 0x1e,
-	0x2c, 0xeb, 0x68, 0xc3, 0x4a, 0xee, 0xb1, 0xdc, 0xee, 0xd5, 0x92, 0x1c, 0x59, 0xef, 0x55, 0xaa,
-	0x77, 0xc5, 0x5d, 0xc4, 0x7a, 0x83, 0x30, 0x48, 0xc5, 0xdb, 0xb0, 0x9f, 0x39, 0x77, 0xd9, 0x00,
-	0x5a, 0xe6, 0x4b, 0xad, 0x4c, 0x39, 0xde, 0x4a, 0xde, 0x9a, 0xed, 0xbe, 0x57, 0x9a, 0xa7, 0x68,
-	0x99, 0xda, 0xb8, 0xe2, 0xb6, 0xb1, 0x8d, 0x09, 0x95, 0xc8, 0x5a, 0x19, 0x0a, 0x0e, 0xcf, 0x1e,
-	0x64, 0x65, 0xd7, 0x0c, 0xa6, 0x2b, 0x3c, 0x07, 0xdb, 0x7d, 0x7f, 0x4a, 0xae, 0x6c, 0xeb, 0x7d,
-	0x6a, 0x6b, 0xdd, 0x65, 0xd8, 0x56, 0x9f, 0xca, 0xa8, 0xe7, 0x60, 0x3f, 0x73, 0xee, 0x6e, 0xfe,
-	0xbb, 0xdb, 0xd0, 0xd0, 0x4e, 0x79, 0xf6, 0x63, 0x58, 0xb0, 0x42, 0x5b, 0x99, 0x1a, 0x46, 0x59,
-	0x24, 0x6c, 0xf7, 0x5a, 0x79, 0xa6, 0x6c, 0xf8, 0x3a, 0x35, 0xdc, 0x61, 0x6b, 0xd8, 0xb0, 0x8c,
-	0x0d, 0xbd, 0x47, 0x41, 0xda, 0xe2, 0x9e, 0xe7, 0x2b, 0x43, 0x92, 0x89, 0xc6, 0xae, 0xe5, 0x85,
-	0x8b, 0xd5, 0xda, 0xfb, 0x53, 0x72, 0x65, 0x73, 0xd7, 0xa8, 0xb9, 0x35, 0xb6, 0x6a, 0x36, 0xa7,
-	0x1d, 0xe5, 0x9c, 0x2e, 0x37, 0x9b, 0x6f, 0x95, 0xb2, 0xf7, 0x35, 0x61, 0x95, 0xbd, 0x61, 0xaa,
-	0x49, 0xa4, 0xf8, 0x90, 0xa9, 0xdb, 0xa1, 0xa6, 0x18, 0xa3, 0xe5, 0x33, 0x9f, 0x2a, 0x65, 0xc7,
-	0xd0, 0x34, 0x5e, 0x18, 0x63, 0x57, 0xa7, 0xbe, 0x86, 0xd6, 0xed, 0x96, 0x65, 0x95, 0x0d, 0xc5,
-	0xac, 0xff, 0x1e, 0x2a, 0x3a, 0x3f, 0x84, 0x86, 0x7e, 0xb3, 0x8a, 0xad, 0x1b, 0x6f, 0x88, 0x99,
-	0x6f, 0x6c, 0x75, 0x3b, 0xc5, 0x8c, 0x32, 0xe2, 0x33, 0x6b, 0x47, 0xe2, 0x7b, 0x09, 0x4d, 0xe3,
-	0x5d, 0x2a, 0x3d, 0x80, 0xe2, 0xdb, 0x57, 0x7a, 0x00, 0x25, 0xcf, 0x58, 0xb9, 0xcb, 0xd4, 0x44,
-	0x93, 0x35, 0x88, 0xbe, 0xd3, 0x37, 0x51, 0xc2, 0xf6, 0xe1, 0x8a, 0x94, 0xd8, 0xc7, 0xfc, 0xab,
-	0x2c, 0x43, 0xc9, 0xf3, 0xb0, 0xf7, 0x1d, 0xf6, 0x00, 0xea, 0xea, 0xf9, 0x31, 0xb6, 0x56, 0xfe,
-	0x8c, 0x5a, 0x77, 0xbd, 0x80, 0x4b, 0xf1, 0xfa, 0x7d, 0x80, 0xec, 0x11, 0x2c, 0x2d, 0x24, 0x0a,
-	0x8f, 0x6a, 0x69, 0x0a, 0x28, 0xbe, 0x98, 0xe5, 0xae, 0xd1, 0x00, 0xdb, 0x8c, 0x84, 0x44, 0xc8,
-	0x5f, 0xab, 0x77, 0x0c, 0x7e, 0x04, 0x4d, 0xe3, 0x1d, 0x2c, 0x3d, 0x7d, 0xc5, 0x37, 0xb4, 0xf4,
-	0xf4, 0x95, 0x3c, 0x9b, 0xe5, 0x76, 0xa9, 0xf6, 0x55, 0x77, 0x09, 0x6b, 0x4f, 0x82, 0xd3, 0x70,
-	0x24, 0x0a, 0xe0, 0x02, 0x9d, 0xc1, 0x82, 0xf5, 0xd8, 0x95, 0xe6, 0xd0, 0xb2, 0xa7, 0xb4, 0x34,
-	0x87, 0x96, 0xbe, 0x8f, 0xa5, 0xe8, 0xcc, 0x5d, 0xc6, 0x76, 0xce, 0xa9, 0x88, 0xd1, 0xd2, 0x0f,
-	0xa0, 0x69, 0x3c, 0x5c, 0xa5, 0xc7, 0x52, 0x7c, 0x23, 0x4b, 0x8f, 0xa5, 0xec, 0x9d, 0xab, 0x55,
-	0x6a, 0x63, 0xd1, 0x25, 0x52, 0xa0, 0x1b, 0xf9, 0x58, 0xf7, 0x8f, 0x61, 0xd1, 0x7e, 0xca, 0x4a,
-	0xf3, 0x7e, 0xe9, 0xa3, 0x58, 0x9a, 0xf7, 0xa7, 0xbc, 0x7f, 0x25, 0x49, 0xfa, 0xee, 0x8a, 0x6e,
-	0xe4, 0xde, 0x17, 0x32, 0xac, 0xef, 0x4b, 0xf6, 0x5d, 0x14, 0x70, 0xf2, 0x89, 0x04, 0xb6, 0x6e,
-	0x50, 0xad, 0xf9, 0x90, 0x82, 0xe6, 0x97, 0xc2, 0x6b, 0x0a, 0x36, 0x31, 0x8b, 0x37, 0x05, 0x68,
-	0xd7, 0xa2, 0xa7, 0x12, 0x8c, 0x5d, 0xcb, 0x7c, 0x4d, 0xc1, 0xd8, 0xb5, 0xac, 0x17, 0x15, 0xf2,
-	0xbb, 0x56, 0x1a, 0x60, 0x1d, 0x21, 0x2c, 0xe5, 0xae, 0xdf, 0x68, 0xae, 0x28, 0xbf, 0x21, 0xd9,
-	0xbd, 0xfe, 0xf6, 0x5b, 0x3b, 0xb6, 0x04, 0x51, 0x42, 0xf0, 0x9e, 0xba, 0x8f, 0xfa, 0x7b, 0xd0,
-	0x32, 0x9f, 0xd7, 0x61, 0x26, 0x2b, 0xe7, 0x5b, 0x7a, 0xaf, 0x34, 0xcf, 0x5e, 0x5c, 0xd6, 0x32,
-	0x9b, 0x61, 0xdf, 0x83, 0x35, 0xcd, 0xea, 0xe6, 0x8d, 0x8e, 0x84, 0x7d, 0x50, 0x72, 0xcf, 0xc3,
-	0xd4, 0xe3, 0xba, 0x57, 0xa7, 0x5e, 0x04, 0xb9, 0xef, 0x20, 0xd1, 0xd8, 0x6f, 0x96, 0x64, 0x1b,
-	0x46, 0xd9, 0x53, 0x2d, 0xd9, 0x86, 0x51, 0xfa, 0xd0, 0x89, 0x22, 0x1a, 0xb6, 0x62, 0xcd, 0x91,
-	0x88, 0x80, 0x60, 0x3f, 0x80, 0x25, 0xe3, 0xce, 0xdc, 0xd1, 0x45, 0xd8, 0xd7, 0x0c, 0x50, 0xbc,
-	0xd2, 0xdd, 0x2d, 0x3b, 0xa5, 0xb8, 0xeb, 0x54, 0xff, 0xb2, 0x6b, 0x4d, 0x0e, 0x12, 0xff, 0x36,
-	0x34, 0xcd, 0xfb, 0x78, 0x6f, 0xa9, 0x77, 0xdd, 0xc8, 0x32, 0x6f, 0x23, 0xdf, 0x77, 0xd8, 0xa1,
-	0x88, 0x84, 0xd3, 0xef, 0xa7, 0x46, 0x71, 0x7e, 0xfb, 0xb4, 0xdf, 0x55, 0xd5, 0x0b, 0x59, 0xf6,
-	0xa2, 0xee, 0x1d, 0xe7, 0xbe, 0xc3, 0xfe, 0xbe, 0x03, 0x2d, 0xeb, 0xbe, 0x9c, 0x15, 0x57, 0x94,
-	0xeb, 0x59, 0xc7, 0xcc, 0x33, 0xbb, 0xe6, 0x7a, 0x34, 0xec, 0xfd, 0xbb, 0xdf, 0xb1, 0xa6, 0xf5,
-	0x0b, 0xcb, 0xa0, 0xb6, 0x91, 0x7f, 0x44, 0xf5, 0xcb, 0x7c, 0x01, 0xf3, 0x22, 0xfd, 0x97, 0xf7,
-	0x1d, 0xf6, 0x47, 0x0e, 0x2c, 0xda, 0x66, 0x60, 0x3d, 0xdc, 0x52, 0x83, 0xb3, 0x5e, 0xfc, 0x29,
-	0xb6, 0xe3, 0x1f, 0x50, 0x2f, 0x9f, 0xdf, 0xf5, 0xac, 0x5e, 0xca, 0xf7, 0x71, 0x7e, 0xb5, 0xde,
-	0xb2, 0xcf, 0xc4, 0x23, 0xe2, 0xca, 0x03, 0xc6, 0x8a, 0xef, 0x4f, 0x6b, 0x82, 0x31, 0x5f, 0x8c,
-	0xa6, 0x45, 0xf8, 0x91, 0x78, 0x3c, 0x54, 0xb9, 0x51, 0x90, 0xee, 0xde, 0xf5, 0x7b, 0xf7, 0x26,
-	0x8d, 0xe9, 0xba, 0x7b, 0xd5, 0x1a, 0x53, 0x7e, 0x87, 0xdf, 0x12, 0xbd, 0x93, 0x8f, 0x3d, 0x67,
-	0x5b, 0x54, 0xe1, 0x01, 0xe8, 0xe9, 0x9d, 0x1c, 0x89, 0x4e, 0xca, 0xe2, 0x16, 0x73, 0xbc, 0x63,
-	0x35, 0xee, 0x5d, 0xea, 0xeb, 0x4d, 0xf7, 0x83, 0xa9, 0x7d, 0xbd, 0x47, 0xc6, 0x5c, 0xec, 0xf1,
-	0x21, 0x40, 0xe6, 0xb1, 0x66, 0x39, 0x6f, 0xa9, 0x16, 0x19, 0x45, 0xa7, 0xb6, 0xcd, 0x81, 0xca,
-	0xa9, 0x8a, 0x35, 0xfe, 0x50, 0x08, 0xc0, 0x27, 0xca, 0xcf, 0x6a, 0xaa, 0x39, 0xb6, 0x5b, 0xd9,
-	0x52, 0x73, 0xf2, 0xf5, 0x5b, 0xe2, 0x4f, 0x3b, 0x6d, 0x5f, 0xc0, 0xc2, 0x7e, 0x14, 0xbd, 0x9a,
-	0x8c, 0x75, 0xc8, 0x8f, 0xed, 0x67, 0xd9, 0xf3, 0x93, 0xb3, 0x6e, 0x6e, 0x14, 0xee, 0x0d, 0xaa,
-	0xaa, 0xcb, 0x3a, 0x46, 0x55, 0xf7, 0xbe, 0xc8, 0xbc, 0xe1, 0x5f, 0x32, 0x1f, 0x96, 0xb5, 0x54,
-	0xd5, 0x1d, 0xef, 0xda, 0xd5, 0x58, 0xb2, 0x34, 0xdf, 0x84, 0xa5, 0x8f, 0xab, 0xde, 0xde, 0x4b,
-	0x54, 0x9d, 0x24, 0x53, 0x5a, 0x3b, 0xbc, 0x4f, 0x57, 0x7e, 0xc8, 0x59, 0xb1, 0x92, 0x75, 0x5c,
-	0x7b, 0x39, 0xba, 0x0b, 0x16, 0x68, 0xef, 0x34, 0x63, 0xff, 0x22, 0xe6, 0x3f, 0xb9, 0xf7, 0x85,
-	0x74, 0x83, 0x7c, 0xa9, 0x76, 0x1a, 0xe5, 0x27, 0xb2, 0x76, 0x9a, 0x9c, 0x63, 0xc9, 0xda, 0x69,
-	0x0a, 0x8e, 0x25, 0x6b, 0xaa, 0x95, 0x9f, 0x8a, 0x0d, 0x61, 0xb9, 0xe0, 0x8b, 0xd2, 0x9b, 0xcc,
-	0x34, 0x0f, 0x56, 0xf7, 0xc6, 0xf4, 0x02, 0x76, 0x6b, 0x77, 0xed, 0xd6, 0x8e, 0x60, 0x61, 0x87,
-	0x8b, 0xc9, 0x12, 0x31, 0xce, 0xb9, 0x4b, 0x97, 0x66, 0x04, 0x75, 0x7e, 0x4b, 0xa0, 0x3c, 0x5b,
-	0x95, 0xa0, 0xe0, 0x62, 0xf6, 0x43, 0x68, 0x3e, 0xe6, 0xa9, 0x0a, 0x6a, 0xd6, 0xca, 0x6c, 0x2e,
-	0xca, 0xb9, 0x5b, 0x12, 0x13, 0x6d, 0xd3, 0x0c, 0xd5, 0x76, 0x8f, 0x0f, 0x4e, 0xb9, 0x10, 0x4e,
-	0xbd, 0x60, 0xf0, 0x25, 0xfb, 0x0b, 0x54, 0xb9, 0xbe, 0xd5, 0xb1, 0x66, 0x44, 0xa8, 0x9a, 0x95,
-	0x2f, 0xe5, 0xf0, 0xb2, 0x9a, 0xc3, 0x68, 0xc0, 0x0d, 0xa5, 0x2a, 0x84, 0xa6, 0x71, 0x19, 0x4b,
-	0x33, 0x50, 0xf1, 0xda, 0x9f, 0x66, 0xa0, 0x92, 0xbb, 0x5b, 0xee, 0x1d, 0x6a, 0xc7, 0x65, 0x37,
-	0xb2, 0x76, 0xc4, 0x7d, 0xad, 0xac, 0xa5, 0x7b, 0x5f, 0xf8, 0xa3, 0xf4, 0x4b, 0xf6, 0x92, 0xde,
-	0xab, 0x32, 0x83, 0xb6, 0x33, 0xed, 0x3c, 0x1f, 0xdf, 0xad, 0x27, 0xcb, 0xc8, 0xb2, 0x35, 0x76,
-	0xd1, 0x14, 0xe9, 0x5e, 0xdf, 0x06, 0x38, 0x4a, 0xa3, 0xf1, 0x8e, 0xcf, 0x47, 0x51, 0x98, 0xc9,
-	0xda, 0x2c, 0x64, 0x38, 0x93, 0x5f, 0x46, 0xdc, 0x30, 0x7b, 0x69, 0x1c, 0x67, 0xac, 0xb8, 0x77,
-	0x45, 0x5c, 0x53, 0xa3, 0x8a, 0xf5, 0x84, 0x94, 0x44, 0x16, 0xdf, 0x77, 0xd8, 0x16, 0x40, 0xe6,
-	0x8c, 0xd4, 0x87, 0x93, 0x82, 0x9f, 0x53, 0x8b, 0xbd, 0x12, 0xcf, 0xe5, 0x21, 0x34, 0x32, 0xef,
-	0xd6, 0x7a, 0x76, 0xe5, 0xd1, 0xf2, 0x85, 0xe9, 0x1d, 0xbc, 0xe0, 0x73, 0x72, 0xdb, 0x34, 0x55,
-	0xc0, 0xea, 0x38, 0x55, 0xe4, 0x48, 0x0a, 0x60, 0x45, 0x74, 0x50, 0x2b, 0x38, 0x14, 0xea, 0xaa,
-	0x46, 0x52, 0xe2, 0xf7, 0xd1, 0xdc, 0x5c, 0xea, 0x10, 0xb1, 0x6c, 0x2c, 0x48, 0xad, 0x22, 0xcc,
-	0x16, 0x45, 0xf3, 0x08, 0x96, 0x0b, 0x36, 0x76, 0xcd, 0xd2, 0xd3, 0x9c, 0x28, 0x9a, 0xa5, 0xa7,
-	0x9a, 0xe7, 0xdd, 0x2b, 0xd4, 0xe4, 0x92, 0x0b, 0x74, 0xa6, 0x7a, 0x1d, 0xa4, 0xfd, 0x33, 0x6c,
-	0xee, 0x0f, 0x1c, 0x58, 0x29, 0x31, 0xa1, 0xb3, 0x0f, 0xd5, 0xf1, 0x7c, 0xaa, 0x79, 0xbd, 0x5b,
-	0x6a, 0x61, 0x75, 0x8f, 0xa8, 0x9d, 0xa7, 0xec, 0x73, 0x6b, 0x63, 0x13, 0xc6, 0x4d, 0xc9, 0x99,
-	0x6f, 0x55, 0x2a, 0x4a, 0x35, 0x8a, 0x9f, 0xc0, 0xba, 0xe8, 0xc8, 0xd6, 0x70, 0x98, 0xb3, 0xfe,
-	0x5e, 0x2f, 0xfc, 0xa3, 0x21, 0xcb, 0xaa, 0xdd, 0x9d, 0xfe, 0x8f, 0x88, 0xa6, 0x28, 0xc0, 0xa2,
-	0xab, 0x6c, 0x02, 0xed, 0xbc, 0x45, 0x95, 0x4d, 0xaf, 0xab, 0xfb, 0x81, 0x75, 0xd0, 0x2c, 0xb1,
-	0xc2, 0xfe, 0x06, 0x35, 0xf6, 0x81, 0xdb, 0x2d, 0x9b, 0x17, 0x71, 0xf6, 0xc4, 0xf5, 0xf8, 0x4b,
-	0xda, 0xfc, 0x9b, 0x1b, 0xa7, 0x6a, 0x60, 0x9a, 0xbd, 0x5a, 0x1f, 0x75, 0xcb, 0xad, 0xc7, 0xb7,
-	0xa8, 0xf9, 0x1b, 0xee, 0x7b, 0x65, 0xcd, 0xc7, 0xe2, 0x13, 0x71, 0xe8, 0x5d, 0xcf, 0xf3, 0xb5,
-	0xea, 0xc1, 0x8d, 0xb2, 0xf5, 0x9e, 0x7a, 0x7a, 0xc9, 0xcd, 0xf5, 0x0c, 0xe9, 0x76, 0x2d, 0xd3,
-	0xdc, 0xab, 0xd9, 0xa7, 0xc4, 0xae, 0xac, 0xd9, 0xa7, 0xcc, 0x3e, 0x6c, 0xeb, 0x35, 0xca, 0x32,
-	0xfc, 0x99, 0x73, 0xf7, 0xe1, 0xed, 0x1f, 0xfc, 0xc6, 0x69, 0x90, 0x9e, 0x4d, 0x8e, 0x37, 0xfa,
-	0xd1, 0xe8, 0xde, 0x50, 0x99, 0xf5, 0xe4, 0xf5, 0x8f, 0x7b, 0xc3, 0x70, 0x70, 0x8f, 0xaa, 0x3d,
-	0x9e, 0xa3, 0xff, 0x8c, 0xf6, 0x9b, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x2a, 0xd4, 0x19,
-	0x4b, 0x6d, 0x00, 0x00,
+	// 8733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7d, 0x5d, 0x6c, 0x24, 0x4b,
+	0x96, 0x96, 0xb3, 0xaa, 0x6c, 0x57, 0x9d, 0x2a, 0x97, 0xcb, 0xe1, 0x6e, 0xbb, 0x3a, 0xfb, 0xe7,
+	0xfa, 0xe6, 0xf4, 0xde, 0xee, 0xed, 0xb9, 0xe3, 0xee, 0xeb, 0x99, 0xb9, 0xdc, 0xbd, 0xcd, 0xb2,
+	0xb8, 0x6d, 0x77, 0xbb, 0x67, 0xdc, 0x6e, 0x6f, 0xba, 0x7b, 0x9a, 0x99, 0xd9, 0x55, 0x4d, 0xba,
+	0x2a, 0x6c, 0xe7, 0x74, 0x55, 0x66, 0x4d, 0x66, 0x96, 0xdd, 0x9e, 0xcb, 0x45, 0x02, 0x21, 0x84,
+	0x78, 0x41, 0x03, 0x42, 0x02, 0x04, 0x5a, 0x69, 0x16, 0x89, 0x5d, 0xf1, 0x00, 0x2f, 0x48, 0x0b,
+	0x5a, 0x9e, 0x10, 0x42, 0x42, 0x42, 0x3c, 0xf0, 0x80, 0xc4, 0x03, 0x2b, 0x04, 0x12, 0x5a, 0xf1,
+	0x86, 0xe0, 0x1d, 0x9d, 0x13, 0x11, 0x99, 0x11, 0x99, 0x59, 0x76, 0xdf, 0x99, 0xdd, 0x7d, 0x72,
+	0xc5, 0x77, 0x22, 0xe3, 0xf7, 0xc4, 0x89, 0x13, 0xe7, 0x9c, 0x08, 0x43, 0x23, 0x1a, 0xf7, 0xd7,
+	0xc7, 0x51, 0x98, 0x84, 0x6c, 0x76, 0x18, 0x44, 0xe3, 0xbe, 0x7d, 0xeb, 0x24, 0x0c, 0x4f, 0x86,
+	0xfc, 0xa1, 0x37, 0xf6, 0x1f, 0x7a, 0x41, 0x10, 0x26, 0x5e, 0xe2, 0x87, 0x41, 0x2c, 0x32, 0x39,
+	0x3f, 0x82, 0xf6, 0x33, 0x1e, 0x1c, 0x72, 0x3e, 0x70, 0xf9, 0x4f, 0x26, 0x3c, 0x4e, 0xd8, 0xd7,
+	0x61, 0xc9, 0xe3, 0x3f, 0xe5, 0x7c, 0xd0, 0x1b, 0x7b, 0x71, 0x3c, 0x3e, 0x8d, 0xbc, 0x98, 0x77,
+	0xad, 0x35, 0xeb, 0x7e, 0xcb, 0xed, 0x08, 0xc2, 0x41, 0x8a, 0xb3, 0x0f, 0xa1, 0x15, 0x63, 0x56,
+	0x1e, 0x24, 0x51, 0x38, 0xbe, 0xe8, 0x56, 0x28, 0x5f, 0x13, 0xb1, 0x1d, 0x01, 0x39, 0x43, 0x58,
+	0x4c, 0x6b, 0x88, 0xc7, 0x61, 0x10, 0x73, 0xf6, 0x08, 0xae, 0xf5, 0xfd, 0xf1, 0x29, 0x8f, 0x7a,
+	0xf4, 0xf1, 0x28, 0xe0, 0xa3, 0x30, 0xf0, 0xfb, 0x5d, 0x6b, 0xad, 0x7a, 0xbf, 0xe1, 0x32, 0x41,
+	0xc3, 0x2f, 0x5e, 0x48, 0x0a, 0xbb, 0x07, 0x8b, 0x3c, 0x10, 0x38, 0x1f, 0xd0, 0x57, 0xb2, 0xaa,
+	0x76, 0x06, 0xe3, 0x07, 0xce, 0xdf, 0xac, 0xc0, 0xd2, 0xf3, 0xc0, 0x4f, 0xde, 0x78, 0xc3, 0x21,
+	0x4f, 0x54, 0x9f, 0xee, 0xc1, 0xe2, 0x39, 0x01, 0xd4, 0xa7, 0xf3, 0x30, 0x1a, 0xc8, 0x1e, 0xb5,
+	0x05, 0x7c, 0x20, 0xd1, 0xa9, 0x2d, 0xab, 0x4c, 0x6d, 0x59, 0xe9, 0x70, 0x55, 0xa7, 0x0c, 0xd7,
+	0x3d, 0x58, 0x8c, 0x78, 0x3f, 0x3c, 0xe3, 0xd1, 0x45, 0xef, 0xdc, 0x0f, 0x06, 0xe1, 0x79, 0xb7,
+	0xb6, 0x66, 0xdd, 0x9f, 0x75, 0xdb, 0x0a, 0x7e, 0x43, 0x28, 0x7b, 0x02, 0x8b, 0xfd, 0x53, 0x2f,
+	0x08, 0xf8, 0xb0, 0x77, 0xe4, 0xf5, 0xdf, 0x4e, 0xc6, 0x71, 0x77, 0x76, 0xcd, 0xba, 0xdf, 0xdc,
+	0xb8, 0xb1, 0x4e, 0xb3, 0xba, 0xbe, 0x75, 0xea, 0x05, 0x4f, 0x88, 0x72, 0x18, 0x78, 0xe3, 0xf8,
+	0x34, 0x4c, 0xdc, 0xb6, 0xfc, 0x42, 0xc0, 0xb1, 0x73, 0x0d, 0x98, 0x3e, 0x12, 0x62, 0xec, 0x9d,
+	0x7f, 0x66, 0xc1, 0xf2, 0xeb, 0x60, 0x18, 0xf6, 0xdf, 0xfe, 0x82, 0x43, 0x54, 0xd2, 0x87, 0xca,
+	0xfb, 0xf6, 0xa1, 0xfa, 0x55, 0xfb, 0xb0, 0x02, 0xd7, 0xcc, 0xc6, 0xca, 0x5e, 0x70, 0xb8, 0x8e,
+	0x5f, 0x9f, 0x70, 0xd5, 0x2c, 0xd5, 0x8d, 0x5f, 0x85, 0x4e, 0x7f, 0x12, 0x45, 0x3c, 0x28, 0xf4,
+	0x63, 0x51, 0xe2, 0x69, 0x47, 0x3e, 0x84, 0x56, 0xc0, 0xcf, 0xb3, 0x6c, 0x92, 0x77, 0x03, 0x7e,
+	0xae, 0xb2, 0x38, 0x5d, 0x58, 0xc9, 0x57, 0x23, 0x1b, 0xf0, 0xdf, 0x2d, 0xa8, 0xbd, 0x4e, 0xde,
+	0x85, 0x6c, 0x1d, 0x6a, 0xc9, 0xc5, 0x58, 0xac, 0x90, 0xf6, 0x06, 0x93, 0x5d, 0xdb, 0x1c, 0x0c,
+	0x22, 0x1e, 0xc7, 0xaf, 0x2e, 0xc6, 0xdc, 0x6d, 0x79, 0x22, 0xd1, 0xc3, 0x7c, 0xac, 0x0b, 0xf3,
+	0x32, 0x4d, 0x15, 0x36, 0x5c, 0x95, 0x64, 0x77, 0x00, 0xbc, 0x51, 0x38, 0x09, 0x92, 0x5e, 0xec,
+	0x25, 0x34, 0x54, 0x55, 0x57, 0x43, 0xd8, 0x2d, 0x68, 0x8c, 0xdf, 0xf6, 0xe2, 0x7e, 0xe4, 0x8f,
+	0x13, 0x62, 0x9b, 0x86, 0x9b, 0x01, 0xec, 0xeb, 0x50, 0x0f, 0x27, 0xc9, 0x38, 0xf4, 0x83, 0x44,
+	0xb2, 0xca, 0xa2, 0x6c, 0xcb, 0xcb, 0x49, 0x72, 0x80, 0xb0, 0x9b, 0x66, 0x60, 0x77, 0x61, 0xa1,
+	0x1f, 0x06, 0xc7, 0x7e, 0x34, 0x12, 0xc2, 0xa0, 0x3b, 0x47, 0xb5, 0x99, 0xa0, 0xf3, 0xaf, 0x2a,
+	0xd0, 0x7c, 0x15, 0x79, 0x41, 0xec, 0xf5, 0x11, 0xc0, 0xa6, 0x27, 0xef, 0x7a, 0xa7, 0x5e, 0x7c,
+	0x4a, 0xbd, 0x6d, 0xb8, 0x2a, 0xc9, 0x56, 0x60, 0x4e, 0x34, 0x94, 0xfa, 0x54, 0x75, 0x65, 0x8a,
+	0x7d, 0x0c, 0x4b, 0xc1, 0x64, 0xd4, 0x33, 0xeb, 0xaa, 0x12, 0xb7, 0x14, 0x09, 0x38, 0x00, 0x47,
+	0x38, 0xd7, 0xa2, 0x0a, 0xd1, 0x43, 0x0d, 0x61, 0x0e, 0xb4, 0x64, 0x8a, 0xfb, 0x27, 0xa7, 0xa2,
+	0x9b, 0xb3, 0xae, 0x81, 0x61, 0x19, 0x89, 0x3f, 0xe2, 0xbd, 0x38, 0xf1, 0x46, 0x63, 0xd9, 0x2d,
+	0x0d, 0x21, 0x7a, 0x98, 0x78, 0xc3, 0xde, 0x31, 0xe7, 0x71, 0x77, 0x5e, 0xd2, 0x53, 0x84, 0x7d,
+	0x04, 0xed, 0x01, 0x8f, 0x93, 0x9e, 0x9c, 0x14, 0x1e, 0x77, 0xeb, 0xb4, 0xf4, 0x73, 0x28, 0x96,
+	0x13, 0x79, 0xe7, 0x3d, 0x1c, 0x00, 0xfe, 0xae, 0xdb, 0x10, 0x6d, 0xcd, 0x10, 0xe4, 0x9c, 0x67,
+	0x3c, 0xd1, 0x46, 0x2f, 0x96, 0x1c, 0xea, 0xec, 0x01, 0xd3, 0xe0, 0x6d, 0x9e, 0x78, 0xfe, 0x30,
+	0x66, 0x9f, 0x42, 0x2b, 0xd1, 0x32, 0x93, 0x28, 0x6c, 0xa6, 0xec, 0xa4, 0x7d, 0xe0, 0x1a, 0xf9,
+	0x9c, 0x53, 0xa8, 0x3f, 0xe5, 0x7c, 0xcf, 0x1f, 0xf9, 0x09, 0x5b, 0x81, 0xd9, 0x63, 0xff, 0x1d,
+	0x17, 0x0c, 0x5f, 0xdd, 0x9d, 0x71, 0x45, 0x92, 0x7d, 0x00, 0x40, 0x3f, 0x7a, 0xa3, 0x94, 0xb1,
+	0x76, 0x67, 0xdc, 0x06, 0x61, 0x2f, 0x90, 0xb3, 0x6c, 0x98, 0x1f, 0xf3, 0xa8, 0xcf, 0xd5, 0xfc,
+	0xed, 0xce, 0xb8, 0x0a, 0x78, 0x32, 0x0f, 0xb3, 0x43, 0x2c, 0xdd, 0xf9, 0x83, 0x1a, 0x34, 0x0f,
+	0x79, 0x90, 0xae, 0x34, 0x06, 0x35, 0x1c, 0x13, 0xb9, 0xba, 0xe8, 0x37, 0xfb, 0x1a, 0x34, 0xf1,
+	0x6f, 0x2f, 0x4e, 0x22, 0x3f, 0x38, 0x11, 0x0c, 0xfe, 0xa4, 0xd2, 0xb5, 0x5c, 0x40, 0xf8, 0x90,
+	0x50, 0xd6, 0x81, 0xaa, 0x37, 0x52, 0x0c, 0x8e, 0x3f, 0xd9, 0x0d, 0xa8, 0x7b, 0xa3, 0x44, 0x34,
+	0xaf, 0x45, 0xf0, 0xbc, 0x37, 0x4a, 0xa8, 0x69, 0x1f, 0x42, 0x6b, 0xec, 0x5d, 0x8c, 0x70, 0x3d,
+	0xa7, 0x5c, 0xd1, 0x72, 0x9b, 0x12, 0xdb, 0x45, 0xb6, 0xd8, 0x80, 0x65, 0x3d, 0x8b, 0xaa, 0x7c,
+	0x36, 0xad, 0x7c, 0x49, 0xcb, 0x2d, 0xdb, 0x70, 0x0f, 0x16, 0xd5, 0x37, 0x91, 0xe8, 0x0f, 0xf1,
+	0x4a, 0xc3, 0x6d, 0x4b, 0x58, 0xf5, 0xf2, 0x3e, 0x74, 0x8e, 0xfd, 0xc0, 0x1b, 0xf6, 0xfa, 0xc3,
+	0xe4, 0xac, 0x37, 0xe0, 0xc3, 0xc4, 0x23, 0xae, 0x99, 0x75, 0xdb, 0x84, 0x6f, 0x0d, 0x93, 0xb3,
+	0x6d, 0x44, 0xd9, 0xc7, 0xd0, 0x38, 0xe6, 0xbc, 0x47, 0x83, 0xd5, 0xad, 0x1b, 0x2b, 0x50, 0xcd,
+	0x90, 0x5b, 0x3f, 0x96, 0xbf, 0xd8, 0xc7, 0xd0, 0x09, 0x27, 0xc9, 0x49, 0xe8, 0x07, 0x27, 0x3d,
+	0x94, 0x79, 0x3d, 0x7f, 0x40, 0x5c, 0x54, 0x7b, 0x52, 0x79, 0x64, 0xb9, 0x6d, 0x45, 0x43, 0xe9,
+	0xf3, 0x7c, 0xc0, 0x3e, 0x82, 0xc5, 0xa1, 0x17, 0x27, 0xbd, 0xd3, 0x70, 0xdc, 0x1b, 0x4f, 0x8e,
+	0xde, 0xf2, 0x8b, 0xee, 0x02, 0x0d, 0xc4, 0x02, 0xc2, 0xbb, 0xe1, 0xf8, 0x80, 0x40, 0x76, 0x1b,
+	0x80, 0xda, 0x29, 0x1a, 0x01, 0x6b, 0xd6, 0xfd, 0x05, 0xb7, 0x81, 0x88, 0xa8, 0xf4, 0x73, 0xa8,
+	0xd3, 0xf4, 0x24, 0xc3, 0xb3, 0x6e, 0x93, 0x18, 0xec, 0x03, 0xd9, 0x42, 0x6d, 0x62, 0xd7, 0xb7,
+	0x79, 0x9c, 0xbc, 0x1a, 0x9e, 0xe1, 0xfe, 0x7d, 0xe1, 0xce, 0x0f, 0x44, 0xca, 0xfe, 0x1c, 0x5a,
+	0x3a, 0x01, 0x67, 0x11, 0x9b, 0x81, 0xb3, 0x5f, 0x73, 0xf1, 0x27, 0xbb, 0x06, 0xb3, 0x67, 0xde,
+	0x70, 0xc2, 0xa5, 0x20, 0x15, 0x89, 0xcf, 0x2b, 0x9f, 0x59, 0xce, 0x1f, 0x58, 0xd0, 0x12, 0x35,
+	0x48, 0x05, 0xe0, 0x2e, 0x2c, 0xa8, 0xe1, 0xe7, 0x51, 0x14, 0x46, 0x52, 0x9e, 0x98, 0x20, 0x7b,
+	0x00, 0x1d, 0x05, 0x8c, 0x23, 0xee, 0x8f, 0xbc, 0x13, 0x55, 0x76, 0x01, 0x67, 0x1b, 0x59, 0x89,
+	0x51, 0x38, 0x49, 0xb8, 0xdc, 0x6a, 0x5a, 0xb2, 0x7f, 0x2e, 0x62, 0xae, 0x99, 0x05, 0xe5, 0x49,
+	0x09, 0x6f, 0x19, 0x98, 0xf3, 0xf7, 0x2c, 0x60, 0xd8, 0xf4, 0x57, 0xa1, 0x28, 0x42, 0xb2, 0x45,
+	0x9e, 0x2d, 0xad, 0xf7, 0x66, 0xcb, 0xca, 0x65, 0x6c, 0xe9, 0xc0, 0xac, 0x68, 0x7d, 0xad, 0xa4,
+	0xf5, 0x82, 0xf4, 0x9d, 0x5a, 0xbd, 0xda, 0xa9, 0x39, 0xff, 0xb5, 0x0a, 0xd7, 0xb6, 0xc4, 0x5e,
+	0xb9, 0xd9, 0xef, 0xf3, 0x71, 0xca, 0xb0, 0x1f, 0x40, 0x33, 0x08, 0x07, 0x5c, 0xb1, 0x89, 0x68,
+	0x18, 0x20, 0xa4, 0xf1, 0xc8, 0xa9, 0xe7, 0x07, 0xa2, 0xe1, 0x62, 0x3c, 0x1b, 0x84, 0x50, 0xb3,
+	0x3f, 0x82, 0xc5, 0x31, 0x0f, 0x06, 0x3a, 0x5f, 0x0a, 0x6d, 0x66, 0x41, 0xc2, 0x92, 0x25, 0x3f,
+	0x80, 0xe6, 0xf1, 0x44, 0xe4, 0xc3, 0xd5, 0x5c, 0x23, 0x3e, 0x00, 0x09, 0x6d, 0x8a, 0x45, 0x3d,
+	0x9e, 0xc4, 0xa7, 0x44, 0x9d, 0x25, 0xea, 0x3c, 0xa6, 0x91, 0x74, 0x1b, 0x60, 0x30, 0x89, 0x13,
+	0xc9, 0xa6, 0x73, 0x44, 0x6c, 0x20, 0x22, 0xd8, 0xf4, 0x1b, 0xb0, 0x3c, 0xf2, 0xde, 0xf5, 0x88,
+	0x7f, 0x7a, 0x7e, 0xd0, 0x3b, 0x1e, 0x92, 0xb8, 0x9f, 0xa7, 0x7c, 0x9d, 0x91, 0xf7, 0xee, 0x7b,
+	0x48, 0x79, 0x1e, 0x3c, 0x25, 0x1c, 0xd7, 0xb2, 0xd2, 0x33, 0x22, 0x1e, 0xf3, 0xe8, 0x8c, 0xd3,
+	0xf2, 0xab, 0xa5, 0xca, 0x84, 0x2b, 0x50, 0x6c, 0xd1, 0x08, 0xfb, 0x9d, 0x0c, 0xfb, 0x62, 0xad,
+	0xb9, 0xf3, 0x23, 0x3f, 0xd8, 0x4d, 0x86, 0x7d, 0x76, 0x0b, 0x00, 0x17, 0xef, 0x98, 0x47, 0xbd,
+	0xb7, 0xe7, 0xb4, 0x70, 0x6a, 0xb4, 0x58, 0x0f, 0x78, 0xf4, 0xdd, 0x73, 0x76, 0x13, 0x1a, 0xfd,
+	0x98, 0x56, 0xbf, 0x77, 0xd1, 0x6d, 0xd2, 0xaa, 0xaa, 0xf7, 0x63, 0x5c, 0xf7, 0xde, 0x05, 0xfb,
+	0x18, 0x18, 0xb6, 0xd6, 0xa3, 0x59, 0xe0, 0x03, 0x2a, 0x3e, 0x26, 0x31, 0xb6, 0x40, 0x8d, 0xdd,
+	0x94, 0x04, 0xac, 0x27, 0x66, 0x5f, 0x83, 0x05, 0xd5, 0xd8, 0xe3, 0xa1, 0x77, 0x12, 0xd3, 0x3a,
+	0x5e, 0x70, 0x5b, 0x12, 0x7c, 0x8a, 0x98, 0xf3, 0x06, 0xae, 0xe7, 0xe6, 0x56, 0xae, 0x1b, 0xdc,
+	0x67, 0x09, 0xa1, 0x79, 0xad, 0xbb, 0x32, 0x55, 0x36, 0x69, 0x95, 0x92, 0x49, 0x73, 0x7e, 0x6e,
+	0x41, 0x4b, 0x96, 0x4c, 0x2a, 0x01, 0x7b, 0x04, 0x4c, 0xcd, 0x62, 0xf2, 0xce, 0x1f, 0xf4, 0x8e,
+	0x2e, 0x12, 0x1e, 0x0b, 0xa6, 0xd9, 0x9d, 0x71, 0x4b, 0x68, 0x28, 0xb8, 0x0c, 0x34, 0x4e, 0x22,
+	0xc1, 0xd3, 0xbb, 0x33, 0x6e, 0x81, 0x82, 0x4b, 0x0c, 0x95, 0x8e, 0x49, 0xd2, 0xf3, 0x83, 0x01,
+	0x7f, 0x47, 0xac, 0xb4, 0xe0, 0x1a, 0xd8, 0x93, 0x36, 0xb4, 0xf4, 0xef, 0x9c, 0x1f, 0x43, 0x5d,
+	0xa9, 0x2c, 0xb4, 0x5d, 0xe7, 0xda, 0xe5, 0x6a, 0x08, 0xb3, 0xa1, 0x6e, 0xb6, 0xc2, 0xad, 0x7f,
+	0x95, 0xba, 0x9d, 0xbf, 0x00, 0x9d, 0x3d, 0x64, 0xa2, 0x00, 0x99, 0x56, 0xea, 0x61, 0x2b, 0x30,
+	0xa7, 0x2d, 0x9e, 0x86, 0x2b, 0x53, 0xb8, 0xe1, 0x9d, 0x86, 0x71, 0x22, 0xeb, 0xa1, 0xdf, 0xce,
+	0xbf, 0xb7, 0x80, 0xed, 0xc4, 0x89, 0x3f, 0xf2, 0x12, 0xfe, 0x94, 0xa7, 0xe2, 0xe1, 0x25, 0xb4,
+	0xb0, 0xb4, 0x57, 0xe1, 0xa6, 0xd0, 0x8a, 0xc4, 0x6e, 0xfe, 0x75, 0xb9, 0x9c, 0x8b, 0x1f, 0xac,
+	0xeb, 0xb9, 0x85, 0xe0, 0x35, 0x0a, 0xc0, 0xd5, 0x96, 0x78, 0xd1, 0x09, 0x4f, 0x48, 0x65, 0x92,
+	0x0a, 0x37, 0x08, 0x68, 0x2b, 0x0c, 0x8e, 0xed, 0xdf, 0x80, 0xa5, 0x42, 0x19, 0xba, 0x8c, 0x6e,
+	0x94, 0xc8, 0xe8, 0xaa, 0x2e, 0xa3, 0xfb, 0xb0, 0x6c, 0xb4, 0x4b, 0x72, 0x5c, 0x17, 0xe6, 0x71,
+	0x61, 0xe0, 0xce, 0x6c, 0x89, 0x9d, 0x59, 0x26, 0xd9, 0x06, 0x5c, 0x3b, 0xe6, 0x3c, 0xf2, 0x12,
+	0x4a, 0xd2, 0xd2, 0xc1, 0x39, 0x91, 0x25, 0x97, 0xd2, 0x9c, 0xff, 0x61, 0xc1, 0x22, 0x4a, 0xd3,
+	0x17, 0x5e, 0x70, 0xa1, 0xc6, 0x6a, 0xaf, 0x74, 0xac, 0xee, 0x6b, 0x1b, 0x93, 0x96, 0xfb, 0xab,
+	0x0e, 0x54, 0x35, 0x3f, 0x50, 0x6c, 0x0d, 0x5a, 0x46, 0x73, 0x67, 0x85, 0x0a, 0x18, 0x7b, 0xc9,
+	0x01, 0x8f, 0x9e, 0x5c, 0x24, 0xfc, 0x97, 0x1f, 0xca, 0x8f, 0xa0, 0x93, 0x35, 0x5b, 0x8e, 0x23,
+	0x83, 0x1a, 0x32, 0xa6, 0x2c, 0x80, 0x7e, 0x3b, 0xff, 0xc8, 0x12, 0x19, 0xb7, 0x42, 0x3f, 0x55,
+	0x0f, 0x31, 0x23, 0x6a, 0x99, 0x2a, 0x23, 0xfe, 0x9e, 0xaa, 0x5e, 0xff, 0xf2, 0x9d, 0x45, 0x99,
+	0x18, 0xf3, 0x60, 0xd0, 0xf3, 0x86, 0x43, 0x12, 0xc4, 0x75, 0x77, 0x1e, 0xd3, 0x9b, 0xc3, 0xa1,
+	0x73, 0x0f, 0x96, 0xb4, 0xd6, 0x5d, 0xd2, 0x8f, 0x7d, 0x60, 0x7b, 0x7e, 0x9c, 0xbc, 0x0e, 0xe2,
+	0xb1, 0xa6, 0x39, 0xdd, 0x84, 0x06, 0x4a, 0x5b, 0x6c, 0x99, 0x58, 0xb9, 0xb3, 0x2e, 0x8a, 0x5f,
+	0x6c, 0x57, 0x4c, 0x44, 0xef, 0x9d, 0x24, 0x56, 0x24, 0xd1, 0x7b, 0x47, 0x44, 0xe7, 0x33, 0x58,
+	0x36, 0xca, 0x93, 0x55, 0x7f, 0x08, 0xb3, 0x93, 0xe4, 0x5d, 0xa8, 0x74, 0xe3, 0xa6, 0xe4, 0x10,
+	0x3c, 0x85, 0xb9, 0x82, 0xe2, 0x3c, 0x86, 0xa5, 0x7d, 0x7e, 0x2e, 0x17, 0xb2, 0x6a, 0xc8, 0x47,
+	0x57, 0x9e, 0xd0, 0x88, 0xee, 0xac, 0x03, 0xd3, 0x3f, 0xce, 0x16, 0x80, 0x3a, 0xaf, 0x59, 0xc6,
+	0x79, 0xcd, 0xf9, 0x08, 0xd8, 0xa1, 0x7f, 0x12, 0xbc, 0xe0, 0x71, 0xec, 0x9d, 0xa4, 0x4b, 0xbf,
+	0x03, 0xd5, 0x51, 0x7c, 0x22, 0x45, 0x15, 0xfe, 0x74, 0xbe, 0x09, 0xcb, 0x46, 0x3e, 0x59, 0xf0,
+	0x2d, 0x68, 0xc4, 0xfe, 0x49, 0xe0, 0x25, 0x93, 0x88, 0xcb, 0xa2, 0x33, 0xc0, 0x79, 0x0a, 0xd7,
+	0xbe, 0xc7, 0x23, 0xff, 0xf8, 0xe2, 0xaa, 0xe2, 0xcd, 0x72, 0x2a, 0xf9, 0x72, 0x76, 0xe0, 0x7a,
+	0xae, 0x1c, 0x59, 0xbd, 0x60, 0x5f, 0x39, 0x93, 0x75, 0x57, 0x24, 0x34, 0xd9, 0x57, 0xd1, 0x65,
+	0x9f, 0xf3, 0x1a, 0xd8, 0x56, 0x18, 0x04, 0xbc, 0x9f, 0x1c, 0x70, 0x1e, 0x65, 0xa6, 0xa2, 0x8c,
+	0x57, 0x9b, 0x1b, 0xab, 0x72, 0x64, 0xf3, 0x02, 0x55, 0x32, 0x31, 0x83, 0xda, 0x98, 0x47, 0x23,
+	0x2a, 0xb8, 0xee, 0xd2, 0x6f, 0xe7, 0x3a, 0x2c, 0x1b, 0xc5, 0xca, 0xc3, 0xf5, 0x27, 0x70, 0x7d,
+	0xdb, 0x8f, 0xfb, 0xc5, 0x0a, 0xbb, 0x30, 0x3f, 0x9e, 0x1c, 0xf5, 0xb2, 0x95, 0xa8, 0x92, 0x78,
+	0xde, 0xca, 0x7f, 0x22, 0x0b, 0xfb, 0x1b, 0x16, 0xd4, 0x76, 0x5f, 0xed, 0x6d, 0xe1, 0x5e, 0xe1,
+	0x07, 0xfd, 0x70, 0x84, 0x5a, 0x98, 0xe8, 0x74, 0x9a, 0x9e, 0xba, 0xc2, 0x6e, 0x41, 0x83, 0x94,
+	0x37, 0x3c, 0x62, 0x4a, 0x3d, 0x28, 0x03, 0xf0, 0x78, 0xcb, 0xdf, 0x8d, 0xfd, 0x88, 0xce, 0xaf,
+	0xea, 0x54, 0x5a, 0xa3, 0x6d, 0xa6, 0x48, 0x70, 0x7e, 0x3e, 0x0f, 0xf3, 0x72, 0xf3, 0x15, 0x1b,
+	0x79, 0xe2, 0x9f, 0xf1, 0x6c, 0x23, 0xc7, 0x14, 0x2a, 0xc6, 0x11, 0x1f, 0x85, 0x49, 0xaa, 0xbf,
+	0x89, 0x69, 0x30, 0x41, 0xcc, 0xa5, 0x94, 0x08, 0x71, 0xe0, 0xaf, 0x8a, 0x5c, 0x06, 0xc8, 0x6e,
+	0xc1, 0xbc, 0x52, 0x06, 0x6a, 0xe9, 0xc9, 0x42, 0x41, 0x38, 0x1a, 0x7d, 0x6f, 0xec, 0xf5, 0xfd,
+	0xe4, 0x42, 0x8a, 0x85, 0x34, 0x8d, 0xe5, 0x0f, 0xc3, 0xbe, 0x87, 0x76, 0x9b, 0xa1, 0x17, 0xf4,
+	0xb9, 0x32, 0x0f, 0x18, 0x20, 0x1e, 0x95, 0x65, 0xb3, 0x54, 0x36, 0x71, 0x9c, 0xce, 0xa1, 0xb8,
+	0x87, 0xf7, 0xc3, 0xd1, 0xc8, 0x4f, 0xf0, 0x84, 0x4d, 0xaa, 0x59, 0xd5, 0xd5, 0x10, 0xea, 0x8d,
+	0x48, 0x9d, 0x8b, 0x11, 0x6c, 0x28, 0x63, 0x84, 0x06, 0x62, 0x29, 0x39, 0x0d, 0xad, 0xea, 0x6a,
+	0x08, 0xce, 0xc5, 0x24, 0x88, 0x79, 0x92, 0x0c, 0xf9, 0x20, 0x6d, 0x50, 0x93, 0xb2, 0x15, 0x09,
+	0xec, 0x11, 0x2c, 0x8b, 0x43, 0x7f, 0xec, 0x25, 0x61, 0x7c, 0xea, 0xc7, 0xbd, 0x18, 0x4f, 0xbf,
+	0xe2, 0xf0, 0x59, 0x46, 0x62, 0x9f, 0xc1, 0x6a, 0x0e, 0x8e, 0x78, 0x9f, 0xfb, 0x67, 0x7c, 0x40,
+	0x2a, 0x5c, 0xd5, 0x9d, 0x46, 0x66, 0x6b, 0xd0, 0x44, 0x5b, 0xc7, 0x64, 0x3c, 0xf0, 0x50, 0x89,
+	0x69, 0x93, 0x72, 0xa9, 0x43, 0xec, 0x13, 0x50, 0x7a, 0x9a, 0xd4, 0x1e, 0x17, 0x0d, 0x09, 0x87,
+	0xdc, 0xeb, 0x9a, 0x39, 0xd8, 0x2d, 0x5d, 0x25, 0xed, 0xc8, 0x83, 0x9e, 0x02, 0x68, 0x9d, 0x44,
+	0xfe, 0x99, 0x97, 0xf0, 0xee, 0x92, 0x10, 0xea, 0x32, 0x89, 0xdf, 0xf9, 0x81, 0x9f, 0xf8, 0x5e,
+	0x12, 0x46, 0x5d, 0x46, 0xb4, 0x0c, 0xc0, 0x41, 0x24, 0xfe, 0x88, 0x13, 0x2f, 0x99, 0xc4, 0x52,
+	0x43, 0x5d, 0x26, 0xe6, 0x2a, 0x12, 0xd8, 0xa7, 0xb0, 0x22, 0x38, 0x82, 0x48, 0x52, 0xf7, 0x26,
+	0x55, 0xe1, 0x1a, 0x8d, 0xc8, 0x14, 0x2a, 0x0e, 0xa5, 0x64, 0x91, 0xc2, 0x87, 0xd7, 0xc5, 0x50,
+	0x4e, 0x21, 0x63, 0xfb, 0xb0, 0x05, 0x7e, 0xbf, 0x27, 0x73, 0xe0, 0x12, 0x59, 0xa1, 0x5e, 0x14,
+	0x09, 0xc8, 0xe2, 0x43, 0xff, 0x98, 0xa3, 0xf5, 0xa7, 0xbb, 0x2a, 0x58, 0x5c, 0xa5, 0x71, 0x01,
+	0x4e, 0xc6, 0x44, 0xe9, 0x8a, 0x05, 0x2f, 0x52, 0xce, 0xef, 0x58, 0x62, 0xf3, 0x91, 0x0b, 0x35,
+	0xd6, 0x8e, 0x55, 0x62, 0x89, 0xf6, 0xc2, 0x60, 0x78, 0x21, 0x57, 0x2d, 0x08, 0xe8, 0x65, 0x30,
+	0xbc, 0x40, 0xc5, 0xde, 0x0f, 0xf4, 0x2c, 0x42, 0xce, 0xb5, 0xfc, 0x40, 0xcb, 0xf4, 0x01, 0x34,
+	0xc7, 0x93, 0xa3, 0xa1, 0xdf, 0x17, 0x59, 0xaa, 0xa2, 0x14, 0x01, 0x51, 0x06, 0x3c, 0x57, 0x8a,
+	0x99, 0x12, 0x39, 0x6a, 0x94, 0xa3, 0x29, 0x31, 0xcc, 0xe2, 0x3c, 0x81, 0x6b, 0x66, 0x03, 0xa5,
+	0x40, 0x7f, 0x00, 0x75, 0xb9, 0xfe, 0x63, 0x79, 0xb8, 0x6f, 0x6b, 0x76, 0x56, 0x3c, 0x06, 0xa5,
+	0x74, 0xe7, 0x5f, 0xd7, 0x60, 0x59, 0xa2, 0x5b, 0xc3, 0x30, 0xe6, 0x87, 0x93, 0xd1, 0xc8, 0x8b,
+	0x4a, 0x04, 0x8b, 0x75, 0x85, 0x60, 0xa9, 0x14, 0x05, 0xcb, 0x1d, 0xe3, 0x7c, 0x29, 0x24, 0x93,
+	0x86, 0xb0, 0xfb, 0xb0, 0xd8, 0x1f, 0x86, 0xb1, 0x50, 0xf7, 0x75, 0x53, 0x5f, 0x1e, 0x2e, 0x0a,
+	0xc3, 0xd9, 0x32, 0x61, 0xa8, 0x0b, 0xb2, 0xb9, 0x9c, 0x20, 0x73, 0xa0, 0x85, 0x85, 0x72, 0x25,
+	0x9b, 0xe7, 0xe5, 0x61, 0x4b, 0xc3, 0xb0, 0x3d, 0x79, 0xb1, 0x21, 0x64, 0xd4, 0x62, 0x99, 0xd0,
+	0x40, 0x4b, 0x22, 0xca, 0x7e, 0x2d, 0x77, 0x43, 0x0a, 0x8d, 0x22, 0x89, 0x3d, 0x05, 0x10, 0x75,
+	0x91, 0x02, 0x02, 0xa4, 0x80, 0x7c, 0x64, 0xce, 0x8a, 0x3e, 0xfe, 0xeb, 0x98, 0x98, 0x44, 0x9c,
+	0x94, 0x12, 0xed, 0x4b, 0xe7, 0x6f, 0x59, 0xd0, 0xd4, 0x68, 0xec, 0x3a, 0x2c, 0x6d, 0xbd, 0x7c,
+	0x79, 0xb0, 0xe3, 0x6e, 0xbe, 0x7a, 0xfe, 0xbd, 0x9d, 0xde, 0xd6, 0xde, 0xcb, 0xc3, 0x9d, 0xce,
+	0x0c, 0xc2, 0x7b, 0x2f, 0xb7, 0x36, 0xf7, 0x7a, 0x4f, 0x5f, 0xba, 0x5b, 0x0a, 0xb6, 0xd8, 0x0a,
+	0x30, 0x77, 0xe7, 0xc5, 0xcb, 0x57, 0x3b, 0x06, 0x5e, 0x61, 0x1d, 0x68, 0x3d, 0x71, 0x77, 0x36,
+	0xb7, 0x76, 0x25, 0x52, 0x65, 0xd7, 0xa0, 0xf3, 0xf4, 0xf5, 0xfe, 0xf6, 0xf3, 0xfd, 0x67, 0xbd,
+	0xad, 0xcd, 0xfd, 0xad, 0x9d, 0xbd, 0x9d, 0xed, 0x4e, 0x8d, 0x2d, 0x40, 0x63, 0xf3, 0xc9, 0xe6,
+	0xfe, 0xf6, 0xcb, 0xfd, 0x9d, 0xed, 0xce, 0xac, 0xf3, 0xdf, 0x2c, 0xb8, 0x4e, 0xad, 0x1e, 0xe4,
+	0x17, 0xc9, 0x1a, 0x34, 0xfb, 0x61, 0x38, 0xe6, 0x91, 0xa7, 0x6d, 0x6d, 0x3a, 0x84, 0x0b, 0x40,
+	0x08, 0x85, 0xe3, 0x30, 0xea, 0x73, 0xb9, 0x46, 0x80, 0xa0, 0xa7, 0x88, 0xe0, 0x02, 0x90, 0xd3,
+	0x2b, 0x72, 0x88, 0x25, 0xd2, 0x14, 0x98, 0xc8, 0xb2, 0x02, 0x73, 0x47, 0x11, 0xf7, 0xfa, 0xa7,
+	0x72, 0x75, 0xc8, 0x14, 0x9a, 0xfe, 0xd5, 0x39, 0xb2, 0x8f, 0xa3, 0x3f, 0xe4, 0x03, 0xe2, 0x98,
+	0xba, 0xbb, 0x28, 0xf1, 0x2d, 0x09, 0xa3, 0x14, 0xf4, 0x8e, 0xbc, 0x60, 0x10, 0x06, 0x7c, 0x20,
+	0xd5, 0xde, 0x0c, 0x70, 0x0e, 0x60, 0x25, 0xdf, 0x3f, 0xb9, 0xc6, 0x3e, 0xd5, 0xd6, 0x98, 0xd0,
+	0x42, 0xed, 0xe9, 0xb3, 0xa9, 0xad, 0xb7, 0x3f, 0xaa, 0x40, 0x0d, 0x95, 0x92, 0xe9, 0x0a, 0x8c,
+	0xae, 0x67, 0x56, 0x0b, 0x7e, 0x01, 0x3a, 0xec, 0x8a, 0x2d, 0x4a, 0x1a, 0x5a, 0x32, 0x24, 0xa3,
+	0x47, 0xbc, 0x7f, 0x26, 0x4d, 0x2d, 0x1a, 0x82, 0x0b, 0x04, 0x0f, 0x01, 0xf4, 0xb5, 0x5c, 0x20,
+	0x2a, 0xad, 0x68, 0xf4, 0xe5, 0x7c, 0x46, 0xa3, 0xef, 0xba, 0x30, 0xef, 0x07, 0x47, 0xe1, 0x24,
+	0x18, 0xd0, 0x82, 0xa8, 0xbb, 0x2a, 0x49, 0x9e, 0x08, 0x5a, 0xa8, 0xfe, 0x48, 0xb1, 0x7f, 0x06,
+	0xb0, 0x0d, 0x68, 0xc4, 0x17, 0x41, 0x5f, 0xe7, 0xf9, 0x6b, 0x72, 0x94, 0x70, 0x0c, 0xd6, 0x0f,
+	0x2f, 0x82, 0x3e, 0x71, 0x78, 0x96, 0xcd, 0xf9, 0x0d, 0xa8, 0x2b, 0x18, 0xd9, 0xf2, 0xf5, 0xfe,
+	0x77, 0xf7, 0x5f, 0xbe, 0xd9, 0xef, 0x1d, 0x7e, 0x7f, 0x7f, 0xab, 0x33, 0xc3, 0x16, 0xa1, 0xb9,
+	0xb9, 0x45, 0x9c, 0x4e, 0x80, 0x85, 0x59, 0x0e, 0x36, 0x0f, 0x0f, 0x53, 0xa4, 0xe2, 0x30, 0x3c,
+	0xc8, 0xc7, 0xa4, 0xf9, 0xa5, 0x96, 0xf6, 0x4f, 0x61, 0x49, 0xc3, 0xb2, 0x53, 0xc4, 0x18, 0x81,
+	0xdc, 0x29, 0x02, 0x33, 0xb9, 0x82, 0xe2, 0x74, 0xd0, 0x27, 0x9a, 0x3c, 0x0f, 0x8e, 0x43, 0x55,
+	0xd2, 0xff, 0xaa, 0xc1, 0x62, 0x0a, 0xc9, 0x82, 0xee, 0xc3, 0xa2, 0x3f, 0xe0, 0x41, 0xe2, 0x27,
+	0x17, 0x3d, 0xc3, 0x5e, 0x90, 0x87, 0x51, 0xd5, 0xf6, 0x86, 0xbe, 0xa7, 0x1c, 0x3e, 0x22, 0x81,
+	0xe7, 0x67, 0xd4, 0x01, 0x74, 0xbb, 0x0d, 0xf1, 0x95, 0x30, 0x53, 0x94, 0xd2, 0x50, 0x02, 0x21,
+	0x2e, 0xb7, 0x99, 0xf4, 0x13, 0xa1, 0x72, 0x96, 0x91, 0x70, 0xaa, 0x44, 0x49, 0xd8, 0xe5, 0x59,
+	0xa1, 0x27, 0xa4, 0x40, 0xc1, 0xa3, 0x32, 0x27, 0xe4, 0x63, 0xde, 0xa3, 0xa2, 0x79, 0x65, 0xea,
+	0x05, 0xaf, 0x0c, 0xca, 0xcf, 0x8b, 0xa0, 0xcf, 0x07, 0xbd, 0x24, 0xec, 0x91, 0x9c, 0x27, 0x96,
+	0xa8, 0xbb, 0x79, 0x18, 0xf7, 0x8d, 0x84, 0xc7, 0x49, 0xc0, 0x85, 0x69, 0xba, 0x4e, 0x56, 0x50,
+	0x05, 0xe1, 0xf9, 0x60, 0x12, 0xf9, 0x68, 0x39, 0x43, 0x7f, 0x0b, 0xfd, 0x66, 0xdf, 0x82, 0xeb,
+	0x47, 0x1c, 0xed, 0xde, 0xdc, 0x1b, 0xf0, 0x88, 0xd8, 0x4b, 0x38, 0x76, 0x84, 0xca, 0x55, 0x4e,
+	0x44, 0xc6, 0x3d, 0xe3, 0x51, 0xec, 0x87, 0x01, 0x29, 0x5b, 0x0d, 0x57, 0x25, 0xb1, 0x3c, 0xec,
+	0xbc, 0x1f, 0xe4, 0x86, 0xa9, 0xbb, 0x48, 0x1d, 0x2f, 0x27, 0xb2, 0xbb, 0x30, 0x47, 0x1d, 0x88,
+	0xbb, 0x9d, 0xb5, 0xaa, 0x66, 0x96, 0xdd, 0x42, 0xd0, 0x95, 0x34, 0x9c, 0xe5, 0x7e, 0x38, 0x0c,
+	0x23, 0xd2, 0xb8, 0x1a, 0xae, 0x48, 0x98, 0xa3, 0x73, 0x12, 0x79, 0xe3, 0x53, 0xa9, 0x75, 0xe5,
+	0xe1, 0xef, 0xd4, 0xea, 0xcd, 0x4e, 0xcb, 0xf9, 0x73, 0x30, 0x4b, 0xc5, 0x52, 0x71, 0x34, 0x98,
+	0x96, 0x2c, 0x8e, 0xd0, 0x2e, 0xcc, 0x07, 0x3c, 0x39, 0x0f, 0xa3, 0xb7, 0xca, 0x7b, 0x28, 0x93,
+	0xce, 0x4f, 0xe9, 0x84, 0x96, 0x7a, 0xd3, 0x5e, 0x93, 0x6a, 0x89, 0xe7, 0x6c, 0x31, 0x55, 0xf1,
+	0xa9, 0x27, 0x0f, 0x8d, 0x75, 0x02, 0x0e, 0x4f, 0x3d, 0x94, 0xb5, 0xc6, 0xec, 0x8b, 0x73, 0x78,
+	0x93, 0xb0, 0x5d, 0x31, 0xf9, 0x77, 0xa1, 0xad, 0xfc, 0x74, 0x71, 0x6f, 0xc8, 0x8f, 0x13, 0x65,
+	0x45, 0x0b, 0x26, 0x23, 0xac, 0x2e, 0xde, 0xe3, 0xc7, 0x89, 0xb3, 0x0f, 0x4b, 0x52, 0xfe, 0xbd,
+	0x1c, 0x73, 0x55, 0xf5, 0xaf, 0x95, 0xe9, 0x12, 0xcd, 0x8d, 0x65, 0x53, 0x60, 0x0a, 0xcf, 0xa4,
+	0x99, 0xd3, 0x71, 0x81, 0xe9, 0xf2, 0x54, 0x16, 0x28, 0x37, 0x73, 0x65, 0x27, 0x94, 0xdd, 0x31,
+	0x30, 0x1c, 0x9f, 0x78, 0xd2, 0xef, 0x2b, 0xef, 0x6a, 0xdd, 0x55, 0x49, 0xe7, 0xf7, 0x2c, 0x58,
+	0xa6, 0xd2, 0xb6, 0x94, 0x51, 0x58, 0xec, 0x59, 0x9f, 0x7d, 0x85, 0x66, 0xb6, 0xfa, 0x5a, 0x0a,
+	0x67, 0x48, 0xdf, 0xc5, 0x44, 0xe2, 0xab, 0xdb, 0x64, 0x6a, 0x79, 0x9b, 0x8c, 0xf3, 0xf7, 0x2d,
+	0x58, 0x12, 0x1b, 0x09, 0x69, 0xdb, 0xb2, 0xfb, 0x7f, 0x1e, 0x16, 0x84, 0x46, 0x20, 0xa5, 0x82,
+	0x6c, 0x68, 0x26, 0x5a, 0x09, 0x15, 0x99, 0x77, 0x67, 0x5c, 0x33, 0x33, 0x7b, 0x4c, 0x5a, 0x59,
+	0xd0, 0x23, 0xb4, 0xc4, 0x0f, 0x6f, 0x8e, 0xf5, 0xee, 0x8c, 0xab, 0x65, 0x7f, 0x52, 0x47, 0x65,
+	0x19, 0x71, 0xe7, 0x19, 0x2c, 0x18, 0x15, 0x19, 0xf6, 0xa0, 0x96, 0xb0, 0x07, 0x15, 0x0c, 0xaf,
+	0x95, 0x12, 0xc3, 0xeb, 0xbf, 0xac, 0x02, 0x43, 0x66, 0xc9, 0xcd, 0xc6, 0x9a, 0xe9, 0xbd, 0x50,
+	0x2e, 0xf9, 0x0c, 0x62, 0x1b, 0xc0, 0xb4, 0xa4, 0xf2, 0xaa, 0x54, 0x53, 0xaf, 0x4a, 0x09, 0x15,
+	0x45, 0xad, 0xd4, 0x3a, 0x52, 0x8f, 0x05, 0x9d, 0xf5, 0xc5, 0xd0, 0x97, 0xd2, 0x70, 0x67, 0x24,
+	0xf7, 0x05, 0x9e, 0x4a, 0xe4, 0xf9, 0x58, 0xa5, 0xf3, 0x73, 0x3c, 0x77, 0xe5, 0x1c, 0xcf, 0x17,
+	0xec, 0x6e, 0xda, 0x09, 0xad, 0x6e, 0x9e, 0xd0, 0xee, 0xc2, 0x82, 0xf2, 0x52, 0x08, 0x8f, 0xa8,
+	0x3c, 0x0e, 0x1b, 0x20, 0xfa, 0xc6, 0xd4, 0x21, 0x29, 0x3d, 0x06, 0x0a, 0x7f, 0x5f, 0x01, 0xc7,
+	0x3d, 0x20, 0xb3, 0xc4, 0x35, 0xa9, 0xb1, 0x19, 0x40, 0x67, 0x2a, 0xe4, 0x92, 0xde, 0x24, 0x90,
+	0xee, 0x78, 0x3e, 0xe8, 0xb6, 0xe4, 0x99, 0x2a, 0x4f, 0x70, 0xfe, 0x8e, 0x05, 0x1d, 0x9c, 0x37,
+	0x83, 0x35, 0x3f, 0x07, 0x5a, 0x19, 0xef, 0xc9, 0x99, 0x46, 0x5e, 0xf6, 0x19, 0x34, 0x28, 0x1d,
+	0x8e, 0x79, 0x20, 0xf9, 0xb2, 0x6b, 0xf2, 0x65, 0x26, 0x53, 0xd0, 0x6b, 0x9d, 0x66, 0xd6, 0xb8,
+	0xf2, 0x3f, 0x59, 0xd0, 0x94, 0xb5, 0xfc, 0xc2, 0x96, 0x1e, 0x5b, 0x8b, 0x9f, 0x10, 0x0a, 0x58,
+	0x9a, 0x46, 0x21, 0x3e, 0x42, 0x73, 0x1a, 0xee, 0xe9, 0x86, 0x95, 0x27, 0x0f, 0xe3, 0x06, 0x4d,
+	0xe2, 0x33, 0xee, 0x25, 0xfe, 0xb0, 0xa7, 0xa8, 0x32, 0x52, 0xa1, 0x8c, 0x84, 0x52, 0x24, 0x4e,
+	0xd0, 0xb3, 0x29, 0xf6, 0x5e, 0x91, 0x40, 0x73, 0xd6, 0x41, 0xe6, 0xb9, 0xd1, 0x74, 0x6c, 0xe7,
+	0x9f, 0x2f, 0xc0, 0x6a, 0x81, 0x94, 0xc6, 0x55, 0x49, 0xd3, 0xc5, 0xd0, 0x1f, 0x1d, 0x85, 0xe9,
+	0x01, 0xc5, 0xd2, 0xad, 0x1a, 0x06, 0x89, 0x9d, 0xc0, 0x75, 0xa5, 0x64, 0xe0, 0x98, 0x66, 0x1b,
+	0x62, 0x85, 0x76, 0xba, 0x4f, 0xcc, 0x29, 0xcc, 0x57, 0xa8, 0x70, 0x7d, 0x21, 0x97, 0x97, 0xc7,
+	0x4e, 0xa1, 0xab, 0x08, 0x4a, 0x60, 0x6b, 0x1a, 0x0f, 0xd6, 0xf5, 0xf1, 0x15, 0x75, 0x19, 0x2a,
+	0xb9, 0x3b, 0xb5, 0x34, 0x76, 0x01, 0x77, 0x14, 0x8d, 0x24, 0x72, 0xb1, 0xbe, 0xda, 0x7b, 0xf5,
+	0x8d, 0x0e, 0x1b, 0x66, 0xa5, 0x57, 0x14, 0xcc, 0x7e, 0x0c, 0x2b, 0xe7, 0x9e, 0x9f, 0xa8, 0x66,
+	0x69, 0xfa, 0xc5, 0x2c, 0x55, 0xb9, 0x71, 0x45, 0x95, 0x6f, 0xc4, 0xc7, 0xc6, 0x36, 0x35, 0xa5,
+	0x44, 0xfb, 0x0f, 0x2b, 0xd0, 0x36, 0xcb, 0x41, 0x36, 0x95, 0x6b, 0x5f, 0xc9, 0x40, 0xa5, 0x91,
+	0xe6, 0xe0, 0xe2, 0x39, 0xbf, 0x52, 0x76, 0xce, 0xd7, 0x4f, 0xd6, 0xd5, 0xab, 0x4c, 0x84, 0xb5,
+	0xf7, 0x33, 0x11, 0xce, 0x96, 0x9a, 0x08, 0xa7, 0x5b, 0x92, 0xe6, 0x7e, 0x51, 0x4b, 0xd2, 0xfc,
+	0xa5, 0x96, 0x24, 0xfb, 0xff, 0x59, 0xc0, 0x8a, 0xdc, 0xcb, 0x9e, 0x09, 0xd3, 0x46, 0xc0, 0x87,
+	0x52, 0x88, 0x7d, 0xe3, 0xfd, 0x56, 0x80, 0x9a, 0x2d, 0xf5, 0x35, 0x2e, 0x45, 0x3d, 0xb8, 0x49,
+	0x57, 0xb1, 0x16, 0xdc, 0x32, 0x52, 0xce, 0x4c, 0x5a, 0xbb, 0xda, 0x4c, 0x3a, 0x7b, 0xb5, 0x99,
+	0x74, 0x2e, 0x6f, 0x26, 0xb5, 0xff, 0xba, 0x05, 0xcb, 0x25, 0x6c, 0xf6, 0x27, 0xd7, 0x71, 0x64,
+	0x0c, 0x43, 0xfa, 0x54, 0x24, 0x63, 0xe8, 0xa0, 0xfd, 0x97, 0x61, 0xc1, 0x58, 0x5a, 0x7f, 0x72,
+	0xf5, 0xe7, 0xb5, 0x44, 0xc1, 0xd9, 0x06, 0x66, 0xff, 0xef, 0x0a, 0xb0, 0xe2, 0xf2, 0xfe, 0x33,
+	0x6d, 0x43, 0x71, 0x9c, 0xaa, 0x25, 0xe3, 0xf4, 0xa7, 0xba, 0xf3, 0x7c, 0x0c, 0x4b, 0x32, 0x62,
+	0x53, 0x33, 0x66, 0x09, 0x8e, 0x29, 0x12, 0x50, 0x4f, 0x36, 0x6d, 0xd4, 0x75, 0x23, 0x42, 0x4d,
+	0xdb, 0x7e, 0x73, 0xa6, 0x6a, 0xc7, 0x86, 0xae, 0x1c, 0xa1, 0x9d, 0x33, 0x1e, 0x24, 0x87, 0x93,
+	0x23, 0x11, 0xb2, 0xe8, 0x87, 0x01, 0xa9, 0x81, 0x3a, 0x51, 0x2a, 0x14, 0xdf, 0x82, 0x96, 0xbe,
+	0x7d, 0xc8, 0xe9, 0xc8, 0xd9, 0x33, 0x51, 0x95, 0xd0, 0x73, 0xb1, 0x6d, 0x68, 0x93, 0x90, 0x1c,
+	0xa4, 0xdf, 0x55, 0xd6, 0xac, 0xcb, 0x6d, 0x34, 0xbb, 0x33, 0x6e, 0xee, 0x1b, 0xf6, 0xeb, 0xd0,
+	0x36, 0x0f, 0x80, 0xdd, 0xea, 0xd4, 0x13, 0x01, 0x7e, 0x6e, 0x66, 0x66, 0x9b, 0xd0, 0xc9, 0x9f,
+	0x20, 0xbb, 0xb5, 0xcb, 0x0a, 0x28, 0x64, 0x67, 0x9f, 0x49, 0x87, 0xe5, 0x2c, 0xd9, 0x4e, 0xee,
+	0x9a, 0x9f, 0x69, 0xc3, 0xb4, 0x2e, 0xfe, 0x68, 0x2e, 0xcc, 0xdf, 0x02, 0xc8, 0x30, 0xb4, 0x92,
+	0xbc, 0x3c, 0xd8, 0xd9, 0xef, 0x6d, 0xed, 0x6e, 0xee, 0xef, 0xef, 0xec, 0x75, 0x66, 0x18, 0x83,
+	0x36, 0x99, 0xfa, 0xb6, 0x53, 0xcc, 0x42, 0x4c, 0x1a, 0x57, 0x14, 0x56, 0x41, 0x3b, 0xe0, 0xf3,
+	0xfd, 0x1c, 0x5a, 0x7d, 0xd2, 0x48, 0xd7, 0x07, 0xc6, 0xe5, 0x8a, 0x88, 0xdc, 0x27, 0x82, 0x3d,
+	0x94, 0x76, 0xf2, 0x8f, 0x2d, 0xb8, 0x9e, 0x23, 0x64, 0x21, 0x5f, 0x42, 0x01, 0x31, 0xb5, 0x12,
+	0x13, 0x24, 0x07, 0x84, 0xd2, 0x35, 0x73, 0x12, 0xa4, 0x48, 0x40, 0x9e, 0x9f, 0x04, 0x05, 0x58,
+	0xae, 0xa4, 0x32, 0x92, 0xb3, 0x9a, 0x46, 0xd6, 0xe4, 0x1a, 0x7e, 0x0c, 0x2b, 0x79, 0x42, 0xe6,
+	0x00, 0x36, 0x9b, 0xac, 0x92, 0x78, 0xac, 0x30, 0x94, 0x1d, 0xb3, 0xbd, 0xa5, 0x34, 0xe7, 0xdf,
+	0x55, 0x81, 0xfd, 0xe6, 0x84, 0x47, 0x17, 0x14, 0xd3, 0x95, 0x5a, 0x4e, 0x57, 0xf3, 0x76, 0x41,
+	0x74, 0xbc, 0x7e, 0x97, 0x5f, 0xa8, 0x60, 0xc9, 0xca, 0x7b, 0x05, 0x4b, 0x96, 0x05, 0x2b, 0xd6,
+	0xae, 0x0e, 0x56, 0x9c, 0xbd, 0x2a, 0x58, 0x11, 0x7d, 0x1b, 0x27, 0x41, 0x88, 0xe2, 0x00, 0x55,
+	0x08, 0x0c, 0x17, 0xae, 0xe2, 0xd1, 0x5b, 0x82, 0xfb, 0x88, 0xb1, 0xc7, 0x59, 0x26, 0x3e, 0x38,
+	0xa1, 0xe0, 0x5a, 0x5d, 0x40, 0xec, 0x0c, 0x4e, 0xf8, 0x5e, 0xd8, 0xf7, 0x92, 0x30, 0xa2, 0x73,
+	0x9a, 0xfa, 0x18, 0x71, 0x34, 0xb1, 0xb4, 0xe3, 0x70, 0x82, 0x4a, 0x95, 0x1a, 0x06, 0x61, 0x68,
+	0x6a, 0x09, 0xf4, 0x40, 0x0c, 0xc6, 0x3a, 0x2c, 0x4f, 0x62, 0xde, 0x1b, 0xf9, 0x31, 0x5a, 0x73,
+	0xf0, 0xfc, 0x92, 0x44, 0xe1, 0x50, 0x9a, 0x9b, 0x96, 0x26, 0x31, 0x7f, 0x21, 0x28, 0x5b, 0x82,
+	0xc0, 0xbe, 0x95, 0x35, 0x69, 0xec, 0xf9, 0x51, 0xdc, 0x85, 0xb5, 0xaa, 0xd6, 0x53, 0x6c, 0xf7,
+	0x81, 0xe7, 0x47, 0x69, 0x5b, 0x30, 0x11, 0xe7, 0x82, 0x28, 0x9b, 0xb9, 0x20, 0x4a, 0x19, 0x7f,
+	0xb7, 0x0e, 0x75, 0xf5, 0x39, 0x9e, 0x81, 0x8f, 0xa3, 0x70, 0xa4, 0xce, 0xc0, 0xf8, 0x9b, 0xb5,
+	0xa1, 0x92, 0x84, 0xf2, 0xfc, 0x5a, 0x49, 0x42, 0xe7, 0xb7, 0xa1, 0xa9, 0x8d, 0x00, 0xfb, 0x10,
+	0x40, 0xe9, 0x5a, 0xf2, 0xf0, 0x2c, 0xbc, 0x28, 0x0d, 0x89, 0x3e, 0x1f, 0xe0, 0xc5, 0x82, 0x81,
+	0x1f, 0x71, 0x8a, 0xf3, 0xed, 0x45, 0x1c, 0x4d, 0x58, 0xca, 0xd4, 0xd0, 0x49, 0x09, 0xae, 0xc0,
+	0x9d, 0x1e, 0x2c, 0x1b, 0x5c, 0x95, 0x2e, 0xba, 0x39, 0x0a, 0x1a, 0x54, 0xd6, 0x4e, 0x33, 0xa0,
+	0x50, 0xd2, 0x70, 0xbb, 0x92, 0x56, 0x92, 0xde, 0x38, 0x0a, 0x8f, 0xa8, 0x12, 0xcb, 0x35, 0x30,
+	0xb4, 0x60, 0x57, 0x77, 0xc3, 0xb1, 0xee, 0xfb, 0xb1, 0x8a, 0xbe, 0x1f, 0xa9, 0x57, 0xf6, 0x52,
+	0xb5, 0x51, 0x6e, 0xfe, 0x06, 0xc8, 0x1e, 0x40, 0x1b, 0x39, 0x38, 0x09, 0x51, 0x8f, 0x3e, 0xf7,
+	0x22, 0x11, 0x61, 0x58, 0x25, 0xb6, 0xc8, 0x51, 0xd8, 0x35, 0xa8, 0xa6, 0xea, 0x10, 0x65, 0xc0,
+	0x24, 0x1e, 0xe2, 0xc8, 0xbf, 0x7e, 0x21, 0x4d, 0x9a, 0x32, 0x85, 0x02, 0xc1, 0xfc, 0x5e, 0x2c,
+	0x13, 0xb1, 0xa9, 0x95, 0x91, 0x50, 0xc7, 0xc5, 0x85, 0x30, 0xca, 0x54, 0xc6, 0x34, 0xad, 0x1b,
+	0xeb, 0xeb, 0xa6, 0xb1, 0x7e, 0x0d, 0x9a, 0xc9, 0xf0, 0xac, 0x37, 0xf6, 0x2e, 0x86, 0xa1, 0x37,
+	0x90, 0x0c, 0xa8, 0x43, 0xec, 0x11, 0xc0, 0x68, 0x3c, 0x46, 0x43, 0x3a, 0x5e, 0x2d, 0x00, 0x5a,
+	0x61, 0x1d, 0x39, 0xfa, 0x2f, 0x0e, 0x0e, 0x5c, 0xc2, 0x5d, 0x2d, 0x8f, 0xf3, 0x06, 0x1a, 0x29,
+	0x41, 0x0f, 0x4d, 0xa5, 0x08, 0x8b, 0xa6, 0x19, 0x9a, 0x8a, 0x18, 0x2a, 0xd7, 0x42, 0x78, 0xa6,
+	0xe2, 0x40, 0x78, 0xc5, 0x73, 0xa8, 0xf3, 0xc7, 0x16, 0xcc, 0xd2, 0x84, 0xa3, 0x36, 0x21, 0x68,
+	0xa9, 0xaf, 0x8a, 0x26, 0x71, 0xc1, 0xcd, 0xc3, 0xcc, 0x31, 0xc2, 0xe4, 0x2b, 0xe9, 0xe8, 0x6b,
+	0x28, 0x5b, 0x83, 0x46, 0x5a, 0x93, 0x36, 0x83, 0x19, 0xc8, 0xee, 0x60, 0xc4, 0xdc, 0x58, 0x1d,
+	0xb8, 0x40, 0xb9, 0xb3, 0xc3, 0xb1, 0x4b, 0x78, 0xd6, 0x1e, 0x2c, 0x4f, 0x74, 0x41, 0x28, 0xb5,
+	0x79, 0xb8, 0xa4, 0xaf, 0x73, 0xa5, 0x7d, 0x7d, 0x0d, 0x8b, 0xb8, 0x2c, 0x35, 0xdb, 0xfd, 0x74,
+	0xd1, 0xfa, 0xab, 0xb8, 0x53, 0xf7, 0x87, 0x93, 0x01, 0xd7, 0x8f, 0xbd, 0x64, 0x9b, 0x95, 0xb8,
+	0x52, 0xf8, 0x9c, 0x7f, 0x61, 0x41, 0x5d, 0x95, 0xcb, 0xee, 0x43, 0x0d, 0xa5, 0x60, 0xce, 0xca,
+	0x91, 0x46, 0xbd, 0x60, 0x3e, 0x97, 0x72, 0xe0, 0x2c, 0x92, 0xf5, 0x54, 0x2f, 0x7d, 0xc1, 0x35,
+	0xb0, 0xac, 0x67, 0xb9, 0xa3, 0x56, 0x0e, 0x65, 0xeb, 0x9a, 0xeb, 0xa9, 0x66, 0x48, 0x56, 0xa5,
+	0x18, 0x0c, 0x4e, 0xb8, 0xe6, 0x72, 0xfa, 0x7d, 0x0b, 0x16, 0x8c, 0x36, 0x21, 0xd3, 0x52, 0x10,
+	0xb9, 0x30, 0x9a, 0xc8, 0x99, 0xd7, 0x21, 0x9d, 0xe1, 0x2b, 0x26, 0xc3, 0xa7, 0x2e, 0x8c, 0xaa,
+	0xee, 0xc2, 0x78, 0x04, 0x8d, 0xec, 0x9e, 0x84, 0xd9, 0x28, 0xac, 0x51, 0xc5, 0xff, 0x64, 0x99,
+	0x32, 0x23, 0xf9, 0xac, 0x66, 0x24, 0x77, 0x1e, 0x43, 0x53, 0xcb, 0xaf, 0x1b, 0xb9, 0x2d, 0xc3,
+	0xc8, 0x9d, 0x06, 0xc7, 0x55, 0xb2, 0xe0, 0x38, 0xe7, 0x67, 0x15, 0x58, 0x40, 0xf6, 0xf6, 0x83,
+	0x93, 0x83, 0x70, 0xe8, 0xf7, 0x2f, 0x88, 0xad, 0x14, 0x27, 0xcb, 0x5d, 0x50, 0xb1, 0xb9, 0x09,
+	0xe3, 0xea, 0x4f, 0x23, 0x82, 0x85, 0xa8, 0x4a, 0xd3, 0x28, 0xcb, 0x50, 0x12, 0x1c, 0x79, 0x31,
+	0xd7, 0x2e, 0x4e, 0xb8, 0x26, 0x88, 0x12, 0x07, 0x01, 0x0a, 0x75, 0x1c, 0xf9, 0xc3, 0xa1, 0x2f,
+	0xf2, 0x8a, 0xe3, 0x5b, 0x19, 0x09, 0xeb, 0x1c, 0xf8, 0xb1, 0x77, 0x94, 0xb9, 0x27, 0xd3, 0x34,
+	0xd6, 0x89, 0x61, 0x71, 0x99, 0xed, 0x4f, 0xc4, 0x46, 0x9b, 0x60, 0x7e, 0x22, 0xe7, 0x0b, 0x13,
+	0xe9, 0xfc, 0xdb, 0x0a, 0x34, 0x35, 0xb6, 0xc0, 0xe5, 0x5c, 0xba, 0xdd, 0x68, 0xa8, 0xf4, 0xdb,
+	0x07, 0x86, 0x41, 0x40, 0x43, 0xd8, 0x5d, 0xb3, 0x56, 0xf2, 0x03, 0xd0, 0x82, 0xd7, 0x61, 0xf2,
+	0x37, 0x85, 0x03, 0xfe, 0x09, 0x59, 0x1f, 0xe4, 0x25, 0xa5, 0x14, 0x50, 0xd4, 0x0d, 0xa2, 0xce,
+	0x66, 0x54, 0x02, 0x2e, 0xf5, 0xe4, 0x7f, 0x06, 0x2d, 0x59, 0x0c, 0xcd, 0x71, 0x77, 0xde, 0x58,
+	0x7c, 0xc6, 0xfc, 0xbb, 0x46, 0x4e, 0xf5, 0xe5, 0x86, 0xfa, 0xb2, 0x7e, 0xd5, 0x97, 0x2a, 0xa7,
+	0xf3, 0x2c, 0x0d, 0x92, 0x78, 0x86, 0x1e, 0x1a, 0x25, 0x50, 0x1e, 0xc1, 0xb2, 0x92, 0x1b, 0x93,
+	0xc0, 0x0b, 0x82, 0x70, 0x82, 0x8e, 0x1c, 0x69, 0x68, 0x2c, 0x23, 0x39, 0x03, 0x68, 0xe9, 0x05,
+	0xb1, 0x07, 0x30, 0x2b, 0xf4, 0x28, 0xb1, 0x2b, 0x97, 0x8b, 0x10, 0x91, 0x85, 0xdd, 0x87, 0x59,
+	0xa1, 0x4e, 0x55, 0xa6, 0x2e, 0x7a, 0x91, 0xc1, 0x59, 0x87, 0x45, 0x44, 0x75, 0xd9, 0x77, 0xb3,
+	0x6c, 0xb7, 0x9e, 0xeb, 0x8b, 0x60, 0xf0, 0x6b, 0x18, 0xef, 0x48, 0xeb, 0x4a, 0xfb, 0xc4, 0xf9,
+	0xe3, 0x2a, 0x34, 0x35, 0x18, 0xe5, 0x13, 0xf9, 0xa7, 0x7a, 0x03, 0xdf, 0x1b, 0xf1, 0x84, 0x47,
+	0x72, 0x2d, 0xe5, 0x50, 0xcc, 0xe7, 0x9d, 0x9d, 0xf4, 0xc2, 0x49, 0xd2, 0x1b, 0xf0, 0x93, 0x88,
+	0x73, 0xa9, 0x46, 0xe4, 0x50, 0xcc, 0x87, 0xdc, 0xac, 0xe5, 0x13, 0x1e, 0xa5, 0x1c, 0xaa, 0x1c,
+	0x97, 0x62, 0x9c, 0x6a, 0x99, 0xe3, 0x52, 0x8c, 0x4a, 0x5e, 0xb2, 0xce, 0x96, 0x48, 0xd6, 0x4f,
+	0x61, 0x45, 0xc8, 0x50, 0x29, 0x3d, 0x7a, 0x39, 0xe6, 0x9a, 0x42, 0x45, 0xd3, 0x3a, 0xb6, 0x59,
+	0x2d, 0x8d, 0xd8, 0xff, 0xa9, 0x58, 0x63, 0x96, 0x5b, 0xc0, 0x31, 0x2f, 0x59, 0xd2, 0xf5, 0xbc,
+	0x22, 0x7a, 0xa4, 0x80, 0x53, 0x5e, 0xef, 0x9d, 0x81, 0x49, 0xdb, 0x7e, 0x01, 0x47, 0xc3, 0xd6,
+	0x88, 0x0f, 0x7c, 0xcf, 0x2c, 0xa2, 0x97, 0x6d, 0xf2, 0xd3, 0xc8, 0x58, 0x0b, 0x8e, 0xc2, 0x4f,
+	0xc3, 0xd1, 0x91, 0x2f, 0x36, 0x36, 0x61, 0xf3, 0xaf, 0xb9, 0x05, 0xdc, 0x59, 0x80, 0xe6, 0x61,
+	0x12, 0x8e, 0xd5, 0xd4, 0xb7, 0xa1, 0x25, 0x92, 0x32, 0x72, 0xf2, 0x26, 0xdc, 0x20, 0x7e, 0x7d,
+	0x15, 0x8e, 0xc3, 0x61, 0x78, 0x72, 0x61, 0x9c, 0xdc, 0xff, 0xa3, 0x05, 0xcb, 0x06, 0x35, 0x3b,
+	0xba, 0x93, 0x99, 0x51, 0x85, 0xbb, 0x09, 0x16, 0x5f, 0xd2, 0xb6, 0x05, 0x91, 0x51, 0x78, 0x75,
+	0xc4, 0xef, 0x98, 0x6d, 0x66, 0x77, 0x38, 0xd4, 0x87, 0x82, 0xdf, 0xbb, 0x45, 0x7e, 0x97, 0xdf,
+	0xab, 0xdb, 0x1d, 0xaa, 0x88, 0x5f, 0x87, 0x96, 0x76, 0x92, 0x57, 0x56, 0xe5, 0xf4, 0xec, 0xaf,
+	0x5b, 0x7a, 0x54, 0x0b, 0xfa, 0x29, 0x18, 0xe3, 0xd5, 0x08, 0xc8, 0x5a, 0x87, 0xec, 0x97, 0x6d,
+	0x6d, 0xe2, 0x5e, 0x72, 0x06, 0xa0, 0xe7, 0x34, 0x75, 0xf2, 0x67, 0xbb, 0x65, 0x53, 0x61, 0xa8,
+	0x5d, 0xdc, 0x83, 0xc5, 0x93, 0x61, 0x78, 0x44, 0x5a, 0x0c, 0x85, 0xe2, 0xc6, 0x32, 0x7e, 0xb4,
+	0x2d, 0xe0, 0xa7, 0x12, 0xcd, 0xb6, 0xd6, 0x9a, 0xbe, 0xb5, 0x96, 0x6f, 0x94, 0x3f, 0xab, 0xc0,
+	0x52, 0x61, 0x24, 0x2e, 0x5d, 0xe5, 0x6c, 0xa3, 0x20, 0xd6, 0xa7, 0x38, 0x37, 0xe9, 0xe8, 0x71,
+	0x70, 0xa5, 0xe1, 0xf7, 0x31, 0xb4, 0x23, 0x21, 0x33, 0x95, 0x40, 0xad, 0x5d, 0x22, 0x50, 0x17,
+	0x22, 0x3d, 0x89, 0x2a, 0x97, 0x37, 0x38, 0xe3, 0x51, 0xe2, 0x93, 0x21, 0x8c, 0xd4, 0x28, 0xd1,
+	0xc1, 0x45, 0x0d, 0x27, 0x6d, 0x05, 0x6f, 0xf5, 0x88, 0x68, 0xde, 0x34, 0xa7, 0xbc, 0xa1, 0x97,
+	0xc1, 0x98, 0xd1, 0xf9, 0xa7, 0xca, 0xb1, 0x6b, 0xce, 0xee, 0xe5, 0xa3, 0xa2, 0xf7, 0xb0, 0x92,
+	0xeb, 0xe1, 0xd7, 0xa4, 0xa3, 0x75, 0xa0, 0x2c, 0x6e, 0x55, 0x2d, 0x6a, 0x6c, 0x20, 0x1d, 0xe3,
+	0xe6, 0xb0, 0xd6, 0xde, 0x67, 0x58, 0x9d, 0xff, 0x62, 0xc1, 0xfc, 0x6e, 0x38, 0xde, 0xc5, 0x21,
+	0x46, 0x1d, 0x07, 0x97, 0x49, 0x1a, 0x4a, 0xaf, 0x92, 0x57, 0x44, 0xd7, 0x95, 0x6a, 0x25, 0x0b,
+	0x79, 0xad, 0xe4, 0x2f, 0xc2, 0x4d, 0x04, 0xc6, 0x51, 0x38, 0x0e, 0x23, 0x5c, 0xae, 0xde, 0x50,
+	0xa8, 0x20, 0x61, 0x90, 0x9c, 0x2a, 0x71, 0x7a, 0x59, 0x16, 0x32, 0xc4, 0xe0, 0x21, 0x58, 0x1c,
+	0xac, 0xa4, 0x16, 0x25, 0xa4, 0x6c, 0x91, 0xe0, 0xfc, 0x1a, 0x34, 0xe8, 0x84, 0x41, 0x5d, 0xfb,
+	0x18, 0x1a, 0x78, 0x4f, 0xf1, 0xd4, 0x0f, 0x12, 0xb5, 0xfc, 0xdb, 0x99, 0xea, 0xbf, 0x4b, 0x83,
+	0x92, 0x66, 0x70, 0xfe, 0x68, 0x0e, 0xe6, 0x9f, 0x07, 0x67, 0xa1, 0xdf, 0x27, 0x67, 0xf2, 0x88,
+	0x8f, 0x42, 0x75, 0xb9, 0x00, 0x7f, 0xe3, 0x70, 0x50, 0x24, 0xed, 0x58, 0x30, 0x6f, 0x4b, 0x04,
+	0x8d, 0x48, 0x88, 0xae, 0xe1, 0x66, 0x97, 0x03, 0xc5, 0x02, 0xd3, 0x10, 0x3c, 0x28, 0x46, 0xfa,
+	0xe5, 0x3e, 0x99, 0xca, 0x2e, 0x6f, 0xcc, 0x6a, 0x97, 0x37, 0xb0, 0x34, 0xfa, 0x21, 0x46, 0x56,
+	0x84, 0x8c, 0x6a, 0x08, 0xb6, 0x45, 0xc6, 0x04, 0x8a, 0xa0, 0x31, 0xd1, 0x16, 0x09, 0xd1, 0xe1,
+	0x37, 0xe2, 0xc2, 0xa6, 0x9f, 0x2a, 0x66, 0x55, 0xd7, 0x04, 0x51, 0x79, 0x13, 0x1f, 0x88, 0x3c,
+	0x62, 0xb3, 0xd0, 0x21, 0x54, 0x5f, 0xf3, 0x77, 0x53, 0xc5, 0xfd, 0xe2, 0x3c, 0x8c, 0xb2, 0x7e,
+	0xc0, 0x53, 0x91, 0x2c, 0xfa, 0x09, 0xe2, 0x82, 0x64, 0x1e, 0xd7, 0x8e, 0xcc, 0x22, 0x28, 0x5a,
+	0xa6, 0x88, 0xa1, 0xbc, 0xe1, 0x10, 0x6f, 0xe8, 0x8b, 0xa3, 0x66, 0x4b, 0xb8, 0x82, 0x0c, 0x10,
+	0x5b, 0xad, 0xcd, 0x3a, 0x85, 0xdf, 0xd4, 0x5c, 0x1d, 0x62, 0x1b, 0xd0, 0x24, 0x73, 0x82, 0x9c,
+	0xf7, 0xf6, 0x5a, 0x55, 0x3b, 0xf1, 0xa6, 0xcc, 0xe1, 0xea, 0x99, 0x74, 0x27, 0xf8, 0x62, 0x21,
+	0x4c, 0xd9, 0x1b, 0x0c, 0x64, 0xfc, 0x40, 0x87, 0x6a, 0xcb, 0x00, 0x32, 0x58, 0x88, 0x01, 0x13,
+	0x19, 0x96, 0x28, 0x83, 0x81, 0xb1, 0x3b, 0xc2, 0x4c, 0x36, 0xf6, 0xfc, 0x41, 0x97, 0xa5, 0x87,
+	0xd3, 0x14, 0xc3, 0x32, 0xd4, 0x6f, 0xda, 0x56, 0x97, 0x69, 0x54, 0x0c, 0x0c, 0xc7, 0x26, 0x4d,
+	0x8f, 0xb2, 0xb8, 0x66, 0x13, 0x64, 0x9f, 0x90, 0x07, 0x37, 0xe1, 0x14, 0xbc, 0xdc, 0xde, 0xb8,
+	0x29, 0xfb, 0x2c, 0x99, 0x5a, 0xfd, 0x45, 0x87, 0x39, 0x77, 0x45, 0x4e, 0x54, 0xea, 0x84, 0x11,
+	0x7d, 0xc5, 0x50, 0xea, 0x64, 0x56, 0x32, 0xa2, 0x8b, 0x0c, 0xce, 0x26, 0xb4, 0xf4, 0x02, 0x58,
+	0x1d, 0x6a, 0x68, 0xd3, 0xed, 0xcc, 0xb0, 0x26, 0xcc, 0x1f, 0xee, 0xbc, 0x7a, 0x85, 0x21, 0x9a,
+	0x16, 0x6b, 0x41, 0x3d, 0x0d, 0xd8, 0xac, 0x60, 0x6a, 0x73, 0x6b, 0x6b, 0xe7, 0xe0, 0xd5, 0xce,
+	0x76, 0xa7, 0xea, 0xfc, 0x5e, 0x05, 0x9a, 0x5a, 0xc9, 0x57, 0x98, 0x70, 0xee, 0x00, 0x60, 0xcd,
+	0x5a, 0xe8, 0x46, 0xcd, 0xd5, 0x10, 0x94, 0x9c, 0xe9, 0x19, 0xbc, 0x4a, 0xd4, 0x34, 0x4d, 0xe3,
+	0x45, 0x17, 0x12, 0x75, 0x5f, 0xc5, 0xac, 0x6b, 0x82, 0xc8, 0x4b, 0x12, 0xa0, 0xf8, 0x41, 0xb1,
+	0x02, 0x75, 0x08, 0xe7, 0x26, 0xe2, 0x71, 0x38, 0x3c, 0xe3, 0x22, 0x8b, 0xd0, 0xd7, 0x0c, 0x0c,
+	0xeb, 0x92, 0x22, 0x48, 0x8b, 0xed, 0x9d, 0x75, 0x4d, 0x90, 0x7d, 0x43, 0xcd, 0x4d, 0x9d, 0xe6,
+	0x66, 0xb5, 0x38, 0xd0, 0xfa, 0xbc, 0x38, 0x09, 0xb0, 0xcd, 0xc1, 0x40, 0x52, 0xf5, 0x5b, 0x97,
+	0x91, 0x7e, 0xcd, 0x57, 0xa6, 0xca, 0x16, 0x6a, 0xa5, 0x7c, 0xa1, 0x5e, 0xca, 0xce, 0xce, 0x73,
+	0x68, 0x1e, 0x68, 0x17, 0x87, 0x1d, 0x00, 0x51, 0x01, 0xdd, 0x6a, 0xb4, 0xb2, 0x3b, 0xf4, 0x19,
+	0xaa, 0x35, 0xa9, 0xa2, 0x37, 0xc9, 0xf9, 0x27, 0x96, 0xb8, 0x8b, 0x95, 0x76, 0x41, 0xd4, 0x8f,
+	0xe6, 0x24, 0x65, 0x8d, 0xce, 0xc2, 0xd7, 0x0d, 0x0c, 0xf3, 0x50, 0x73, 0x7a, 0xe1, 0xf1, 0x71,
+	0xcc, 0x55, 0xa0, 0xa9, 0x81, 0x29, 0xe5, 0x12, 0xd5, 0x55, 0x5f, 0xd4, 0x10, 0xcb, 0x80, 0xd3,
+	0x02, 0x8e, 0x8c, 0x22, 0xad, 0x96, 0x2a, 0xc4, 0x36, 0x4d, 0xa7, 0x51, 0xf6, 0xf9, 0x91, 0x7e,
+	0x80, 0x81, 0x1b, 0xb2, 0x5c, 0x73, 0xe7, 0x50, 0x39, 0x53, 0x3a, 0xee, 0x50, 0x74, 0xf0, 0x34,
+	0x1a, 0x2d, 0xf8, 0xb5, 0x48, 0x60, 0xeb, 0xc0, 0x8e, 0xfd, 0x28, 0x9f, 0x5d, 0x30, 0x70, 0x09,
+	0xc5, 0x79, 0x03, 0xcb, 0x6a, 0xdd, 0x69, 0x5a, 0xaf, 0x39, 0x91, 0xd6, 0x55, 0x72, 0xa9, 0x52,
+	0x94, 0x4b, 0xce, 0xbf, 0xa9, 0xc1, 0xbc, 0x9c, 0xed, 0xc2, 0x05, 0x74, 0xb1, 0xef, 0x19, 0x18,
+	0xeb, 0x1a, 0xd7, 0x0c, 0x89, 0x11, 0x04, 0xc0, 0xee, 0xe7, 0xf7, 0x9b, 0xcc, 0x06, 0x67, 0x12,
+	0xd8, 0x0a, 0xd4, 0xc6, 0x5e, 0x72, 0x4a, 0x26, 0x1a, 0xc1, 0x4b, 0x94, 0x56, 0xc6, 0xd5, 0x59,
+	0xd3, 0xb8, 0x5a, 0x76, 0xed, 0x5e, 0xa8, 0x5e, 0x05, 0x1c, 0xc7, 0x43, 0xec, 0x8f, 0x99, 0xfd,
+	0x34, 0x03, 0x72, 0xfb, 0x69, 0xbd, 0xb0, 0x9f, 0xbe, 0xff, 0x4e, 0xf7, 0x2d, 0x98, 0x13, 0x57,
+	0x4f, 0x64, 0x40, 0xf1, 0x2d, 0xe5, 0x76, 0x14, 0xf9, 0xd4, 0x5f, 0x11, 0x95, 0xe4, 0xca, 0xbc,
+	0xfa, 0xe5, 0xd5, 0xa6, 0x79, 0x79, 0x55, 0x37, 0xfb, 0xb6, 0x72, 0x66, 0xdf, 0x07, 0xd0, 0x49,
+	0x87, 0x8f, 0x0c, 0x46, 0x41, 0x2c, 0xe3, 0x4d, 0x0b, 0x78, 0x26, 0xc8, 0xdb, 0x86, 0x20, 0x47,
+	0xc1, 0xb2, 0x99, 0x24, 0x7c, 0x34, 0x4e, 0x94, 0x20, 0x7f, 0x0a, 0x0b, 0x46, 0x23, 0x51, 0x7e,
+	0xcb, 0x30, 0xe7, 0xce, 0x0c, 0x86, 0xd8, 0x3f, 0xdf, 0xef, 0x3d, 0xdd, 0x7b, 0xfe, 0x6c, 0xf7,
+	0x55, 0xc7, 0xc2, 0xe4, 0xe1, 0xeb, 0xad, 0xad, 0x9d, 0x9d, 0x6d, 0x92, 0xe7, 0x00, 0x73, 0x4f,
+	0x37, 0x9f, 0xef, 0x91, 0x34, 0xff, 0xbf, 0x16, 0x34, 0xb5, 0xe2, 0xd9, 0xb7, 0xd3, 0x91, 0x11,
+	0xf7, 0x1b, 0x6f, 0x17, 0x9b, 0xb0, 0xae, 0xe4, 0x9c, 0x36, 0x34, 0xe9, 0x4b, 0x03, 0x95, 0xa9,
+	0x2f, 0x0d, 0xe0, 0xf4, 0x78, 0xa2, 0x84, 0x74, 0x1c, 0xc4, 0x69, 0x20, 0x0f, 0x8b, 0xc8, 0x93,
+	0x4c, 0x38, 0x63, 0x4e, 0x61, 0x01, 0xcb, 0xc3, 0xce, 0xa7, 0x00, 0x59, 0x6b, 0xcc, 0x6e, 0xcf,
+	0x98, 0xdd, 0xb6, 0xb4, 0x6e, 0x57, 0x9c, 0x6d, 0x21, 0x30, 0xe4, 0x10, 0xa6, 0x7e, 0xb3, 0x6f,
+	0x00, 0x53, 0x06, 0x17, 0x8a, 0xf0, 0x1a, 0x0f, 0x79, 0xa2, 0x2e, 0x1e, 0x2c, 0x49, 0xca, 0xf3,
+	0x94, 0xa0, 0xee, 0xce, 0x64, 0xa5, 0x64, 0x72, 0x47, 0x72, 0x5c, 0x5e, 0xee, 0xc8, 0xac, 0x6e,
+	0x4a, 0x47, 0x77, 0xf6, 0x36, 0xc7, 0xd2, 0x36, 0x87, 0xc3, 0x5c, 0x73, 0xf0, 0xc4, 0x5c, 0x42,
+	0x93, 0xc7, 0xe9, 0x13, 0xb8, 0xbe, 0x29, 0xee, 0x18, 0xfc, 0xe9, 0x86, 0xa0, 0x62, 0xf0, 0x58,
+	0xbe, 0x22, 0xd9, 0x84, 0xa7, 0xb0, 0xb4, 0xcd, 0x8f, 0x26, 0x27, 0x7b, 0xfc, 0x2c, 0xab, 0x9e,
+	0x41, 0x2d, 0x3e, 0x0d, 0xcf, 0xe5, 0xa8, 0xd1, 0x6f, 0xf4, 0x81, 0x0d, 0x31, 0x4f, 0x2f, 0x1e,
+	0xf3, 0xbe, 0xba, 0x55, 0x4a, 0xc8, 0xe1, 0x98, 0xf7, 0x9d, 0x4f, 0x81, 0xe9, 0xe5, 0xc8, 0x51,
+	0x44, 0xd5, 0x76, 0x72, 0xd4, 0x8b, 0x2f, 0xe2, 0x84, 0x8f, 0xd4, 0x75, 0x59, 0x1d, 0x72, 0xee,
+	0x41, 0xeb, 0xc0, 0xc3, 0xbb, 0xdc, 0xf2, 0xbd, 0x0b, 0xb4, 0xcd, 0x7b, 0x17, 0xb8, 0xca, 0x53,
+	0xdb, 0x3c, 0x91, 0x9d, 0xff, 0x53, 0x81, 0x39, 0x91, 0x13, 0x4b, 0x1d, 0xf0, 0x38, 0xf1, 0x03,
+	0x5a, 0x79, 0xaa, 0x54, 0x0d, 0x2a, 0x88, 0xd1, 0x4a, 0x89, 0x18, 0x95, 0x06, 0x23, 0x75, 0x3b,
+	0x4f, 0x32, 0xb2, 0x81, 0xa1, 0x30, 0xcb, 0x22, 0xcc, 0x05, 0xff, 0x66, 0x40, 0xce, 0xe7, 0x94,
+	0x29, 0xd0, 0xa2, 0x7d, 0x6a, 0x87, 0x90, 0x92, 0x52, 0x87, 0x4a, 0xd5, 0xf4, 0x79, 0x21, 0x50,
+	0xf3, 0x78, 0x51, 0x1d, 0xaf, 0xbf, 0x87, 0x3a, 0x2e, 0xac, 0x48, 0x97, 0xa9, 0xe3, 0xf0, 0x1e,
+	0xea, 0x38, 0xde, 0xa1, 0xa0, 0xab, 0xff, 0x78, 0x20, 0x54, 0x1c, 0xfd, 0x0f, 0x2c, 0xe8, 0x48,
+	0x2e, 0x4a, 0x69, 0xca, 0x7b, 0x79, 0xd9, 0x1d, 0xb1, 0xbb, 0xb0, 0x40, 0xc7, 0xd1, 0x54, 0xca,
+	0x4a, 0x4f, 0xa0, 0x01, 0x62, 0x3f, 0x54, 0x6c, 0xd2, 0xc8, 0x1f, 0xca, 0x49, 0xd1, 0x21, 0x25,
+	0xa8, 0x23, 0x4f, 0x46, 0x4a, 0x5b, 0x6e, 0x9a, 0x76, 0xfe, 0xd0, 0x82, 0x25, 0xad, 0xc1, 0x92,
+	0x0b, 0x1f, 0x83, 0x5a, 0x23, 0xc2, 0x79, 0x25, 0xd6, 0xf3, 0xaa, 0xb9, 0x98, 0xb2, 0xcf, 0x8c,
+	0xcc, 0x34, 0x99, 0xde, 0x05, 0x35, 0x30, 0x9e, 0x8c, 0xe4, 0x06, 0xae, 0x43, 0xc8, 0x48, 0xe7,
+	0x9c, 0xbf, 0x4d, 0xb3, 0x08, 0x15, 0xc2, 0xc0, 0xc8, 0x8c, 0x8f, 0xc7, 0xe8, 0x34, 0x53, 0x4d,
+	0x9a, 0xf1, 0x75, 0xd0, 0xf9, 0xab, 0x15, 0x58, 0x16, 0x76, 0x11, 0x69, 0x8f, 0x4a, 0x2f, 0x39,
+	0xcf, 0x09, 0x13, 0x91, 0x58, 0x91, 0xbb, 0x33, 0xae, 0x4c, 0xb3, 0x6f, 0xbf, 0xa7, 0x2d, 0x27,
+	0x0d, 0xdf, 0x9e, 0x32, 0x17, 0xd5, 0xb2, 0xb9, 0xb8, 0x64, 0xa4, 0xcb, 0x3c, 0x2a, 0xb3, 0xe5,
+	0x1e, 0x95, 0xf7, 0xf2, 0x60, 0xe0, 0xa3, 0x52, 0x71, 0x3f, 0x1c, 0x73, 0x8c, 0x23, 0x31, 0x87,
+	0x40, 0x0a, 0xaa, 0x9f, 0x5b, 0xd0, 0x7d, 0x2a, 0xfc, 0xb4, 0x18, 0x55, 0xe4, 0xc7, 0x49, 0x18,
+	0xa5, 0x2f, 0x46, 0xdc, 0x01, 0x88, 0x13, 0x2f, 0x92, 0xe7, 0x07, 0xa1, 0x85, 0x69, 0x08, 0xf6,
+	0x84, 0x07, 0x03, 0x41, 0x15, 0x33, 0x98, 0xa6, 0x0b, 0x5a, 0xae, 0xb4, 0xed, 0xe8, 0x18, 0x9a,
+	0xa8, 0x95, 0x36, 0xcb, 0xcf, 0x68, 0x4f, 0x10, 0x06, 0x93, 0x1c, 0xea, 0xfc, 0x4e, 0x05, 0x16,
+	0xb3, 0x46, 0x52, 0x60, 0x8e, 0x29, 0x43, 0xa4, 0x82, 0x98, 0x02, 0xca, 0x0f, 0xd3, 0xf3, 0x51,
+	0x63, 0xd4, 0xcc, 0x3b, 0x1a, 0x8a, 0x7e, 0x16, 0x95, 0x0a, 0x27, 0x89, 0x76, 0x75, 0x5b, 0x87,
+	0x45, 0x18, 0x33, 0xea, 0xac, 0x52, 0xff, 0x96, 0x29, 0xba, 0x46, 0x36, 0x4a, 0xe8, 0x4b, 0x31,
+	0xf2, 0x2a, 0xc9, 0x3a, 0x42, 0xd9, 0x13, 0xaf, 0xe8, 0xe0, 0x4f, 0x43, 0x09, 0xaa, 0xa7, 0x4f,
+	0xde, 0xa4, 0x2b, 0x53, 0x94, 0x98, 0xc5, 0xa0, 0xd7, 0x5c, 0x1d, 0x52, 0x07, 0x68, 0x34, 0xd9,
+	0xa7, 0xce, 0xe7, 0x9a, 0x6b, 0x60, 0xce, 0xdf, 0xb6, 0xe0, 0x46, 0xc9, 0x34, 0xca, 0x95, 0xba,
+	0x0d, 0x4b, 0xc7, 0x29, 0x51, 0x0d, 0xb5, 0x58, 0xae, 0x2b, 0x2a, 0x18, 0xc5, 0x1c, 0x5e, 0xb7,
+	0xf8, 0x41, 0x7a, 0x0e, 0x10, 0x93, 0x67, 0x5c, 0x39, 0x28, 0x12, 0x9c, 0x03, 0xb0, 0x77, 0xde,
+	0xe1, 0xc2, 0xdf, 0xd2, 0x1f, 0x1a, 0x54, 0x9c, 0xb5, 0x51, 0x10, 0x6c, 0x57, 0x5b, 0xf5, 0x8e,
+	0x61, 0xc1, 0x28, 0x8b, 0x7d, 0xf3, 0x7d, 0x0b, 0xd1, 0xd7, 0xe8, 0x9a, 0x9c, 0x75, 0xf1, 0x52,
+	0xa2, 0xba, 0xf8, 0xa0, 0x41, 0xce, 0x19, 0x2c, 0xbe, 0x98, 0x0c, 0x13, 0x3f, 0x7b, 0x35, 0x91,
+	0x7d, 0x1b, 0x9a, 0x59, 0x11, 0x6a, 0xe8, 0x4a, 0xab, 0xd2, 0xf3, 0xe1, 0x88, 0x8d, 0xb0, 0xa4,
+	0x5e, 0xb1, 0xc6, 0x22, 0xc1, 0xb9, 0x01, 0xab, 0x59, 0x95, 0x62, 0xec, 0xd4, 0xe6, 0xf0, 0xbb,
+	0x16, 0xb0, 0x8c, 0xa6, 0x1e, 0x71, 0x64, 0xcf, 0x60, 0x19, 0xcd, 0xb8, 0x43, 0xae, 0x97, 0x13,
+	0xcb, 0x91, 0xb8, 0x6e, 0x36, 0x4f, 0x7c, 0x1a, 0xbb, 0x65, 0x5f, 0x20, 0x83, 0x94, 0x37, 0x34,
+	0x63, 0x90, 0xdc, 0x90, 0x94, 0x75, 0xe0, 0x3b, 0xd0, 0x36, 0x2b, 0x43, 0x97, 0x60, 0xae, 0x65,
+	0xba, 0x1b, 0xce, 0xe4, 0x0c, 0x23, 0xa7, 0xf3, 0x33, 0x0b, 0xba, 0x2e, 0x47, 0x36, 0xe6, 0x5a,
+	0xa5, 0x92, 0x7b, 0x1e, 0x17, 0x8a, 0x9d, 0xde, 0xe1, 0xf4, 0x1e, 0x84, 0xea, 0xeb, 0xfa, 0xd4,
+	0x49, 0xd9, 0x9d, 0x29, 0xe9, 0x15, 0xde, 0x7e, 0x90, 0xfd, 0x5b, 0x85, 0xeb, 0xb2, 0x49, 0xaa,
+	0x39, 0x99, 0xff, 0xc6, 0xa8, 0xd4, 0xf0, 0xdf, 0xd8, 0xd0, 0x15, 0x0f, 0x83, 0xe8, 0xfd, 0x90,
+	0x1f, 0x6e, 0x03, 0x7b, 0xe1, 0xf5, 0xbd, 0x28, 0x0c, 0x83, 0x03, 0x1e, 0xc9, 0x70, 0x2b, 0x52,
+	0x80, 0xc8, 0xbd, 0xa1, 0x74, 0x35, 0x91, 0x52, 0x6f, 0x59, 0x84, 0x81, 0x7a, 0x33, 0x44, 0xa4,
+	0x1c, 0x17, 0x96, 0x9f, 0x78, 0x6f, 0xb9, 0x2a, 0x29, 0x1b, 0xa5, 0xe6, 0x38, 0x2d, 0x54, 0x8d,
+	0xbd, 0xba, 0x8a, 0x54, 0xac, 0xd6, 0xd5, 0x73, 0x3b, 0x1b, 0x70, 0xcd, 0x2c, 0x53, 0x8a, 0x12,
+	0x74, 0xe4, 0x4b, 0x4c, 0xb6, 0x2e, 0x4d, 0x3f, 0xf8, 0x12, 0x9a, 0xda, 0x63, 0x2f, 0x6c, 0x15,
+	0x96, 0xdf, 0x3c, 0x7f, 0xb5, 0xbf, 0x73, 0x78, 0xd8, 0x3b, 0x78, 0xfd, 0xe4, 0xbb, 0x3b, 0xdf,
+	0xef, 0xed, 0x6e, 0x1e, 0xee, 0x76, 0x66, 0xf0, 0x9a, 0xf4, 0xfe, 0xce, 0xe1, 0xab, 0x9d, 0x6d,
+	0x03, 0xb7, 0xd8, 0x1d, 0xb0, 0x5f, 0xef, 0xbf, 0xc6, 0xa0, 0xc9, 0xb2, 0xef, 0x2a, 0xec, 0x36,
+	0xdc, 0x90, 0xf4, 0x92, 0xcf, 0xab, 0x0f, 0x1e, 0x43, 0x27, 0x6f, 0x6e, 0x32, 0x0c, 0x74, 0x97,
+	0x59, 0xf2, 0x36, 0x7e, 0x56, 0x85, 0xb6, 0x88, 0xa7, 0x14, 0xcf, 0xa0, 0xf2, 0x88, 0xbd, 0x80,
+	0x79, 0xf9, 0x9e, 0x2e, 0x53, 0xac, 0x65, 0xbe, 0xe0, 0x6b, 0xaf, 0xe4, 0x61, 0x39, 0xad, 0xcb,
+	0x7f, 0xed, 0x3f, 0xff, 0xcf, 0xbf, 0x5b, 0x59, 0x60, 0xcd, 0x87, 0x67, 0x9f, 0x3c, 0x3c, 0xe1,
+	0x41, 0x8c, 0x65, 0xfc, 0x16, 0x40, 0xf6, 0x4a, 0x2c, 0xeb, 0xa6, 0xe6, 0x96, 0xdc, 0x13, 0xba,
+	0xf6, 0x8d, 0x12, 0x8a, 0x2c, 0xf7, 0x06, 0x95, 0xbb, 0xec, 0xb4, 0xb1, 0x5c, 0x3f, 0xf0, 0x13,
+	0xf1, 0x62, 0xec, 0xe7, 0xd6, 0x03, 0x36, 0x80, 0x96, 0xfe, 0x7e, 0x2b, 0x53, 0xee, 0xb8, 0x92,
+	0x17, 0x68, 0xed, 0x9b, 0xa5, 0x34, 0xc5, 0xcb, 0x54, 0xc7, 0x75, 0xa7, 0x83, 0x75, 0x4c, 0x28,
+	0x47, 0x56, 0xcb, 0x10, 0xda, 0xe6, 0x33, 0xad, 0xec, 0x96, 0xb6, 0xe8, 0x0a, 0x8f, 0xc4, 0xda,
+	0xb7, 0xa7, 0x50, 0x65, 0x5d, 0xb7, 0xa9, 0xae, 0x55, 0x87, 0x61, 0x5d, 0x7d, 0xca, 0xa3, 0x1e,
+	0x89, 0xfd, 0xdc, 0x7a, 0xb0, 0xf1, 0x1f, 0xee, 0x41, 0x23, 0x75, 0xd5, 0xb3, 0x1f, 0xc3, 0x82,
+	0x11, 0xf0, 0xca, 0x54, 0x37, 0xca, 0xe2, 0x63, 0xed, 0x5b, 0xe5, 0x44, 0x59, 0xf1, 0x1d, 0xaa,
+	0xb8, 0xcb, 0x56, 0xb0, 0x62, 0x19, 0x31, 0xfa, 0x90, 0x42, 0xb7, 0xc5, 0xed, 0xcf, 0xb7, 0x9a,
+	0x24, 0x13, 0x95, 0xdd, 0xca, 0x0b, 0x17, 0xa3, 0xb6, 0xdb, 0x53, 0xa8, 0xb2, 0xba, 0x5b, 0x54,
+	0xdd, 0x0a, 0xbb, 0xa6, 0x57, 0x97, 0xba, 0xcf, 0x39, 0x5d, 0x79, 0xd6, 0x5f, 0x30, 0x65, 0xb7,
+	0x53, 0xc6, 0x2a, 0x7b, 0xd9, 0x34, 0x65, 0x91, 0xe2, 0xf3, 0xa6, 0x4e, 0x97, 0xaa, 0x62, 0x8c,
+	0xa6, 0x4f, 0x7f, 0xc0, 0x94, 0x1d, 0x41, 0x53, 0x7b, 0x77, 0x8c, 0xdd, 0x98, 0xfa, 0x46, 0x9a,
+	0x6d, 0x97, 0x91, 0xca, 0xba, 0xa2, 0x97, 0xff, 0x10, 0x15, 0x9d, 0x1f, 0x42, 0x23, 0x7d, 0xc9,
+	0x8a, 0xad, 0x6a, 0x2f, 0x8b, 0xe9, 0x2f, 0x6f, 0xd9, 0xdd, 0x22, 0xa1, 0x8c, 0xf9, 0xf4, 0xd2,
+	0x91, 0xf9, 0xde, 0x40, 0x53, 0x7b, 0xad, 0x2a, 0xed, 0x40, 0xf1, 0x45, 0x2c, 0xdb, 0x2e, 0x23,
+	0xc9, 0x2a, 0x96, 0xa8, 0x8a, 0x26, 0x6b, 0x10, 0x7f, 0xe3, 0x63, 0x56, 0x6c, 0x0f, 0xae, 0x4b,
+	0x89, 0x7d, 0xc4, 0xbf, 0xca, 0x34, 0x94, 0x3c, 0x1a, 0xfb, 0xc8, 0x62, 0x8f, 0xa1, 0xae, 0x1e,
+	0x25, 0x63, 0x2b, 0xe5, 0x8f, 0xab, 0xd9, 0xab, 0x05, 0x5c, 0x8a, 0xd7, 0xef, 0x03, 0x64, 0x4f,
+	0x63, 0xa5, 0x42, 0xa2, 0xf0, 0xd4, 0x96, 0x7d, 0xa3, 0x84, 0x22, 0x3b, 0xb8, 0x42, 0x1d, 0xec,
+	0x30, 0x12, 0x12, 0x01, 0x3f, 0x57, 0xaf, 0x1b, 0xfc, 0x08, 0x9a, 0xda, 0xeb, 0x58, 0xe9, 0xf0,
+	0x15, 0x5f, 0xd6, 0xb2, 0xed, 0x32, 0x92, 0x2c, 0xdd, 0xa6, 0xd2, 0xaf, 0x39, 0x8b, 0x58, 0x3a,
+	0xbe, 0x7e, 0x35, 0x12, 0x19, 0x70, 0x82, 0x4e, 0x61, 0xc1, 0x78, 0x02, 0x2b, 0x5d, 0xa1, 0x65,
+	0x0f, 0x6c, 0xd9, 0xb7, 0xca, 0x89, 0x26, 0x9f, 0x39, 0x4b, 0x58, 0xcf, 0x19, 0x65, 0xd1, 0x6a,
+	0xfa, 0x01, 0x34, 0xb5, 0xe7, 0xac, 0xd2, 0xbe, 0x14, 0x5f, 0xce, 0xb2, 0xed, 0x32, 0x92, 0xac,
+	0xe3, 0x1a, 0xd5, 0xd1, 0x76, 0x88, 0x15, 0xe8, 0x9e, 0x3e, 0x96, 0xfd, 0x63, 0x68, 0x9b, 0x0f,
+	0x5c, 0xa5, 0x6b, 0xbf, 0xf4, 0xa9, 0x2c, 0xfb, 0xf6, 0x14, 0xaa, 0xc9, 0xd2, 0x0f, 0x96, 0xd3,
+	0x4a, 0x1e, 0x7e, 0x21, 0x83, 0xfd, 0xbe, 0x64, 0xbf, 0x09, 0x8d, 0xf4, 0xe1, 0x04, 0xb6, 0xaa,
+	0x71, 0xad, 0xfe, 0xbc, 0x82, 0xdd, 0x2d, 0x12, 0xca, 0x98, 0x99, 0x0a, 0x17, 0xbb, 0x16, 0x3d,
+	0xa0, 0xa0, 0xed, 0x5a, 0xfa, 0x1b, 0x0b, 0xf6, 0x4a, 0x1e, 0x2e, 0xdf, 0xb5, 0x12, 0x1f, 0xcb,
+	0x08, 0x60, 0x31, 0x77, 0x29, 0x27, 0x5d, 0x15, 0xe5, 0xf7, 0x26, 0xed, 0x3b, 0x97, 0xdf, 0xe5,
+	0x31, 0x25, 0x88, 0x12, 0x82, 0x0f, 0xd5, 0x2d, 0xd5, 0xdf, 0x86, 0x96, 0xfe, 0xe8, 0x0e, 0xd3,
+	0x97, 0x72, 0xbe, 0xa6, 0x9b, 0xa5, 0x34, 0x73, 0x72, 0x59, 0x4b, 0xaf, 0x86, 0x7d, 0x0f, 0x56,
+	0xd2, 0xa5, 0xae, 0xdf, 0xf3, 0x88, 0xd9, 0x07, 0x25, 0xb7, 0x3f, 0x74, 0x3d, 0xce, 0xbe, 0x31,
+	0xf5, 0x7a, 0xc8, 0x23, 0x0b, 0x99, 0xc6, 0x7c, 0xc9, 0x24, 0xdb, 0x30, 0xca, 0x1e, 0x70, 0xb1,
+	0x6f, 0x4f, 0xa1, 0x9a, 0x4c, 0xc3, 0x96, 0x8d, 0x31, 0x12, 0x71, 0x11, 0xec, 0x07, 0xb0, 0xa8,
+	0xdd, 0xa4, 0xc3, 0xd7, 0x3c, 0xd2, 0x05, 0x50, 0xbc, 0xe8, 0x6d, 0x97, 0x9d, 0x52, 0x9c, 0x55,
+	0x2a, 0x7f, 0xc9, 0x31, 0x06, 0x07, 0x99, 0x7f, 0x0b, 0x9a, 0x5a, 0x19, 0x97, 0x95, 0xbb, 0xaa,
+	0x91, 0xf4, 0x3b, 0xca, 0x8f, 0x2c, 0x76, 0x00, 0x8b, 0xc6, 0xab, 0xaa, 0x61, 0x94, 0xdf, 0x3e,
+	0xcd, 0xd7, 0x56, 0xed, 0x9b, 0xe5, 0x54, 0xaa, 0xe8, 0xbe, 0xf5, 0xc8, 0x62, 0xff, 0x10, 0x9f,
+	0x53, 0xd5, 0x6f, 0xd1, 0x19, 0xd1, 0x46, 0xb9, 0x96, 0x75, 0x75, 0x9a, 0xde, 0x34, 0xc7, 0xa5,
+	0x6e, 0xef, 0x3d, 0xf8, 0x8e, 0x31, 0xac, 0x5f, 0x18, 0x06, 0xb5, 0xf5, 0xfc, 0xd3, 0xaa, 0x5f,
+	0xe6, 0x33, 0xe8, 0xd7, 0xeb, 0xbf, 0x7c, 0x64, 0xb1, 0xdf, 0xb7, 0xa0, 0x6d, 0x9a, 0x81, 0xd3,
+	0xee, 0x96, 0x9a, 0xa1, 0xed, 0xdb, 0x53, 0xa8, 0x72, 0xf2, 0x7f, 0x40, 0xad, 0x7c, 0xf5, 0xc0,
+	0x35, 0x5a, 0x29, 0x5f, 0xcd, 0xf9, 0xe5, 0x5a, 0xcb, 0x3e, 0x17, 0x4f, 0x8b, 0x2b, 0xbf, 0x18,
+	0x2b, 0xbe, 0x4a, 0x6d, 0x2f, 0x1b, 0x98, 0x68, 0x13, 0x4d, 0xc2, 0x8f, 0x60, 0x51, 0xfb, 0x96,
+	0xf8, 0xee, 0x7d, 0xbf, 0x77, 0xee, 0x52, 0x9f, 0xee, 0x38, 0x37, 0x8c, 0x3e, 0xe5, 0x77, 0xf8,
+	0x4d, 0x68, 0x6a, 0x4f, 0x40, 0x67, 0x5b, 0x54, 0xe1, 0x59, 0xe8, 0xe9, 0x8d, 0x1c, 0xc1, 0xa2,
+	0x96, 0xdd, 0x58, 0x1c, 0xef, 0x59, 0x8c, 0xf3, 0x80, 0xda, 0x7a, 0xd7, 0xf9, 0x60, 0x6a, 0x5b,
+	0x1f, 0x92, 0x31, 0x17, 0x5b, 0x7c, 0x00, 0x90, 0xf9, 0xb1, 0x59, 0xce, 0x87, 0x9a, 0x8a, 0x8c,
+	0xa2, 0xab, 0xdb, 0x5c, 0x81, 0xca, 0xd5, 0x8a, 0x25, 0xfe, 0x50, 0x08, 0x40, 0x99, 0x3f, 0x36,
+	0xd4, 0x1c, 0xd3, 0xd9, 0x6c, 0xdb, 0x65, 0xa4, 0x32, 0xf1, 0xa7, 0xca, 0x67, 0xaf, 0x61, 0x61,
+	0x2f, 0x0c, 0xdf, 0x4e, 0xc6, 0xaa, 0xc5, 0xcc, 0xf4, 0xbe, 0xa0, 0x5b, 0xdc, 0xce, 0xf5, 0xc2,
+	0x59, 0xa3, 0xa2, 0x6c, 0xd6, 0xd5, 0x8a, 0x7a, 0xf8, 0x45, 0xe6, 0x23, 0xff, 0x92, 0x79, 0xb0,
+	0x94, 0x4a, 0xd5, 0xb4, 0xe1, 0xb6, 0x59, 0x8c, 0x21, 0x4b, 0xf3, 0x55, 0x18, 0xfa, 0xb8, 0x6a,
+	0xed, 0xc3, 0x58, 0x95, 0x49, 0x32, 0xa5, 0xb5, 0xcd, 0xfb, 0x74, 0x11, 0x88, 0x9c, 0x15, 0xcb,
+	0x59, 0xc3, 0x53, 0x2f, 0x87, 0xbd, 0x60, 0x80, 0xe6, 0x4e, 0x33, 0xf6, 0x2e, 0x22, 0xfe, 0x93,
+	0x87, 0x5f, 0x48, 0x37, 0xc8, 0x97, 0x6a, 0xa7, 0x91, 0x3d, 0x37, 0x77, 0x9a, 0x9c, 0xbb, 0xc9,
+	0xbe, 0x59, 0x4a, 0x2b, 0x1b, 0x6a, 0xe5, 0xbd, 0x62, 0x43, 0x58, 0x2a, 0x78, 0xa8, 0xd2, 0x4d,
+	0x66, 0x9a, 0x5f, 0xcb, 0x5e, 0x9b, 0x9e, 0xc1, 0xac, 0xed, 0x81, 0x59, 0xdb, 0x21, 0x2c, 0x6c,
+	0x73, 0x31, 0x58, 0x22, 0xf2, 0x39, 0x77, 0x15, 0x53, 0x8f, 0xab, 0xb6, 0x97, 0x4b, 0x68, 0xa6,
+	0x2a, 0x41, 0x21, 0xc7, 0xec, 0x87, 0xd0, 0x7c, 0xc6, 0x13, 0x15, 0xea, 0x9c, 0x2a, 0xb3, 0xb9,
+	0xd8, 0x67, 0xbb, 0x24, 0x52, 0xda, 0xe4, 0x19, 0x2a, 0xed, 0x21, 0xc6, 0x4e, 0x0b, 0xe1, 0xd4,
+	0xf3, 0x07, 0x5f, 0xb2, 0xbf, 0x44, 0x85, 0xa7, 0x77, 0x3d, 0x56, 0xb4, 0xb8, 0x55, 0xbd, 0xf0,
+	0xc5, 0x1c, 0x5e, 0x56, 0x72, 0x10, 0x0e, 0xb8, 0xa6, 0x54, 0x05, 0xd0, 0xd4, 0xae, 0x68, 0xa5,
+	0x0b, 0xa8, 0x78, 0x19, 0xd0, 0xb6, 0xcb, 0x48, 0x72, 0x9c, 0xef, 0x53, 0x3d, 0x0e, 0x5b, 0xcb,
+	0xea, 0x11, 0xb7, 0xb8, 0xb2, 0x9a, 0x1e, 0x7e, 0xe1, 0x8d, 0x92, 0x2f, 0xd9, 0x1b, 0x7a, 0xc5,
+	0x4a, 0x0f, 0xe5, 0xce, 0xb4, 0xf3, 0x7c, 0xd4, 0xb7, 0xcd, 0x8a, 0x24, 0x53, 0x63, 0x17, 0x55,
+	0x91, 0xee, 0xf5, 0x6d, 0x00, 0x0c, 0x13, 0xde, 0xf6, 0xf8, 0x28, 0x0c, 0x32, 0x59, 0x9b, 0x05,
+	0x12, 0xdb, 0xcb, 0x06, 0x26, 0xcf, 0x10, 0x6f, 0xb4, 0xe3, 0x8c, 0x3e, 0xc5, 0x4c, 0x31, 0xd7,
+	0xd4, 0x58, 0x63, 0xdb, 0x2e, 0xcb, 0x91, 0xee, 0xeb, 0x9b, 0x00, 0x99, 0x33, 0x32, 0x3d, 0x9c,
+	0x14, 0xfc, 0x9c, 0xf6, 0x8d, 0x12, 0x8a, 0x6c, 0xdb, 0x01, 0x34, 0x32, 0xef, 0xd6, 0x6a, 0x76,
+	0x11, 0xd2, 0xf0, 0x85, 0xd9, 0xdd, 0x22, 0x41, 0xce, 0x4a, 0x87, 0x86, 0x0a, 0x58, 0x1d, 0x87,
+	0x8a, 0x1c, 0x49, 0x3e, 0x2c, 0x8b, 0x06, 0xa6, 0x0a, 0x0e, 0x05, 0xc0, 0xaa, 0x9e, 0x94, 0xf8,
+	0x7d, 0xec, 0x9b, 0xa5, 0xb4, 0x32, 0x1b, 0x0b, 0x72, 0xab, 0x08, 0xbe, 0x45, 0xd1, 0x3c, 0x82,
+	0xa5, 0x82, 0x8d, 0x3d, 0x5d, 0xd2, 0xd3, 0x9c, 0x28, 0xf6, 0xda, 0xf4, 0x0c, 0xb2, 0xca, 0xeb,
+	0x54, 0xe5, 0xa2, 0x03, 0x58, 0x65, 0x7c, 0xee, 0x27, 0xfd, 0x53, 0xac, 0xee, 0x77, 0x2d, 0x58,
+	0x2e, 0x31, 0xa1, 0xb3, 0x0f, 0xd5, 0xf1, 0x7c, 0xaa, 0x79, 0xdd, 0x2e, 0xb5, 0xb0, 0x3a, 0x87,
+	0x54, 0xcf, 0x0b, 0xf6, 0x5d, 0x63, 0x63, 0x13, 0xc6, 0x4d, 0xb9, 0x32, 0x2f, 0x55, 0x2a, 0x4a,
+	0x35, 0x8a, 0x9f, 0xc0, 0xaa, 0x68, 0xc8, 0xe6, 0x70, 0x98, 0xb3, 0xfe, 0xde, 0x29, 0xfc, 0xfb,
+	0x21, 0xc3, 0xaa, 0x6d, 0x4f, 0xff, 0xf7, 0x44, 0x53, 0x14, 0x60, 0xd1, 0x54, 0x36, 0x81, 0x4e,
+	0xde, 0xa2, 0xca, 0xa6, 0x97, 0x65, 0x7f, 0x60, 0x1c, 0x34, 0x4b, 0xac, 0xb0, 0xbf, 0x42, 0x95,
+	0x7d, 0xe0, 0xd8, 0x65, 0xe3, 0x22, 0xce, 0x9e, 0x38, 0x1f, 0x7f, 0x25, 0x35, 0xff, 0xe6, 0xfa,
+	0xa9, 0x2a, 0x98, 0x66, 0xaf, 0xb6, 0x6f, 0x99, 0x19, 0x72, 0xd5, 0x7f, 0x44, 0xd5, 0xaf, 0x39,
+	0x37, 0xcb, 0xaa, 0x8f, 0xc4, 0x27, 0xe2, 0xd0, 0xbb, 0x9a, 0x5f, 0xd7, 0xaa, 0x05, 0x6b, 0x65,
+	0xf3, 0x3d, 0xf5, 0xf4, 0x92, 0x1b, 0xeb, 0x19, 0xd2, 0xed, 0x5a, 0xba, 0xb9, 0x37, 0x5d, 0x3e,
+	0x25, 0x76, 0x65, 0xfb, 0x66, 0x29, 0xad, 0x4c, 0xaf, 0x51, 0x96, 0xe1, 0xcf, 0xad, 0x07, 0x4f,
+	0xee, 0xfd, 0xe0, 0x57, 0x4e, 0xfc, 0xe4, 0x74, 0x72, 0xb4, 0xde, 0x0f, 0x47, 0x0f, 0x87, 0xca,
+	0xac, 0x27, 0x2f, 0x85, 0x3c, 0x1c, 0x06, 0x83, 0x87, 0x54, 0xec, 0xd1, 0x1c, 0xfd, 0xbf, 0xb4,
+	0x6f, 0xfe, 0xff, 0x01, 0x00, 0x93, 0x63, 0x56, 0xc2, 0x61, 0x6d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
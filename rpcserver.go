@@ -1969,6 +1969,20 @@ func abandonChanFromGraph(chanGraph *channeldb.ChannelGraph,
 	return chanGraph.DeleteChannelEdges(chanID)
 }
 
+// prepareChanForAbandon ensures that the passed open channel can be abandoned,
+// and marks it as borked, such that it won't be loaded back in while its state
+// is being removed. If the channel isn't in the default state, then it's left
+// untouched and ErrAbandonNonDefaultChannel is returned, unless force is set.
+func prepareChanForAbandon(dbChan *channeldb.OpenChannel, force bool) error {
+	if !force {
+		if err := dbChan.CheckAbandon(); err != nil {
+			return err
+		}
+	}
+
+	return dbChan.MarkBorked()
+}
+
 // AbandonChannel removes all channel state from the database except for a
 // close summary. This method can be used to get rid of permanently unusable
 // channels due to bugs fixed in newer versions of lnd.
@@ -2012,8 +2026,9 @@ func (r *rpcServer) AbandonChannel(ctx context.Context,
 	case err == nil:
 		// We'll mark the channel as borked before we remove the state
 		// from the switch/peer so it won't be loaded back in if the
-		// peer reconnects.
-		if err := dbChan.MarkBorked(); err != nil {
+		// peer reconnects, unless the channel can't be abandoned.
+		err := prepareChanForAbandon(dbChan, in.Force)
+		if err != nil {
 			return nil, err
 		}
 		remotePub := dbChan.IdentityPub
@@ -2032,8 +2047,12 @@ func (r *rpcServer) AbandonChannel(ctx context.Context,
 	// channel state, remove from the graph, remove from the contract
 	// court. Between any step it's possible that the users restarts the
 	// process all over again. As a result, each of the steps below are
-	// intended to be idempotent.
-	err = r.server.chanDB.AbandonChannel(chanPoint, uint32(bestHeight))
+	// intended to be idempotent. A channel that has only been marked
+	// borked above is still abandoned, while one that may be waiting for a
+	// close to confirm is refused, unless the abandon is forced.
+	err = r.server.chanDB.AbandonChannel(
+		chanPoint, uint32(bestHeight), in.Force,
+	)
 	if err != nil {
 		return nil, err
 	}
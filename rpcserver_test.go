@@ -0,0 +1,91 @@
+// +build !rpctest
+
+package lnd
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+// TestPrepareChanForAbandon asserts that a channel that isn't in the default
+// state is refused when abandoning it, and left untouched, unless the abandon
+// is forced, while one in the default state is marked borked and abandoned.
+func TestPrepareChanForAbandon(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotfier{
+		confChannel: make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	_, aliceChan, bobChan, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// Alice's channel is waiting for its commitment to confirm, so it
+	// shouldn't be abandoned.
+	aliceState := aliceChan.State()
+	commitTx := aliceState.LocalCommitment.CommitTx
+	if err := aliceState.MarkCommitmentBroadcasted(commitTx); err != nil {
+		t.Fatalf("unable to mark commitment broadcast: %v", err)
+	}
+
+	err = prepareChanForAbandon(aliceState, false)
+	if _, ok := err.(channeldb.ErrAbandonNonDefaultChannel); !ok {
+		t.Fatalf("expected ErrAbandonNonDefaultChannel, got: %v", err)
+	}
+
+	dbChan, err := aliceState.Db.FetchChannel(aliceState.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to fetch channel: %v", err)
+	}
+	if dbChan.HasChanStatus(channeldb.ChanStatusBorked) {
+		t.Fatalf("refused channel shouldn't be marked borked")
+	}
+
+	// Forcing the abandon should mark the channel borked regardless, and
+	// allow it to be abandoned.
+	if err := prepareChanForAbandon(aliceState, true); err != nil {
+		t.Fatalf("unable to prepare channel for abandon: %v", err)
+	}
+	if !aliceState.HasChanStatus(channeldb.ChanStatusBorked) {
+		t.Fatalf("expected channel to be marked borked")
+	}
+
+	err = aliceState.Db.AbandonChannel(
+		&aliceState.FundingOutpoint, 1, true,
+	)
+	if err != nil {
+		t.Fatalf("unable to abandon channel: %v", err)
+	}
+	_, err = aliceState.Db.FetchChannel(aliceState.FundingOutpoint)
+	if err != channeldb.ErrChannelNotFound {
+		t.Fatalf("channel should not have been found: %v", err)
+	}
+
+	// Bob's channel is in the default state, so it should be marked
+	// borked, and then abandoned without forcing the operation.
+	bobState := bobChan.State()
+	if err := prepareChanForAbandon(bobState, false); err != nil {
+		t.Fatalf("unable to prepare channel for abandon: %v", err)
+	}
+	if !bobState.HasChanStatus(channeldb.ChanStatusBorked) {
+		t.Fatalf("expected channel to be marked borked")
+	}
+
+	err = bobState.Db.AbandonChannel(&bobState.FundingOutpoint, 1, false)
+	if err != nil {
+		t.Fatalf("unable to abandon channel: %v", err)
+	}
+	_, err = bobState.Db.FetchChannel(bobState.FundingOutpoint)
+	if err != channeldb.ErrChannelNotFound {
+		t.Fatalf("channel should not have been found: %v", err)
+	}
+}